@@ -33,6 +33,27 @@ func GenerateToken(userID int, username, email, role, secret string) (string, er
 	return token.SignedString([]byte(secret))
 }
 
+// ServiceRole is the role of service tokens, no user account can hold it so
+// other services can grant internal endpoints to it alone
+const ServiceRole = "service"
+
+// GenerateServiceToken creates a short-lived JWT cortex itself uses to call
+// other services, it carries no user
+func GenerateServiceToken(name, secret string, ttl time.Duration) (string, error) {
+	claims := TokenClaims{
+		Username: name,
+		Role:     ServiceRole,
+		RegisteredClaims: jwt.RegisteredClaims{
+			Subject:   name,
+			ExpiresAt: jwt.NewNumericDate(time.Now().Add(ttl)),
+			IssuedAt:  jwt.NewNumericDate(time.Now()),
+		},
+	}
+
+	token := jwt.NewWithClaims(jwt.SigningMethodHS256, claims)
+	return token.SignedString([]byte(secret))
+}
+
 // ValidateToken parses and validates a JWT token
 func ValidateToken(tokenString, secret string) (*TokenClaims, error) {
 	token, err := jwt.ParseWithClaims(tokenString, &TokenClaims{}, func(token *jwt.Token) (interface{}, error) {
@@ -0,0 +1,44 @@
+package cache
+
+import (
+	"context"
+	"fmt"
+
+	"go.elastic.co/apm"
+)
+
+// DelPattern deletes all keys matching the given pattern using SCAN
+func (c *cache) DelPattern(ctx context.Context, pattern string) error {
+	span, _ := apm.StartSpan(ctx, "DelPattern", "redis")
+	defer span.End()
+
+	if c.writeClient == nil || pattern == "" {
+		return nil
+	}
+
+	var cursor uint64
+	var keys []string
+
+	for {
+		scanKeys, next, err := c.writeClient.Scan(ctx, cursor, pattern, 100).Result()
+		if err != nil {
+			return fmt.Errorf("failed to scan keys for pattern %q: %w", pattern, err)
+		}
+
+		keys = append(keys, scanKeys...)
+
+		// Scan is complete once the cursor returns to 0
+		cursor = next
+		if cursor == 0 {
+			break
+		}
+	}
+
+	if len(keys) > 0 {
+		if err := c.writeClient.Del(ctx, keys...).Err(); err != nil {
+			return fmt.Errorf("failed to delete keys for pattern %q: %w", pattern, err)
+		}
+	}
+
+	return nil
+}
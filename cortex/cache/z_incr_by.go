@@ -0,0 +1,19 @@
+package cache
+
+import (
+	"context"
+	"time"
+
+	"go.elastic.co/apm"
+)
+
+func (c *cache) ZIncrBy(ctx context.Context, key string, ttl time.Duration, increment float64, member string) error {
+	span, _ := apm.StartSpan(ctx, "ZIncrBy", "redis")
+	defer span.End()
+
+	if err := c.writeClient.ZIncrBy(ctx, key, increment, member).Err(); err != nil {
+		return err
+	}
+
+	return c.writeClient.Expire(ctx, key, ttl).Err()
+}
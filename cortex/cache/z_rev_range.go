@@ -0,0 +1,15 @@
+package cache
+
+import (
+	"context"
+
+	"github.com/redis/go-redis/v9"
+	"go.elastic.co/apm"
+)
+
+func (c *cache) ZRevRangeWithScores(ctx context.Context, key string, start, stop int64) ([]redis.Z, error) {
+	span, _ := apm.StartSpan(ctx, "ZRevRangeWithScores", "redis")
+	defer span.End()
+
+	return c.readClient.ZRevRangeWithScores(ctx, key, start, stop).Result()
+}
@@ -4,9 +4,43 @@ import (
 	"context"
 	"log/slog"
 
+	"cortex/ent"
 	"cortex/logger"
 )
 
+// invalidateCategoryCache removes the cached entries of a single category,
+// including the entries cached under any of its previous slugs and the given extra slugs
+func (s *service) invalidateCategoryCache(ctx context.Context, cat *ent.Category, slugs ...string) {
+	if s.cache == nil || cat == nil {
+		return
+	}
+
+	keys := []string{
+		buildCategoryIDCacheKey(cat.ID),
+		buildCategorySlugCacheKey(cat.Slug),
+	}
+
+	oldSlugs, err := HistoricalSlugs(ctx, s.ent, cat.ID)
+	if err != nil {
+		slog.WarnContext(ctx, "Failed to load category slug history", logger.Extra(map[string]any{
+			"id":    cat.ID,
+			"error": err.Error(),
+		}))
+	}
+	for _, slug := range append(oldSlugs, slugs...) {
+		keys = append(keys, buildCategorySlugCacheKey(slug))
+	}
+
+	for _, key := range keys {
+		if err := s.cache.Del(ctx, key); err != nil {
+			slog.WarnContext(ctx, "Failed to invalidate category cache", logger.Extra(map[string]any{
+				"key":   key,
+				"error": err.Error(),
+			}))
+		}
+	}
+}
+
 // invalidateCategoryListCache removes all category list cache entries
 // This should be called after creating, updating, or deleting categories
 func (s *service) invalidateCategoryListCache(ctx context.Context) {
@@ -14,21 +48,42 @@ func (s *service) invalidateCategoryListCache(ctx context.Context) {
 		return
 	}
 
-	// Delete common cache key patterns for category lists
-	// These patterns match the keys generated in buildCategoryListCacheKey
-	patterns := []string{
-		"category:list",
-		"category:list:*",
+	// Delete the unfiltered list key and every filtered/sorted variant
+	// These keys match the ones generated in buildCategoryListCacheKey
+	if err := s.cache.Del(ctx, "category:list"); err != nil {
+		slog.WarnContext(ctx, "Failed to invalidate category list cache", logger.Extra(map[string]any{
+			"key":   "category:list",
+			"error": err.Error(),
+		}))
 	}
-
-	for _, pattern := range patterns {
-		if err := s.cache.Del(ctx, pattern); err != nil {
-			slog.WarnContext(ctx, "Failed to invalidate category list cache", logger.Extra(map[string]any{
-				"pattern": pattern,
-				"error":   err.Error(),
-			}))
-		}
+	if err := s.cache.DelPattern(ctx, "category:list:*"); err != nil {
+		slog.WarnContext(ctx, "Failed to invalidate category list cache", logger.Extra(map[string]any{
+			"pattern": "category:list:*",
+			"error":   err.Error(),
+		}))
 	}
 
 	slog.InfoContext(ctx, "Category list cache invalidated")
 }
+
+// invalidateTranslatedListCache drops the category and subcategory lists, both
+// carry translated labels since categories and subcategories share translations
+func (s *service) invalidateTranslatedListCache(ctx context.Context) {
+	if s.cache == nil {
+		return
+	}
+
+	s.invalidateCategoryListCache(ctx)
+	if err := s.cache.DelPattern(ctx, "subcategory:list*"); err != nil {
+		slog.WarnContext(ctx, "Failed to invalidate subcategory list cache", logger.Extra(map[string]any{
+			"pattern": "subcategory:list*",
+			"error":   err.Error(),
+		}))
+	}
+	if err := s.cache.Del(ctx, TranslationLocalesKey); err != nil {
+		slog.WarnContext(ctx, "Failed to invalidate translation locales cache", logger.Extra(map[string]any{
+			"key":   TranslationLocalesKey,
+			"error": err.Error(),
+		}))
+	}
+}
@@ -30,4 +30,16 @@ type Category struct {
 	DeletedAt   *time.Time     `json:"deleted_at,omitempty" db:"deleted_at"`
 	Status      string         `json:"status,omitempty" db:"status"`
 	Meta        map[string]any `json:"meta,omitempty" db:"meta"`
+	Position    int            `json:"position" db:"position"`
+	Locale      string         `json:"locale,omitempty" db:"-"`
+}
+
+type Translation struct {
+	Locale      string    `json:"locale" db:"locale"`
+	Label       string    `json:"label" db:"label"`
+	Description string    `json:"description,omitempty" db:"description"`
+	CreatedBy   int       `json:"created_by,omitempty" db:"created_by"`
+	UpdatedBy   int       `json:"updated_by,omitempty" db:"updated_by"`
+	CreatedAt   time.Time `json:"created_at" db:"created_at"`
+	UpdatedAt   time.Time `json:"updated_at" db:"updated_at"`
 }
@@ -10,18 +10,35 @@ func (s *service) CreateCategory(ctx context.Context, params CreateCategoryParam
 	if exists != nil {
 		return errors.New("ent: category already exists")
 	}
-	_, err := s.ent.Category.Create().
+	tx, err := s.ent.Tx(ctx)
+	if err != nil {
+		return errors.New("ent: failed to start transaction")
+	}
+	defer tx.Rollback()
+
+	// New categories are appended after the existing top-level ones
+	position, err := NextPosition(ctx, tx.Client(), 0)
+	if err != nil {
+		return errors.New("ent: category creation failed")
+	}
+
+	_, err = tx.Category.Create().
 		SetSlug(params.Slug).
 		SetLabel(params.Label).
 		SetDescription(params.Description).
 		SetCreatorID(params.CreatorID).
 		SetCreatedBy(params.CreatorID).
 		SetMeta(params.Meta).
+		SetPosition(position).
 		Save(ctx)
 	if err != nil {
 		return errors.New("ent: category creation failed")
 	}
 
+	if err := tx.Commit(); err != nil {
+		return errors.New("ent: category creation failed")
+	}
+
 	// Invalidate category list cache to show new category immediately
 	if s.cache != nil {
 		s.invalidateCategoryListCache(ctx)
@@ -4,6 +4,9 @@ import (
 	"context"
 	"errors"
 
+	"cortex/ent/categoryslughistory"
+	"cortex/ent/categorytranslation"
+
 	"github.com/google/uuid"
 )
 
@@ -12,11 +15,35 @@ func (s *service) DeleteCategoryByUUID(ctx context.Context, uuid uuid.UUID) erro
 	if err != nil {
 		return err
 	}
-	err = s.ent.Category.DeleteOne(category).Exec(ctx)
+
+	tx, err := s.ent.Tx(ctx)
+	if err != nil {
+		return errors.New("ent: failed to start transaction")
+	}
+	defer tx.Rollback()
+
+	// Old slugs must not keep redirecting to a category that is gone
+	if _, err := tx.CategorySlugHistory.Delete().
+		Where(categoryslughistory.CategoryIDEQ(category.ID)).
+		Exec(ctx); err != nil {
+		return errors.New("ent: category slug history deletion failed")
+	}
+
+	if _, err := tx.CategoryTranslation.Delete().
+		Where(categorytranslation.CategoryIDEQ(category.ID)).
+		Exec(ctx); err != nil {
+		return errors.New("ent: category translations deletion failed")
+	}
+
+	err = tx.Category.DeleteOneID(category.ID).Exec(ctx)
 	if err != nil {
 		return errors.New("ent: category deletion failed")
 	}
 
+	if err := tx.Commit(); err != nil {
+		return errors.New("ent: category deletion failed")
+	}
+
 	// Invalidate category list cache to reflect deletion immediately
 	if s.cache != nil {
 		s.invalidateCategoryListCache(ctx)
@@ -0,0 +1,40 @@
+package category
+
+import (
+	"context"
+	"errors"
+
+	"cortex/ent/categorytranslation"
+	customerrors "cortex/pkg/custom_errors"
+
+	"github.com/google/uuid"
+)
+
+func (s *service) DeleteCategoryTranslation(ctx context.Context, uid uuid.UUID, locale string) error {
+	locale = NormalizeLocale(locale)
+	if locale == "" {
+		return customerrors.ErrInvalidLocale
+	}
+
+	cat, err := s.FindCategoryByUUID(ctx, uid)
+	if err != nil {
+		return customerrors.ErrCategoryNotFound
+	}
+
+	deleted, err := s.ent.CategoryTranslation.Delete().
+		Where(
+			categorytranslation.CategoryIDEQ(cat.ID),
+			categorytranslation.LocaleEQ(locale),
+		).
+		Exec(ctx)
+	if err != nil {
+		return errors.New("ent: category translation deletion failed")
+	}
+	if deleted == 0 {
+		return customerrors.ErrTranslationNotFound
+	}
+
+	s.invalidateTranslatedListCache(ctx)
+
+	return nil
+}
@@ -29,6 +29,29 @@ type UpdateCategoryParams struct {
 	ApprovedAt  time.Time
 	Status      *string
 	Meta        json.RawMessage
+
+	// ExpectedUpdatedAt guards the update, it fails with a ModifiedError
+	// when the category changed since the client read it
+	ExpectedUpdatedAt *time.Time
+}
+
+type ReorderCategoriesParams struct {
+	UUIDs     []uuid.UUID
+	UpdatedBy int
+}
+
+type ImportCategoriesParams struct {
+	Rows      []ImportRow
+	CreatorID int
+	DryRun    bool
+}
+
+type UpsertTranslationParams struct {
+	CategoryUUID uuid.UUID
+	Locale       string
+	Label        string
+	Description  string
+	UpdatedBy    int
 }
 
 type DeleteCategoryParams struct {
@@ -44,6 +67,9 @@ type GetCategoryFilter struct {
 	Label  *string
 	Status *string
 
+	// Locales in order of preference, the first available translation is used
+	Locales []string
+
 	IncludeSubcategories bool
 	IncludeTopPosts      bool
 
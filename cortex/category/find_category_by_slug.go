@@ -38,7 +38,12 @@ func (s *service) FindCategoryBySlug(ctx context.Context, slug string) (*ent.Cat
 		category.SlugEQ(slug),
 	).First(ctx)
 	if err != nil {
-		return nil, errors.New("ent: category not found")
+		// Fall back to previous slugs so renamed categories keep resolving.
+		// Callers can detect the redirect by comparing the returned slug.
+		cat, err = s.findCategoryBySlugHistory(ctx, slug)
+		if err != nil {
+			return nil, errors.New("ent: category not found")
+		}
 	}
 
 	// Cache the result (24 hours TTL)
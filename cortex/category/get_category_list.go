@@ -6,6 +6,7 @@ import (
 	"errors"
 	"log/slog"
 	"strconv"
+	"strings"
 	"time"
 
 	"cortex/ent"
@@ -16,6 +17,12 @@ import (
 )
 
 func (s *service) GetCategoryList(ctx context.Context, filter GetCategoryFilter) ([]*Category, error) {
+	locales, err := ResolveLocales(ctx, s.ent, s.cache, filter.Locales)
+	if err != nil {
+		return nil, errors.New("ent: category translations retrieval failed")
+	}
+	filter.Locales = locales
+
 	// Try cache first
 	if s.cache != nil {
 		cacheKey := buildCategoryListCacheKey(filter)
@@ -59,20 +66,26 @@ func (s *service) GetCategoryList(ctx context.Context, filter GetCategoryFilter)
 	if filter.Offset != nil {
 		query = query.Offset(*filter.Offset)
 	}
-	if filter.SortBy != nil && filter.SortOrder != nil {
-		switch *filter.SortBy {
-		case "created_at":
-			if *filter.SortOrder == "desc" {
-				query = query.Order(ent.Desc(category.FieldCreatedAt))
-			} else {
-				query = query.Order(ent.Asc(category.FieldCreatedAt))
-			}
-		case "label":
-			if *filter.SortOrder == "desc" {
-				query = query.Order(ent.Desc(category.FieldLabel))
-			} else {
-				query = query.Order(ent.Asc(category.FieldLabel))
-			}
+	sortBy, sortOrder := resolveCategorySort(filter)
+	switch sortBy {
+	case "created_at":
+		if sortOrder == "desc" {
+			query = query.Order(ent.Desc(category.FieldCreatedAt))
+		} else {
+			query = query.Order(ent.Asc(category.FieldCreatedAt))
+		}
+	case "label":
+		if sortOrder == "desc" {
+			query = query.Order(ent.Desc(category.FieldLabel))
+		} else {
+			query = query.Order(ent.Asc(category.FieldLabel))
+		}
+	default:
+		// Manual order curated by editors, oldest first among equal positions
+		if sortOrder == "desc" {
+			query = query.Order(ent.Desc(category.FieldPosition), ent.Desc(category.FieldCreatedAt))
+		} else {
+			query = query.Order(ent.Asc(category.FieldPosition), ent.Asc(category.FieldCreatedAt))
 		}
 	}
 
@@ -101,9 +114,28 @@ func (s *service) GetCategoryList(ctx context.Context, filter GetCategoryFilter)
 			CreatedAt:   c.CreatedAt,
 			UpdatedAt:   c.UpdatedAt,
 			Meta:        c.Meta,
+			Position:    c.Position,
 		})
 	}
 
+	if len(filter.Locales) > 0 && len(result) > 0 {
+		ids := make([]int, 0, len(result))
+		for _, c := range result {
+			ids = append(ids, c.ID)
+		}
+		translations, err := BestTranslations(ctx, s.ent, ids, filter.Locales)
+		if err != nil {
+			return nil, errors.New("ent: category translations retrieval failed")
+		}
+		for _, c := range result {
+			if t, ok := translations[c.ID]; ok {
+				c.Label = t.Label
+				c.Description = t.Description
+				c.Locale = t.Locale
+			}
+		}
+	}
+
 	// Cache the result (5 minutes TTL for lists)
 	if s.cache != nil {
 		cacheKey := buildCategoryListCacheKey(filter)
@@ -117,6 +149,20 @@ func (s *service) GetCategoryList(ctx context.Context, filter GetCategoryFilter)
 	return result, nil
 }
 
+// resolveCategorySort returns the sort field and order of a list query,
+// defaulting to the manual position order
+func resolveCategorySort(filter GetCategoryFilter) (string, string) {
+	sortBy := "position"
+	if filter.SortBy != nil && *filter.SortBy != "" {
+		sortBy = *filter.SortBy
+	}
+	sortOrder := "asc"
+	if filter.SortOrder != nil && *filter.SortOrder != "" {
+		sortOrder = *filter.SortOrder
+	}
+	return sortBy, sortOrder
+}
+
 func buildCategoryListCacheKey(filter GetCategoryFilter) string {
 	key := "category:list"
 	if filter.ID != nil {
@@ -128,6 +174,9 @@ func buildCategoryListCacheKey(filter GetCategoryFilter) string {
 	if filter.Slug != nil {
 		key += ":slug:" + *filter.Slug
 	}
+	if filter.Label != nil {
+		key += ":label:" + *filter.Label
+	}
 	if filter.Status != nil {
 		key += ":status:" + *filter.Status
 	}
@@ -137,5 +186,10 @@ func buildCategoryListCacheKey(filter GetCategoryFilter) string {
 	if filter.Offset != nil {
 		key += ":offset:" + strconv.Itoa(*filter.Offset)
 	}
+	if len(filter.Locales) > 0 {
+		key += ":lang:" + strings.Join(filter.Locales, ",")
+	}
+	sortBy, sortOrder := resolveCategorySort(filter)
+	key += ":sort:" + sortBy + ":" + sortOrder
 	return key
 }
@@ -0,0 +1,33 @@
+package category
+
+import (
+	"context"
+	"fmt"
+
+	"cortex/ent"
+	"cortex/ent/categorytranslation"
+	customerrors "cortex/pkg/custom_errors"
+
+	"github.com/google/uuid"
+)
+
+func (s *service) GetCategoryTranslations(ctx context.Context, uid uuid.UUID) ([]*Translation, error) {
+	cat, err := s.FindCategoryByUUID(ctx, uid)
+	if err != nil {
+		return nil, customerrors.ErrCategoryNotFound
+	}
+
+	translations, err := s.ent.CategoryTranslation.Query().
+		Where(categorytranslation.CategoryIDEQ(cat.ID)).
+		Order(ent.Asc(categorytranslation.FieldLocale)).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("ent: category translations retrieval failed: %w", err)
+	}
+
+	result := make([]*Translation, 0, len(translations))
+	for _, t := range translations {
+		result = append(result, toTranslation(t))
+	}
+	return result, nil
+}
@@ -0,0 +1,72 @@
+package category
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	customerrors "cortex/pkg/custom_errors"
+
+	"github.com/google/uuid"
+)
+
+// GetTopPosts returns the most viewed posts of a category within the window,
+// scored by their decayed view count
+func (s *service) GetTopPosts(ctx context.Context, categoryUUID uuid.UUID, window string, limit int) ([]*TopPost, error) {
+	if window == "" {
+		window = DefaultTopPostsWindow
+	}
+	halfLife, ok := TopPostsWindows[window]
+	if !ok {
+		return nil, customerrors.ErrInvalidTopPostsWindow
+	}
+
+	cat, err := s.FindCategoryByUUID(ctx, categoryUUID)
+	if err != nil {
+		return nil, customerrors.ErrCategoryNotFound
+	}
+
+	result := []*TopPost{}
+	if s.cache == nil {
+		return result, nil
+	}
+
+	now := time.Now()
+	generation, landmark := topPostsLandmark(halfLife, now)
+	key := s.topPostsKey(categoryUUID, window, generation)
+
+	exists, err := s.cache.KeyExists(ctx, key)
+	if err != nil {
+		return nil, err
+	}
+	if !exists {
+		if err := s.rebuildCategoryTopPosts(ctx, cat); err != nil {
+			return nil, fmt.Errorf("failed to rebuild top posts: %w", err)
+		}
+	}
+
+	// One extra member in case the placeholder of an empty leaderboard is among them
+	members, err := s.cache.ZRevRangeWithScores(ctx, key, 0, int64(limit))
+	if err != nil {
+		return nil, err
+	}
+
+	scale := topPostsWeight(halfLife, landmark, now)
+	for _, m := range members {
+		member, _ := m.Member.(string)
+		postID, err := strconv.Atoi(member)
+		if err != nil || member == topPostsInitMember {
+			continue
+		}
+		if len(result) == limit {
+			break
+		}
+		result = append(result, &TopPost{
+			PostID: postID,
+			Score:  m.Score / scale,
+		})
+	}
+
+	return result, nil
+}
@@ -0,0 +1,163 @@
+package category
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"path/filepath"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+const (
+	ImportFormatCSV  = "csv"
+	ImportFormatYAML = "yaml"
+)
+
+// ImportRow is one category of an import file. Rows with a parent slug become
+// subcategories of that parent, which may be another row of the same file.
+type ImportRow struct {
+	Row         int            `json:"row" yaml:"-"`
+	Slug        string         `json:"slug" yaml:"slug"`
+	Label       string         `json:"label" yaml:"label"`
+	Description string         `json:"description,omitempty" yaml:"description"`
+	ParentSlug  string         `json:"parent_slug,omitempty" yaml:"parent_slug"`
+	Status      string         `json:"status,omitempty" yaml:"status"`
+	Meta        map[string]any `json:"meta,omitempty" yaml:"meta"`
+
+	// MetaError is why the meta column of a CSV row could not be read, it is
+	// reported with the other row errors
+	MetaError string `json:"-" yaml:"-"`
+}
+
+type ImportRowError struct {
+	Row     int    `json:"row"`
+	Field   string `json:"field,omitempty"`
+	Message string `json:"message"`
+}
+
+type ImportResult struct {
+	DryRun        bool             `json:"dry_run"`
+	Total         int              `json:"total"`
+	Categories    int              `json:"categories"`
+	Subcategories int              `json:"subcategories"`
+	Errors        []ImportRowError `json:"errors,omitempty"`
+}
+
+var importCSVColumns = []string{"slug", "label", "description", "parent_slug", "status", "meta"}
+
+// ImportFormatFromFilename guesses the import format from a file extension
+func ImportFormatFromFilename(name string) string {
+	switch strings.ToLower(filepath.Ext(name)) {
+	case ".csv":
+		return ImportFormatCSV
+	case ".yaml", ".yml":
+		return ImportFormatYAML
+	default:
+		return ""
+	}
+}
+
+// ParseImportFile reads the rows of a CSV or YAML import file.
+// CSV files need a header row naming the columns, slug and label are mandatory
+// and meta holds a JSON object. YAML files hold a list of categories, optionally
+// under a top-level "categories" key.
+func ParseImportFile(r io.Reader, format string) ([]ImportRow, error) {
+	switch format {
+	case ImportFormatCSV:
+		return parseImportCSV(r)
+	case ImportFormatYAML:
+		return parseImportYAML(r)
+	default:
+		return nil, fmt.Errorf("unsupported import format %q, expected csv or yaml", format)
+	}
+}
+
+func parseImportCSV(r io.Reader) ([]ImportRow, error) {
+	reader := csv.NewReader(r)
+	reader.TrimLeadingSpace = true
+	reader.FieldsPerRecord = -1
+
+	header, err := reader.Read()
+	if err != nil {
+		return nil, fmt.Errorf("invalid CSV header: %w", err)
+	}
+
+	columns := make(map[string]int, len(header))
+	for i, name := range header {
+		name = strings.ToLower(strings.TrimSpace(strings.TrimPrefix(name, "\ufeff")))
+		columns[name] = i
+	}
+	for _, required := range []string{"slug", "label"} {
+		if _, ok := columns[required]; !ok {
+			return nil, fmt.Errorf("invalid CSV header: missing %s column, expected %s", required, strings.Join(importCSVColumns, ","))
+		}
+	}
+
+	var rows []ImportRow
+	for rowNo := 2; ; rowNo++ {
+		record, err := reader.Read()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("row %d: %w", rowNo, err)
+		}
+
+		value := func(column string) string {
+			i, ok := columns[column]
+			if !ok || i >= len(record) {
+				return ""
+			}
+			return strings.TrimSpace(record[i])
+		}
+
+		row := ImportRow{
+			Row:         rowNo,
+			Slug:        value("slug"),
+			Label:       value("label"),
+			Description: value("description"),
+			ParentSlug:  value("parent_slug"),
+			Status:      value("status"),
+		}
+		if meta := value("meta"); meta != "" {
+			if err := json.Unmarshal([]byte(meta), &row.Meta); err != nil {
+				row.MetaError = fmt.Sprintf("meta must be a JSON object: %v", err)
+			}
+		}
+		rows = append(rows, row)
+	}
+
+	return rows, nil
+}
+
+func parseImportYAML(r io.Reader) ([]ImportRow, error) {
+	data, err := io.ReadAll(r)
+	if err != nil {
+		return nil, err
+	}
+
+	var rows []ImportRow
+	if err := yaml.Unmarshal(data, &rows); err != nil {
+		var wrapped struct {
+			Categories []ImportRow `yaml:"categories"`
+		}
+		if wrappedErr := yaml.Unmarshal(data, &wrapped); wrappedErr != nil {
+			return nil, fmt.Errorf("invalid YAML: %w", err)
+		}
+		rows = wrapped.Categories
+	}
+
+	for i := range rows {
+		rows[i].Row = i + 1
+		rows[i].Slug = strings.TrimSpace(rows[i].Slug)
+		rows[i].Label = strings.TrimSpace(rows[i].Label)
+		rows[i].ParentSlug = strings.TrimSpace(rows[i].ParentSlug)
+		rows[i].Status = strings.TrimSpace(rows[i].Status)
+	}
+
+	return rows, nil
+}
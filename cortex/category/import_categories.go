@@ -0,0 +1,221 @@
+package category
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"sort"
+	"strings"
+
+	"cortex/ent"
+	"cortex/ent/category"
+	"cortex/ent/categoryslughistory"
+	"cortex/logger"
+	customerrors "cortex/pkg/custom_errors"
+)
+
+// ImportCategories validates every row of an import before touching the database and
+// creates all categories in a single transaction. When any row is invalid nothing is
+// created and the returned result lists the per-row errors along with ErrInvalidImport.
+func (s *service) ImportCategories(ctx context.Context, params ImportCategoriesParams) (*ImportResult, error) {
+	result := &ImportResult{
+		DryRun: params.DryRun,
+		Total:  len(params.Rows),
+	}
+
+	existingParents, err := s.validateImport(ctx, params.Rows, result)
+	if err != nil {
+		return nil, err
+	}
+	if len(result.Errors) > 0 {
+		return result, customerrors.ErrInvalidImport
+	}
+	if params.DryRun {
+		return result, nil
+	}
+
+	tx, err := s.ent.Tx(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to start transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	// Parents first so the subcategories of the file can reference them
+	created := make(map[string]int, len(params.Rows))
+	positions := make(map[int]int)
+	for _, pass := range []bool{false, true} {
+		for _, row := range params.Rows {
+			if (row.ParentSlug != "") != pass {
+				continue
+			}
+
+			parentID := 0
+			if row.ParentSlug != "" {
+				if id, ok := created[row.ParentSlug]; ok {
+					parentID = id
+				} else {
+					parentID = existingParents[row.ParentSlug]
+				}
+			}
+
+			if _, ok := positions[parentID]; !ok {
+				next, err := NextPosition(ctx, tx.Client(), parentID)
+				if err != nil {
+					return nil, err
+				}
+				positions[parentID] = next
+			}
+
+			create := tx.Category.Create().
+				SetSlug(row.Slug).
+				SetLabel(row.Label).
+				SetDescription(row.Description).
+				SetCreatorID(params.CreatorID).
+				SetCreatedBy(params.CreatorID).
+				SetPosition(positions[parentID])
+			if parentID != 0 {
+				create.SetParentID(parentID)
+			}
+			if row.Status != "" {
+				create.SetStatus(category.Status(row.Status))
+			}
+			if row.Meta != nil {
+				create.SetMeta(row.Meta)
+			}
+
+			cat, err := create.Save(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("row %d: ent: category creation failed: %w", row.Row, err)
+			}
+			created[row.Slug] = cat.ID
+			positions[parentID]++
+
+			if parentID == 0 {
+				result.Categories++
+			} else {
+				result.Subcategories++
+			}
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, fmt.Errorf("failed to commit import: %w", err)
+	}
+
+	// Imports create subcategories too, which live in the same table
+	s.invalidateTranslatedListCache(ctx)
+
+	slog.InfoContext(ctx, "Categories imported", logger.Extra(map[string]any{
+		"categories":    result.Categories,
+		"subcategories": result.Subcategories,
+		"creator_id":    params.CreatorID,
+	}))
+
+	return result, nil
+}
+
+// validateImport records an error for every invalid row and returns the IDs of the
+// existing top-level categories referenced as parents, keyed by slug
+func (s *service) validateImport(ctx context.Context, rows []ImportRow, result *ImportResult) (map[string]int, error) {
+	addError := func(row int, field, message string) {
+		result.Errors = append(result.Errors, ImportRowError{Row: row, Field: field, Message: message})
+	}
+
+	if len(rows) == 0 {
+		addError(0, "", "import file contains no categories")
+		return nil, nil
+	}
+
+	inFile := make(map[string]ImportRow, len(rows))
+	slugs := make([]string, 0, len(rows))
+	var parentSlugs []string
+	for _, row := range rows {
+		if row.Slug == "" {
+			addError(row.Row, "slug", "slug is required")
+		} else if strings.ContainsAny(row.Slug, " \t/") {
+			addError(row.Row, "slug", "slug must not contain spaces or slashes")
+		} else if first, ok := inFile[row.Slug]; ok {
+			addError(row.Row, "slug", fmt.Sprintf("slug %q is already used in row %d", row.Slug, first.Row))
+		} else {
+			inFile[row.Slug] = row
+			slugs = append(slugs, row.Slug)
+		}
+
+		if row.Label == "" {
+			addError(row.Row, "label", "label is required")
+		}
+		if row.Status != "" && category.StatusValidator(category.Status(row.Status)) != nil {
+			addError(row.Row, "status", fmt.Sprintf("invalid status %q", row.Status))
+		}
+		if row.MetaError != "" {
+			addError(row.Row, "meta", row.MetaError)
+		}
+		if row.ParentSlug != "" {
+			parentSlugs = append(parentSlugs, row.ParentSlug)
+		}
+	}
+
+	// Slugs must be free, including the previous slugs of renamed categories
+	taken, err := s.ent.Category.Query().
+		Where(category.SlugIn(slugs...)).
+		Select(category.FieldSlug).
+		Strings(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("ent: existing slugs retrieval failed: %w", err)
+	}
+	reserved, err := s.ent.CategorySlugHistory.Query().
+		Where(categoryslughistory.SlugIn(slugs...)).
+		Select(categoryslughistory.FieldSlug).
+		Strings(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("ent: slug history retrieval failed: %w", err)
+	}
+	for _, slug := range append(taken, reserved...) {
+		addError(inFile[slug].Row, "slug", fmt.Sprintf("slug %q already exists", slug))
+	}
+
+	existing, err := s.ent.Category.Query().
+		Where(category.SlugIn(parentSlugs...)).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("ent: parent categories retrieval failed: %w", err)
+	}
+	existingBySlug := make(map[string]*ent.Category, len(existing))
+	for _, cat := range existing {
+		existingBySlug[cat.Slug] = cat
+	}
+
+	// Only two levels exist: a parent must itself be a top-level category
+	existingParents := make(map[string]int)
+	for _, row := range rows {
+		if row.ParentSlug == "" {
+			continue
+		}
+		if row.ParentSlug == row.Slug {
+			addError(row.Row, "parent_slug", "a category cannot be its own parent")
+			continue
+		}
+		if parent, ok := inFile[row.ParentSlug]; ok {
+			if parent.ParentSlug != "" {
+				addError(row.Row, "parent_slug", fmt.Sprintf("parent %q is a subcategory itself", row.ParentSlug))
+			}
+			continue
+		}
+		parent, ok := existingBySlug[row.ParentSlug]
+		if !ok {
+			addError(row.Row, "parent_slug", fmt.Sprintf("parent %q not found", row.ParentSlug))
+			continue
+		}
+		if parent.ParentID != 0 {
+			addError(row.Row, "parent_slug", fmt.Sprintf("parent %q is a subcategory itself", row.ParentSlug))
+			continue
+		}
+		existingParents[row.ParentSlug] = parent.ID
+	}
+
+	sort.SliceStable(result.Errors, func(i, j int) bool {
+		return result.Errors[i].Row < result.Errors[j].Row
+	})
+
+	return existingParents, nil
+}
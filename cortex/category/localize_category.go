@@ -0,0 +1,26 @@
+package category
+
+import (
+	"context"
+
+	"cortex/ent"
+)
+
+// LocalizeCategory replaces the label and description of the category with its
+// translation in the most preferred available locale and returns that locale.
+// An empty locale means the tenant default text is kept.
+func (s *service) LocalizeCategory(ctx context.Context, cat *ent.Category, locales []string) (string, error) {
+	translations, err := BestTranslations(ctx, s.ent, []int{cat.ID}, locales)
+	if err != nil {
+		return "", err
+	}
+
+	t, ok := translations[cat.ID]
+	if !ok {
+		return "", nil
+	}
+
+	cat.Label = t.Label
+	cat.Description = t.Description
+	return t.Locale, nil
+}
@@ -7,6 +7,7 @@ import (
 	"cortex/ent"
 
 	"github.com/google/uuid"
+	"github.com/redis/go-redis/v9"
 )
 
 type Service interface {
@@ -16,7 +17,17 @@ type Service interface {
 	FindCategoryByID(ctx context.Context, id int) (*ent.Category, error)
 	DeleteCategoryByUUID(ctx context.Context, uuid uuid.UUID) error
 	GetCategoryList(ctx context.Context, filter GetCategoryFilter) ([]*Category, error)
-	UpdateCategory(ctx context.Context, params UpdateCategoryParams) error
+	UpdateCategory(ctx context.Context, params UpdateCategoryParams) (*ent.Category, error)
+	ReorderCategories(ctx context.Context, params ReorderCategoriesParams) error
+	ImportCategories(ctx context.Context, params ImportCategoriesParams) (*ImportResult, error)
+	GetCategoryTranslations(ctx context.Context, uuid uuid.UUID) ([]*Translation, error)
+	UpsertCategoryTranslation(ctx context.Context, params UpsertTranslationParams) (*Translation, error)
+	DeleteCategoryTranslation(ctx context.Context, uuid uuid.UUID, locale string) error
+	LocalizeCategory(ctx context.Context, cat *ent.Category, locales []string) (string, error)
+	HandlePostViewed(ctx context.Context, body []byte) error
+	RecordPostView(ctx context.Context, event PostViewedEvent) error
+	GetTopPosts(ctx context.Context, categoryUUID uuid.UUID, window string, limit int) ([]*TopPost, error)
+	RebuildTopPosts(ctx context.Context) error
 }
 
 type Cache interface {
@@ -27,9 +38,13 @@ type Cache interface {
 	Get(ctx context.Context, key string) (string, error)
 	SetJSON(ctx context.Context, key string, value any, expiration time.Duration) error
 	ZAdd(ctx context.Context, key string, expiration time.Duration, members ...any) error
+	ZIncrBy(ctx context.Context, key string, expiration time.Duration, increment float64, member string) error
+	ZRevRangeWithScores(ctx context.Context, key string, start, stop int64) ([]redis.Z, error)
+	KeyExists(ctx context.Context, key string) (bool, error)
 	CategoryUUIDKey(uuid uuid.UUID) string
 	CategoryObjectKey(uuid uuid.UUID) string
 	CategoryTopPostsKey(uuid uuid.UUID) string
 	Del(ctx context.Context, key string) error
+	DelPattern(ctx context.Context, pattern string) error
 	FlushAll(ctx context.Context) error
 }
@@ -0,0 +1,146 @@
+package category
+
+import (
+	"context"
+	"fmt"
+
+	"cortex/ent"
+	"cortex/ent/category"
+	"cortex/ent/predicate"
+	customerrors "cortex/pkg/custom_errors"
+
+	"github.com/google/uuid"
+)
+
+// siblingLockClass is the first key of the advisory locks that serialize position
+// changes among siblings, the second key is the parent ID
+const siblingLockClass = 727
+
+// siblingsOf matches the live categories sharing the given parent.
+// A parentID of 0 means top-level categories.
+func siblingsOf(parentID int) predicate.Category {
+	parent := category.ParentIDEQ(parentID)
+	if parentID == 0 {
+		parent = category.ParentIDIsNil()
+	}
+	return category.And(parent, category.StatusNEQ(category.StatusDeleted))
+}
+
+// lockSiblings holds the position lock of the children of parentID until the
+// transaction of client ends
+func lockSiblings(ctx context.Context, client *ent.Client, parentID int) error {
+	if _, err := client.ExecContext(ctx, "SELECT pg_advisory_xact_lock($1, $2)", siblingLockClass, parentID); err != nil {
+		return fmt.Errorf("failed to lock sibling positions: %w", err)
+	}
+	return nil
+}
+
+// backfillPositionsQuery numbers the categories that never got a position after
+// the positioned siblings, oldest first. Positions start at 1, so 0 only marks
+// rows created before the column existed.
+const backfillPositionsQuery = `
+UPDATE categories SET position = numbered.position
+FROM (
+	SELECT id, ROW_NUMBER() OVER (PARTITION BY COALESCE(parent_id, 0) ORDER BY created_at, id) + COALESCE((
+		SELECT MAX(positioned.position) FROM categories positioned
+		WHERE COALESCE(positioned.parent_id, 0) = COALESCE(unpositioned.parent_id, 0)
+	), 0) AS position
+	FROM categories unpositioned
+	WHERE position = 0
+) numbered
+WHERE categories.id = numbered.id`
+
+// BackfillPositions gives the categories created before manual ordering existed
+// the order they were listed in until then, it does nothing once every category
+// has a position. It returns the number of categories numbered.
+func BackfillPositions(ctx context.Context, client *ent.Client) (int64, error) {
+	result, err := client.ExecContext(ctx, backfillPositionsQuery)
+	if err != nil {
+		return 0, fmt.Errorf("failed to backfill category positions: %w", err)
+	}
+	return result.RowsAffected()
+}
+
+// NextPosition returns the position for a new category appended after its siblings.
+// client must be transactional, the siblings stay locked until the category is
+// created in the same transaction so concurrent creates get distinct positions.
+func NextPosition(ctx context.Context, client *ent.Client, parentID int) (int, error) {
+	if err := lockSiblings(ctx, client, parentID); err != nil {
+		return 0, err
+	}
+
+	var result []struct {
+		Max *int `json:"max"`
+	}
+	err := client.Category.Query().
+		Where(siblingsOf(parentID)).
+		Aggregate(ent.Max(category.FieldPosition)).
+		Scan(ctx, &result)
+	if err != nil {
+		return 0, fmt.Errorf("failed to get max position: %w", err)
+	}
+
+	if len(result) == 0 || result[0].Max == nil {
+		return 1, nil
+	}
+	return *result[0].Max + 1, nil
+}
+
+// Reorder assigns positions to the children of parentID following the given order
+// in a single transaction. Siblings that are not listed keep their relative order
+// and are placed after the listed ones, deleted ones are left alone.
+func Reorder(ctx context.Context, client *ent.Client, parentID int, uuids []uuid.UUID, updatedBy int) error {
+	tx, err := client.Tx(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to start transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	if err := lockSiblings(ctx, tx.Client(), parentID); err != nil {
+		return err
+	}
+
+	siblings, err := tx.Category.Query().
+		Where(siblingsOf(parentID)).
+		Order(ent.Asc(category.FieldPosition), ent.Asc(category.FieldCreatedAt)).
+		All(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to load sibling categories: %w", err)
+	}
+
+	byUUID := make(map[string]*ent.Category, len(siblings))
+	for _, c := range siblings {
+		byUUID[c.UUID] = c
+	}
+
+	ordered := make([]*ent.Category, 0, len(siblings))
+	listed := make(map[string]bool, len(uuids))
+	for _, u := range uuids {
+		c, ok := byUUID[u.String()]
+		if !ok || listed[c.UUID] {
+			return customerrors.ErrInvalidCategoryOrder
+		}
+		listed[c.UUID] = true
+		ordered = append(ordered, c)
+	}
+	for _, c := range siblings {
+		if !listed[c.UUID] {
+			ordered = append(ordered, c)
+		}
+	}
+
+	for i, c := range ordered {
+		position := i + 1
+		if c.Position == position {
+			continue
+		}
+		if err := tx.Category.UpdateOneID(c.ID).
+			SetPosition(position).
+			SetUpdatedBy(updatedBy).
+			Exec(ctx); err != nil {
+			return fmt.Errorf("failed to update category position: %w", err)
+		}
+	}
+
+	return tx.Commit()
+}
@@ -0,0 +1,115 @@
+package category
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"strconv"
+	"time"
+
+	"cortex/ent"
+	"cortex/ent/category"
+	"cortex/logger"
+
+	"github.com/google/uuid"
+	"github.com/redis/go-redis/v9"
+)
+
+// topPostsStatsHalfLives is how far back views are loaded on a rebuild,
+// older views weigh less than 2^-8 and are ignored
+const topPostsStatsHalfLives = 8
+
+// RebuildTopPosts rebuilds every missing leaderboard from postal's daily view stats.
+// It runs periodically so leaderboards recover after a Redis flush and
+// new generations are filled before views are added to them.
+func (s *service) RebuildTopPosts(ctx context.Context) error {
+	if s.cache == nil {
+		return nil
+	}
+
+	categories, err := s.ent.Category.Query().
+		Where(category.StatusNEQ(category.StatusDeleted)).
+		All(ctx)
+	if err != nil {
+		return fmt.Errorf("ent: categories retrieval failed: %w", err)
+	}
+
+	for _, cat := range categories {
+		if err := s.rebuildCategoryTopPosts(ctx, cat); err != nil {
+			slog.WarnContext(ctx, "Failed to rebuild category top posts", logger.Extra(map[string]any{
+				"category_id": cat.ID,
+				"error":       err.Error(),
+			}))
+		}
+	}
+
+	return nil
+}
+
+// rebuildCategoryTopPosts rebuilds the missing leaderboards of one category, for all windows
+func (s *service) rebuildCategoryTopPosts(ctx context.Context, cat *ent.Category) error {
+	categoryUUID, err := uuid.Parse(cat.UUID)
+	if err != nil {
+		return err
+	}
+
+	now := time.Now()
+	missing := map[string]string{}
+	var longest time.Duration
+	for window, halfLife := range TopPostsWindows {
+		generation, _ := topPostsLandmark(halfLife, now)
+		key := s.topPostsKey(categoryUUID, window, generation)
+
+		exists, err := s.cache.KeyExists(ctx, key)
+		if err != nil {
+			return err
+		}
+		if !exists {
+			missing[window] = key
+			longest = max(longest, halfLife)
+		}
+	}
+	if len(missing) == 0 {
+		return nil
+	}
+
+	stats, err := s.fetchViewStats(ctx, cat.ID, now.Add(-topPostsStatsHalfLives*longest))
+	if err != nil {
+		return err
+	}
+
+	for window, key := range missing {
+		halfLife := TopPostsWindows[window]
+		_, landmark := topPostsLandmark(halfLife, now)
+
+		scores := map[int]float64{}
+		for _, stat := range stats {
+			// Daily counts are spread over the day, weigh them at midday
+			viewedAt := stat.Day.Add(12 * time.Hour)
+			if viewedAt.After(now) {
+				viewedAt = now
+			}
+			if now.Sub(viewedAt) > topPostsStatsHalfLives*halfLife {
+				continue
+			}
+			scores[stat.PostID] += float64(stat.Count) * topPostsWeight(halfLife, landmark, viewedAt)
+		}
+
+		members := make([]any, 0, len(scores))
+		for postID, score := range scores {
+			members = append(members, redis.Z{Score: score, Member: strconv.Itoa(postID)})
+		}
+
+		// An empty leaderboard gets a placeholder member so live views start counting
+		if err := s.cache.ZAdd(ctx, key, topPostsTTL(halfLife), members...); err != nil {
+			return err
+		}
+	}
+
+	slog.InfoContext(ctx, "Category top posts rebuilt", logger.Extra(map[string]any{
+		"category_id": cat.ID,
+		"windows":     len(missing),
+	}))
+
+	return nil
+}
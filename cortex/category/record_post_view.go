@@ -0,0 +1,82 @@
+package category
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"strconv"
+	"time"
+
+	"cortex/ent/category"
+	"cortex/logger"
+
+	"github.com/google/uuid"
+)
+
+// HandlePostViewed consumes a post.viewed event from postal
+func (s *service) HandlePostViewed(ctx context.Context, body []byte) error {
+	var event PostViewedEvent
+	if err := json.Unmarshal(body, &event); err != nil {
+		slog.ErrorContext(ctx, "Failed to decode post.viewed event", logger.Extra(map[string]any{
+			"error": err.Error(),
+		}))
+		return err
+	}
+
+	return s.RecordPostView(ctx, event)
+}
+
+// RecordPostView adds the view to the leaderboards of the post's category and subcategory.
+// Leaderboards that do not exist yet are skipped, they are rebuilt from postal's
+// daily view stats which already contain this view.
+func (s *service) RecordPostView(ctx context.Context, event PostViewedEvent) error {
+	if s.cache == nil || event.PostID == 0 {
+		return nil
+	}
+
+	ids := []int{event.CategoryID}
+	if event.SubCategoryID != nil {
+		ids = append(ids, *event.SubCategoryID)
+	}
+
+	uuids, err := s.ent.Category.Query().
+		Where(category.IDIn(ids...)).
+		Select(category.FieldUUID).
+		Strings(ctx)
+	if err != nil {
+		return fmt.Errorf("ent: categories of viewed post retrieval failed: %w", err)
+	}
+
+	viewedAt := event.ViewedAt
+	if viewedAt.IsZero() {
+		viewedAt = time.Now()
+	}
+	member := strconv.Itoa(event.PostID)
+
+	for _, u := range uuids {
+		categoryUUID, err := uuid.Parse(u)
+		if err != nil {
+			continue
+		}
+
+		for window, halfLife := range TopPostsWindows {
+			generation, landmark := topPostsLandmark(halfLife, viewedAt)
+			key := s.topPostsKey(categoryUUID, window, generation)
+
+			exists, err := s.cache.KeyExists(ctx, key)
+			if err != nil {
+				return err
+			}
+			if !exists {
+				continue
+			}
+
+			if err := s.cache.ZIncrBy(ctx, key, topPostsTTL(halfLife), topPostsWeight(halfLife, landmark, viewedAt), member); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
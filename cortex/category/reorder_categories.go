@@ -0,0 +1,18 @@
+package category
+
+import (
+	"context"
+)
+
+func (s *service) ReorderCategories(ctx context.Context, params ReorderCategoriesParams) error {
+	if err := Reorder(ctx, s.ent, 0, params.UUIDs, params.UpdatedBy); err != nil {
+		return err
+	}
+
+	// Invalidate category list cache so the new order shows immediately
+	if s.cache != nil {
+		s.invalidateCategoryListCache(ctx)
+	}
+
+	return nil
+}
@@ -0,0 +1,55 @@
+package category
+
+import (
+	"context"
+	"fmt"
+
+	"cortex/ent"
+	"cortex/ent/categoryslughistory"
+)
+
+// RecordSlugChange stores oldSlug as a historical slug of the given category so that
+// links using it can be redirected to the canonical slug.
+// It should be called with a transactional client together with the slug update.
+func RecordSlugChange(ctx context.Context, client *ent.Client, categoryID int, oldSlug, newSlug string, changedBy int) error {
+	// A historical slug can only point to one category, the latest rename wins.
+	// The new slug is live again, so it must not stay in the history either.
+	if _, err := client.CategorySlugHistory.Delete().
+		Where(categoryslughistory.SlugIn(oldSlug, newSlug)).
+		Exec(ctx); err != nil {
+		return fmt.Errorf("failed to clean up slug history: %w", err)
+	}
+
+	create := client.CategorySlugHistory.Create().
+		SetCategoryID(categoryID).
+		SetSlug(oldSlug)
+	if changedBy != 0 {
+		create.SetChangedBy(changedBy)
+	}
+
+	if err := create.Exec(ctx); err != nil {
+		return fmt.Errorf("failed to record slug history: %w", err)
+	}
+
+	return nil
+}
+
+// HistoricalSlugs returns every previous slug of the given category
+func HistoricalSlugs(ctx context.Context, client *ent.Client, categoryID int) ([]string, error) {
+	return client.CategorySlugHistory.Query().
+		Where(categoryslughistory.CategoryIDEQ(categoryID)).
+		Select(categoryslughistory.FieldSlug).
+		Strings(ctx)
+}
+
+// findCategoryBySlugHistory resolves a previous slug to its current category
+func (s *service) findCategoryBySlugHistory(ctx context.Context, slug string) (*ent.Category, error) {
+	history, err := s.ent.CategorySlugHistory.Query().
+		Where(categoryslughistory.SlugEQ(slug)).
+		Only(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.ent.Category.Get(ctx, history.CategoryID)
+}
@@ -0,0 +1,67 @@
+package category
+
+import (
+	"fmt"
+	"math"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// PostViewedRoutingKey is the routing key postal publishes post views with
+const PostViewedRoutingKey = "post.viewed"
+
+const DefaultTopPostsWindow = "7d"
+
+// TopPostsWindows maps the supported leaderboard windows to the half-life of a view,
+// a view counts half as much once a window has passed since it happened
+var TopPostsWindows = map[string]time.Duration{
+	"1d":  24 * time.Hour,
+	"7d":  7 * 24 * time.Hour,
+	"30d": 30 * 24 * time.Hour,
+}
+
+// Scores use forward decay: a view at time t adds 2^((t-landmark)/halfLife), so older
+// views never need to be rewritten. To keep scores bounded the landmark moves every
+// topPostsGenerationHalfLives half-lives, which starts a new key that is rebuilt from postal.
+const topPostsGenerationHalfLives = 32
+
+// topPostsInitMember is the placeholder ZAdd stores in an empty leaderboard
+const topPostsInitMember = "__init__"
+
+var topPostsEpoch = time.Date(2024, time.January, 1, 0, 0, 0, 0, time.UTC)
+
+// PostViewedEvent is the payload of the post.viewed events published by postal
+type PostViewedEvent struct {
+	PostID        int       `json:"post_id"`
+	PostUUID      string    `json:"post_uuid"`
+	CategoryID    int       `json:"category_id"`
+	SubCategoryID *int      `json:"sub_category_id,omitempty"`
+	ViewedAt      time.Time `json:"viewed_at"`
+}
+
+type TopPost struct {
+	PostID int     `json:"post_id"`
+	Score  float64 `json:"score"`
+}
+
+// topPostsLandmark returns the generation and its landmark time for a view at t
+func topPostsLandmark(halfLife time.Duration, t time.Time) (int64, time.Time) {
+	generationLength := topPostsGenerationHalfLives * halfLife
+	generation := int64(t.Sub(topPostsEpoch) / generationLength)
+	return generation, topPostsEpoch.Add(time.Duration(generation) * generationLength)
+}
+
+// topPostsWeight is the decayed weight a view at t carries relative to the landmark
+func topPostsWeight(halfLife time.Duration, landmark, t time.Time) float64 {
+	return math.Exp2(float64(t.Sub(landmark)) / float64(halfLife))
+}
+
+// topPostsTTL keeps a leaderboard for its whole generation plus one half-life
+func topPostsTTL(halfLife time.Duration) time.Duration {
+	return (topPostsGenerationHalfLives + 1) * halfLife
+}
+
+func (s *service) topPostsKey(categoryUUID uuid.UUID, window string, generation int64) string {
+	return fmt.Sprintf("%s:%s:g%d", s.cache.CategoryTopPostsKey(categoryUUID), window, generation)
+}
@@ -0,0 +1,165 @@
+package category
+
+import (
+	"context"
+	"encoding/json"
+	"regexp"
+	"strings"
+	"time"
+
+	"cortex/ent"
+	"cortex/ent/categorytranslation"
+)
+
+var localePattern = regexp.MustCompile(`^[a-z]{2,3}(-[a-z0-9]{2,8})*$`)
+
+const (
+	// TranslationLocalesKey caches the locales at least one category is translated into
+	TranslationLocalesKey = "category:translation:locales"
+	// maxResolvedLocales bounds the fallback chain a request can ask for
+	maxResolvedLocales = 3
+)
+
+// LocaleCache is the part of the cache ResolveLocales needs
+type LocaleCache interface {
+	Get(ctx context.Context, key string) (string, error)
+	SetJSON(ctx context.Context, key string, value any, expiration time.Duration) error
+}
+
+// NormalizeLocale lower-cases a language tag and uses "-" as separator, "bn_BD" becomes "bn-bd".
+// It returns an empty string when the tag is not a valid locale.
+func NormalizeLocale(locale string) string {
+	locale = strings.ToLower(strings.ReplaceAll(strings.TrimSpace(locale), "_", "-"))
+	if !localePattern.MatchString(locale) {
+		return ""
+	}
+	return locale
+}
+
+// ExpandLocales normalizes the preferred locales and adds the base language after
+// each regional tag, so "bn-bd" also matches a "bn" translation
+func ExpandLocales(locales []string) []string {
+	seen := make(map[string]bool, len(locales))
+	expanded := make([]string, 0, len(locales)*2)
+	add := func(locale string) {
+		if locale != "" && !seen[locale] {
+			seen[locale] = true
+			expanded = append(expanded, locale)
+		}
+	}
+
+	for _, locale := range locales {
+		locale = NormalizeLocale(locale)
+		add(locale)
+		if base, _, found := strings.Cut(locale, "-"); found {
+			add(base)
+		}
+	}
+	return expanded
+}
+
+// ResolveLocales narrows the preferred locales to the ones some category is
+// translated into, keeping their order. Lists are cached per resolved locales,
+// so whatever a client sends in Accept-Language cannot grow the key space.
+func ResolveLocales(ctx context.Context, client *ent.Client, c LocaleCache, locales []string) ([]string, error) {
+	locales = ExpandLocales(locales)
+	if len(locales) == 0 {
+		return nil, nil
+	}
+
+	available, err := translationLocales(ctx, client, c)
+	if err != nil {
+		return nil, err
+	}
+
+	resolved := make([]string, 0, maxResolvedLocales)
+	for _, locale := range locales {
+		if available[locale] {
+			resolved = append(resolved, locale)
+			if len(resolved) == maxResolvedLocales {
+				break
+			}
+		}
+	}
+	return resolved, nil
+}
+
+// translationLocales returns the set of locales that have translations
+func translationLocales(ctx context.Context, client *ent.Client, c LocaleCache) (map[string]bool, error) {
+	var locales []string
+	if c != nil {
+		if cached, err := c.Get(ctx, TranslationLocalesKey); err == nil && cached != "" {
+			if err := json.Unmarshal([]byte(cached), &locales); err != nil {
+				locales = nil
+			}
+		}
+	}
+
+	if locales == nil {
+		var err error
+		locales, err = client.CategoryTranslation.Query().
+			Unique(true).
+			Select(categorytranslation.FieldLocale).
+			Strings(ctx)
+		if err != nil {
+			return nil, err
+		}
+		if locales == nil {
+			locales = []string{}
+		}
+		if c != nil {
+			_ = c.SetJSON(ctx, TranslationLocalesKey, locales, time.Hour)
+		}
+	}
+
+	available := make(map[string]bool, len(locales))
+	for _, locale := range locales {
+		available[locale] = true
+	}
+	return available, nil
+}
+
+// BestTranslations returns, per category ID, the translation matching the most preferred
+// of the given locales. Categories without any matching translation are left out.
+func BestTranslations(ctx context.Context, client *ent.Client, categoryIDs []int, locales []string) (map[int]*ent.CategoryTranslation, error) {
+	result := make(map[int]*ent.CategoryTranslation)
+	locales = ExpandLocales(locales)
+	if len(categoryIDs) == 0 || len(locales) == 0 {
+		return result, nil
+	}
+
+	translations, err := client.CategoryTranslation.Query().
+		Where(
+			categorytranslation.CategoryIDIn(categoryIDs...),
+			categorytranslation.LocaleIn(locales...),
+		).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	rank := make(map[string]int, len(locales))
+	for i, locale := range locales {
+		rank[locale] = i
+	}
+	for _, t := range translations {
+		current, ok := result[t.CategoryID]
+		if !ok || rank[t.Locale] < rank[current.Locale] {
+			result[t.CategoryID] = t
+		}
+	}
+
+	return result, nil
+}
+
+func toTranslation(t *ent.CategoryTranslation) *Translation {
+	return &Translation{
+		Locale:      t.Locale,
+		Label:       t.Label,
+		Description: t.Description,
+		CreatedBy:   t.CreatedBy,
+		UpdatedBy:   t.UpdatedBy,
+		CreatedAt:   t.CreatedAt,
+		UpdatedAt:   t.UpdatedAt,
+	}
+}
@@ -5,26 +5,39 @@ import (
 	"encoding/json"
 	"errors"
 
+	"cortex/ent"
 	"cortex/ent/category"
 	customerrors "cortex/pkg/custom_errors"
 )
 
-func (s *service) UpdateCategory(ctx context.Context, params UpdateCategoryParams) error {
+func (s *service) UpdateCategory(ctx context.Context, params UpdateCategoryParams) (*ent.Category, error) {
 	// 1. Find the category by the current slug
 	cat, err := s.FindCategoryBySlug(ctx, *params.Slug)
 	if err != nil {
-		return customerrors.ErrCategoryNotFound
+		return nil, customerrors.ErrCategoryNotFound
 	}
 
-	update := s.ent.Category.UpdateOne(cat)
+	tx, err := s.ent.Tx(ctx)
+	if err != nil {
+		return nil, errors.New("ent: failed to start transaction")
+	}
+	defer tx.Rollback()
+
+	update := tx.Category.UpdateOne(cat)
+	if params.ExpectedUpdatedAt != nil {
+		update.Where(category.UpdatedAtEQ(*params.ExpectedUpdatedAt))
+	}
 
-	// 2. Check if the new slug is provided and different from current
+	// 2. Check if the new slug is provided and different from current.
+	// A category may take back one of its own previous slugs.
+	slugChanged := false
 	if params.NewSlug != nil && *params.NewSlug != cat.Slug {
 		existing, _ := s.FindCategoryBySlug(ctx, *params.NewSlug)
-		if existing != nil {
-			return customerrors.ErrSlugExists
+		if existing != nil && existing.ID != cat.ID {
+			return nil, customerrors.ErrSlugExists
 		}
 		update.SetSlug(*params.NewSlug)
+		slugChanged = true
 	}
 
 	// 3. Optional fields
@@ -40,7 +53,7 @@ func (s *service) UpdateCategory(ctx context.Context, params UpdateCategoryParam
 	if params.Meta != nil {
 		var meta map[string]interface{}
 		if err := json.Unmarshal(params.Meta, &meta); err != nil {
-			return errors.New("invalid meta JSON")
+			return nil, errors.New("invalid meta JSON")
 		}
 		update.SetMeta(meta)
 	}
@@ -55,16 +68,44 @@ func (s *service) UpdateCategory(ctx context.Context, params UpdateCategoryParam
 		update.SetApprovedAt(params.ApprovedAt)
 	}
 
-	// 5. Save changes
-	_, err = update.Save(ctx)
+	// 5. Save changes and keep the old slug so existing links can be redirected
+	updated, err := update.Save(ctx)
 	if err != nil {
-		return errors.New("ent: category update failed")
+		if ent.IsNotFound(err) && params.ExpectedUpdatedAt != nil {
+			return nil, s.categoryModified(ctx, cat.ID)
+		}
+		return nil, errors.New("ent: category update failed")
+	}
+
+	if slugChanged {
+		if err := RecordSlugChange(ctx, tx.Client(), cat.ID, cat.Slug, *params.NewSlug, params.UpdatedBy); err != nil {
+			return nil, errors.New("ent: category slug history update failed")
+		}
 	}
 
-	// Invalidate category list cache to reflect updates immediately
+	if err := tx.Commit(); err != nil {
+		return nil, errors.New("ent: category update failed")
+	}
+
+	// Invalidate category caches to reflect updates immediately
 	if s.cache != nil {
+		if params.NewSlug != nil {
+			s.invalidateCategoryCache(ctx, cat, *params.NewSlug)
+		} else {
+			s.invalidateCategoryCache(ctx, cat)
+		}
 		s.invalidateCategoryListCache(ctx)
 	}
 
-	return nil
+	return updated, nil
+}
+
+// categoryModified reads the stored revision for the conflict error, the cached
+// category the update started from may be the stale one
+func (s *service) categoryModified(ctx context.Context, id int) error {
+	current, err := s.ent.Category.Get(ctx, id)
+	if err != nil {
+		return customerrors.ErrCategoryNotFound
+	}
+	return &customerrors.ModifiedError{Resource: "category", Current: current.UpdatedAt}
 }
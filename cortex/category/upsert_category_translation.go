@@ -0,0 +1,62 @@
+package category
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+
+	"cortex/ent"
+	"cortex/ent/categorytranslation"
+	"cortex/logger"
+	customerrors "cortex/pkg/custom_errors"
+)
+
+func (s *service) UpsertCategoryTranslation(ctx context.Context, params UpsertTranslationParams) (*Translation, error) {
+	locale := NormalizeLocale(params.Locale)
+	if locale == "" {
+		return nil, customerrors.ErrInvalidLocale
+	}
+
+	cat, err := s.FindCategoryByUUID(ctx, params.CategoryUUID)
+	if err != nil {
+		return nil, customerrors.ErrCategoryNotFound
+	}
+
+	existing, err := s.ent.CategoryTranslation.Query().
+		Where(
+			categorytranslation.CategoryIDEQ(cat.ID),
+			categorytranslation.LocaleEQ(locale),
+		).
+		Only(ctx)
+
+	var saved *ent.CategoryTranslation
+	switch {
+	case err == nil:
+		saved, err = existing.Update().
+			SetLabel(params.Label).
+			SetDescription(params.Description).
+			SetUpdatedBy(params.UpdatedBy).
+			Save(ctx)
+	case ent.IsNotFound(err):
+		saved, err = s.ent.CategoryTranslation.Create().
+			SetCategoryID(cat.ID).
+			SetLocale(locale).
+			SetLabel(params.Label).
+			SetDescription(params.Description).
+			SetCreatedBy(params.UpdatedBy).
+			SetUpdatedBy(params.UpdatedBy).
+			Save(ctx)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("ent: category translation save failed: %w", err)
+	}
+
+	s.invalidateTranslatedListCache(ctx)
+
+	slog.InfoContext(ctx, "Category translation saved", logger.Extra(map[string]any{
+		"category_id": cat.ID,
+		"locale":      locale,
+	}))
+
+	return toTranslation(saved), nil
+}
@@ -0,0 +1,66 @@
+package category
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"cortex/auth"
+)
+
+// viewStat is a daily view count of a post as served by postal
+type viewStat struct {
+	PostID int       `json:"post_id"`
+	Day    time.Time `json:"day"`
+	Count  int       `json:"count"`
+}
+
+var postalHTTPClient = &http.Client{Timeout: 10 * time.Second}
+
+// fetchViewStats loads the daily view counts of the posts in a category from postal
+func (s *service) fetchViewStats(ctx context.Context, categoryID int, since time.Time) ([]viewStat, error) {
+	if s.cnf == nil || s.cnf.PostalURL == "" {
+		return nil, nil
+	}
+
+	query := url.Values{}
+	query.Set("category_id", strconv.Itoa(categoryID))
+	query.Set("since", since.UTC().Format(time.RFC3339))
+	endpoint := strings.TrimRight(s.cnf.PostalURL, "/") + "/api/v1/posts/view-stats?" + query.Encode()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, endpoint, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	// Postal only serves view stats to service tokens, both services share the JWT secret
+	token, err := auth.GenerateServiceToken("cortex", s.cnf.JwtSecret, time.Minute)
+	if err != nil {
+		return nil, fmt.Errorf("postal: failed to sign view stats request: %w", err)
+	}
+	req.Header.Set("Authorization", "Bearer "+token)
+
+	resp, err := postalHTTPClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("postal: view stats request failed: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("postal: view stats request failed with status %d", resp.StatusCode)
+	}
+
+	var body struct {
+		Data []viewStat `json:"data"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&body); err != nil {
+		return nil, fmt.Errorf("postal: failed to decode view stats: %w", err)
+	}
+
+	return body.Data, nil
+}
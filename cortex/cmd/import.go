@@ -0,0 +1,115 @@
+package cmd
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"os"
+
+	"cortex/cache"
+	"cortex/category"
+	"cortex/config"
+	"cortex/ent"
+	"cortex/ent/migrate"
+	"cortex/logger"
+	customerrors "cortex/pkg/custom_errors"
+
+	_ "github.com/lib/pq"
+	"github.com/spf13/cobra"
+)
+
+func ImportCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "import",
+		Short: "Bulk import data from files",
+	}
+	cmd.AddCommand(importCategoriesCommand())
+	return cmd
+}
+
+func importCategoriesCommand() *cobra.Command {
+	var (
+		format    string
+		creatorID int
+		dryRun    bool
+	)
+
+	cmd := &cobra.Command{
+		Use:   "categories <file>",
+		Short: "Import categories and subcategories from a CSV or YAML file",
+		Long: "Validates the whole file first and creates every category in one transaction.\n" +
+			"CSV columns: slug,label,description,parent_slug,status,meta (meta is a JSON object).\n" +
+			"YAML: a list of objects with the same keys. Subcategories reference their parent by slug.",
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := context.Background()
+			cnf := config.GetConfig()
+
+			logger.SetupLogger(cnf.ServiceName)
+
+			if format == "" {
+				format = category.ImportFormatFromFilename(args[0])
+			}
+
+			file, err := os.Open(args[0])
+			if err != nil {
+				return err
+			}
+			defer file.Close()
+
+			rows, err := category.ParseImportFile(file, format)
+			if err != nil {
+				return err
+			}
+
+			entClient, err := ent.Open(cnf.BGCE_DB_DRIVER, cnf.BGCE_DB_DSN)
+			if err != nil {
+				slog.Error("Failed to connect to database", slog.Any("error", err))
+				return err
+			}
+			defer entClient.Close()
+
+			if err := entClient.Schema.Create(ctx, migrate.WithDropIndex(true), migrate.WithDropColumn(true)); err != nil {
+				slog.Error("Failed to run migrations", slog.Any("error", err))
+				return err
+			}
+			if _, err := category.BackfillPositions(ctx, entClient); err != nil {
+				slog.Error("Failed to backfill category positions", slog.Any("error", err))
+				return err
+			}
+
+			// Redis is only needed to drop stale category lists, the import works without it
+			var categoryCache category.Cache
+			redisClient, err := cache.NewRedisClient(cnf.WriteRedisURL, cnf.EnableRedisTLSMode, true)
+			if err != nil {
+				slog.Warn("Redis unavailable, category list caches will not be invalidated", slog.Any("error", err))
+			} else {
+				defer redisClient.Close()
+				categoryCache = cache.NewCache(redisClient, redisClient)
+			}
+
+			svc := category.NewService(cnf, nil, categoryCache, entClient)
+			result, err := svc.ImportCategories(ctx, category.ImportCategoriesParams{
+				Rows:      rows,
+				CreatorID: creatorID,
+				DryRun:    dryRun,
+			})
+			if result != nil {
+				out, _ := json.MarshalIndent(result, "", "  ")
+				fmt.Println(string(out))
+			}
+			if errors.Is(err, customerrors.ErrInvalidImport) {
+				return fmt.Errorf("%w: %d error(s), nothing was imported", err, len(result.Errors))
+			}
+			return err
+		},
+	}
+
+	cmd.Flags().StringVar(&format, "format", "", "file format, csv or yaml (default: from the file extension)")
+	cmd.Flags().IntVar(&creatorID, "creator-id", 1, "user ID recorded as creator of the imported categories")
+	cmd.Flags().BoolVar(&dryRun, "dry-run", false, "only validate the file")
+
+	return cmd
+}
@@ -6,6 +6,7 @@ import (
 	"log/slog"
 	"net"
 	"net/http"
+	"time"
 
 	"cortex/apm"
 	"cortex/cache"
@@ -30,6 +31,8 @@ import (
 	"go.elastic.co/apm/module/apmhttp"
 )
 
+const topPostsRebuildInterval = 5 * time.Minute
+
 func APIServerCommand(ctx context.Context) *cobra.Command {
 	return &cobra.Command{
 		Use:   "serve-rest",
@@ -62,6 +65,10 @@ func APIServerCommand(ctx context.Context) *cobra.Command {
 				slog.Error("Failed to create schema:", slog.Any("error", err))
 				return err
 			}
+			if _, err := category.BackfillPositions(backgroundContext, entClient); err != nil {
+				slog.Error("Failed to backfill category positions:", slog.Any("error", err))
+				return err
+			}
 
 			readRedisClient, err := cache.NewRedisClient(cnf.ReadRedisURL, cnf.EnableRedisTLSMode, false)
 			if err != nil {
@@ -116,6 +123,37 @@ func APIServerCommand(ctx context.Context) *cobra.Command {
 			ctgrySvc := category.NewService(cnf, rmq, redisCache, entClient)
 			subcategorySvc := subcategory.NewService(cnf, rmq, redisCache, entClient)
 
+			queuePrefix := cnf.RmqQueuePrefix
+			if queuePrefix == "" {
+				queuePrefix = cnf.ServiceName
+			}
+			if err := rmq.Client.AddConsumer(rabbitmq.ConsumerOption{
+				Exchange:      rabbitmq.PostalExchange,
+				RoutingKey:    category.PostViewedRoutingKey,
+				Queue:         rabbitmq.QueueName(queuePrefix+":", category.PostViewedRoutingKey),
+				Consumer:      ctgrySvc.HandlePostViewed,
+				PrefetchCount: 50,
+			}); err != nil {
+				slog.Error("Failed to start post.viewed consumer", slog.Any("error", err))
+				return err
+			}
+
+			// Rebuild the category top posts leaderboards that are missing in Redis
+			go func() {
+				ticker := time.NewTicker(topPostsRebuildInterval)
+				defer ticker.Stop()
+				for {
+					if err := ctgrySvc.RebuildTopPosts(ctx); err != nil {
+						slog.Error("Failed to rebuild category top posts", slog.Any("error", err))
+					}
+					select {
+					case <-ctx.Done():
+						return
+					case <-ticker.C:
+					}
+				}
+			}()
+
 			// Initialize tenant service
 			tenantRepo := tenant.NewRepository(entClient)
 			tenantSvc := tenant.NewService(cnf, tenantRepo, entClient)
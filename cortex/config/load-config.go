@@ -41,6 +41,8 @@ func loadConfig() error {
 		RabbitmqURL:       viper.GetString("RABBITMQ_URL"),
 		RmqReconnectDelay: viper.GetInt("RMQ_RECONNECT_DELAY"),
 		RmqRetryInterval:  viper.GetInt("RMQ_RETRY_INTERVAL"),
+		RmqQueuePrefix:    viper.GetString("RMQ_QUEUE_PREFIX"),
+		PostalURL:         viper.GetString("POSTAL_URL"),
 
 		BGCE_DB_DSN:    viper.GetString("BGCE_DB_DSN"),
 		BGCE_DB_DRIVER: viper.GetString("BGCE_DB_DRIVER"),
@@ -49,7 +49,9 @@ type Category struct {
 	// Status holds the value of the "status" field.
 	Status category.Status `json:"status,omitempty"`
 	// Meta holds the value of the "meta" field.
-	Meta         map[string]interface{} `json:"meta,omitempty"`
+	Meta map[string]interface{} `json:"meta,omitempty"`
+	// Position holds the value of the "position" field.
+	Position     int `json:"position,omitempty"`
 	selectValues sql.SelectValues
 }
 
@@ -60,7 +62,7 @@ func (*Category) scanValues(columns []string) ([]any, error) {
 		switch columns[i] {
 		case category.FieldMeta:
 			values[i] = new([]byte)
-		case category.FieldID, category.FieldParentID, category.FieldCreatorID, category.FieldCreatedBy, category.FieldUpdatedBy, category.FieldApprovedBy, category.FieldDeletedBy:
+		case category.FieldID, category.FieldParentID, category.FieldCreatorID, category.FieldCreatedBy, category.FieldUpdatedBy, category.FieldApprovedBy, category.FieldDeletedBy, category.FieldPosition:
 			values[i] = new(sql.NullInt64)
 		case category.FieldUUID, category.FieldSlug, category.FieldLabel, category.FieldDescription, category.FieldStatus:
 			values[i] = new(sql.NullString)
@@ -185,6 +187,12 @@ func (_m *Category) assignValues(columns []string, values []any) error {
 					return fmt.Errorf("unmarshal field meta: %w", err)
 				}
 			}
+		case category.FieldPosition:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field position", values[i])
+			} else if value.Valid {
+				_m.Position = int(value.Int64)
+			}
 		default:
 			_m.selectValues.Set(columns[i], values[i])
 		}
@@ -268,6 +276,9 @@ func (_m *Category) String() string {
 	builder.WriteString(", ")
 	builder.WriteString("meta=")
 	builder.WriteString(fmt.Sprintf("%v", _m.Meta))
+	builder.WriteString(", ")
+	builder.WriteString("position=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Position))
 	builder.WriteByte(')')
 	return builder.String()
 }
@@ -46,6 +46,8 @@ const (
 	FieldStatus = "status"
 	// FieldMeta holds the string denoting the meta field in the database.
 	FieldMeta = "meta"
+	// FieldPosition holds the string denoting the position field in the database.
+	FieldPosition = "position"
 	// Table holds the table name of the category in the database.
 	Table = "categories"
 )
@@ -69,6 +71,7 @@ var Columns = []string{
 	FieldDeletedAt,
 	FieldStatus,
 	FieldMeta,
+	FieldPosition,
 }
 
 // ValidColumn reports if the column name is valid (part of the table columns).
@@ -96,6 +99,10 @@ var (
 	LabelValidator func(string) error
 	// CreatorIDValidator is a validator for the "creator_id" field. It is called by the builders before save.
 	CreatorIDValidator func(int) error
+	// DefaultPosition holds the default value on creation for the "position" field.
+	DefaultPosition int
+	// PositionValidator is a validator for the "position" field. It is called by the builders before save.
+	PositionValidator func(int) error
 )
 
 // Status defines the type for the "status" enum field.
@@ -208,3 +215,8 @@ func ByDeletedAt(opts ...sql.OrderTermOption) OrderOption {
 func ByStatus(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldStatus, opts...).ToFunc()
 }
+
+// ByPosition orders the results by the position field.
+func ByPosition(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPosition, opts...).ToFunc()
+}
@@ -212,6 +212,20 @@ func (_c *CategoryCreate) SetMeta(v map[string]interface{}) *CategoryCreate {
 	return _c
 }
 
+// SetPosition sets the "position" field.
+func (_c *CategoryCreate) SetPosition(v int) *CategoryCreate {
+	_c.mutation.SetPosition(v)
+	return _c
+}
+
+// SetNillablePosition sets the "position" field if the given value is not nil.
+func (_c *CategoryCreate) SetNillablePosition(v *int) *CategoryCreate {
+	if v != nil {
+		_c.SetPosition(*v)
+	}
+	return _c
+}
+
 // Mutation returns the CategoryMutation object of the builder.
 func (_c *CategoryCreate) Mutation() *CategoryMutation {
 	return _c.mutation
@@ -263,6 +277,10 @@ func (_c *CategoryCreate) defaults() {
 		v := category.DefaultStatus
 		_c.mutation.SetStatus(v)
 	}
+	if _, ok := _c.mutation.Position(); !ok {
+		v := category.DefaultPosition
+		_c.mutation.SetPosition(v)
+	}
 }
 
 // check runs all checks and user-defined validators on the builder.
@@ -308,6 +326,14 @@ func (_c *CategoryCreate) check() error {
 			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "Category.status": %w`, err)}
 		}
 	}
+	if _, ok := _c.mutation.Position(); !ok {
+		return &ValidationError{Name: "position", err: errors.New(`ent: missing required field "Category.position"`)}
+	}
+	if v, ok := _c.mutation.Position(); ok {
+		if err := category.PositionValidator(v); err != nil {
+			return &ValidationError{Name: "position", err: fmt.Errorf(`ent: validator failed for field "Category.position": %w`, err)}
+		}
+	}
 	return nil
 }
 
@@ -398,6 +424,10 @@ func (_c *CategoryCreate) createSpec() (*Category, *sqlgraph.CreateSpec) {
 		_spec.SetField(category.FieldMeta, field.TypeJSON, value)
 		_node.Meta = value
 	}
+	if value, ok := _c.mutation.Position(); ok {
+		_spec.SetField(category.FieldPosition, field.TypeInt, value)
+		_node.Position = value
+	}
 	return _node, _spec
 }
 
@@ -332,6 +332,27 @@ func (_u *CategoryUpdate) ClearMeta() *CategoryUpdate {
 	return _u
 }
 
+// SetPosition sets the "position" field.
+func (_u *CategoryUpdate) SetPosition(v int) *CategoryUpdate {
+	_u.mutation.ResetPosition()
+	_u.mutation.SetPosition(v)
+	return _u
+}
+
+// SetNillablePosition sets the "position" field if the given value is not nil.
+func (_u *CategoryUpdate) SetNillablePosition(v *int) *CategoryUpdate {
+	if v != nil {
+		_u.SetPosition(*v)
+	}
+	return _u
+}
+
+// AddPosition adds value to the "position" field.
+func (_u *CategoryUpdate) AddPosition(v int) *CategoryUpdate {
+	_u.mutation.AddPosition(v)
+	return _u
+}
+
 // Mutation returns the CategoryMutation object of the builder.
 func (_u *CategoryUpdate) Mutation() *CategoryMutation {
 	return _u.mutation
@@ -395,6 +416,11 @@ func (_u *CategoryUpdate) check() error {
 			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "Category.status": %w`, err)}
 		}
 	}
+	if v, ok := _u.mutation.Position(); ok {
+		if err := category.PositionValidator(v); err != nil {
+			return &ValidationError{Name: "position", err: fmt.Errorf(`ent: validator failed for field "Category.position": %w`, err)}
+		}
+	}
 	return nil
 }
 
@@ -503,6 +529,12 @@ func (_u *CategoryUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 	if _u.mutation.MetaCleared() {
 		_spec.ClearField(category.FieldMeta, field.TypeJSON)
 	}
+	if value, ok := _u.mutation.Position(); ok {
+		_spec.SetField(category.FieldPosition, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedPosition(); ok {
+		_spec.AddField(category.FieldPosition, field.TypeInt, value)
+	}
 	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{category.Label}
@@ -827,6 +859,27 @@ func (_u *CategoryUpdateOne) ClearMeta() *CategoryUpdateOne {
 	return _u
 }
 
+// SetPosition sets the "position" field.
+func (_u *CategoryUpdateOne) SetPosition(v int) *CategoryUpdateOne {
+	_u.mutation.ResetPosition()
+	_u.mutation.SetPosition(v)
+	return _u
+}
+
+// SetNillablePosition sets the "position" field if the given value is not nil.
+func (_u *CategoryUpdateOne) SetNillablePosition(v *int) *CategoryUpdateOne {
+	if v != nil {
+		_u.SetPosition(*v)
+	}
+	return _u
+}
+
+// AddPosition adds value to the "position" field.
+func (_u *CategoryUpdateOne) AddPosition(v int) *CategoryUpdateOne {
+	_u.mutation.AddPosition(v)
+	return _u
+}
+
 // Mutation returns the CategoryMutation object of the builder.
 func (_u *CategoryUpdateOne) Mutation() *CategoryMutation {
 	return _u.mutation
@@ -903,6 +956,11 @@ func (_u *CategoryUpdateOne) check() error {
 			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "Category.status": %w`, err)}
 		}
 	}
+	if v, ok := _u.mutation.Position(); ok {
+		if err := category.PositionValidator(v); err != nil {
+			return &ValidationError{Name: "position", err: fmt.Errorf(`ent: validator failed for field "Category.position": %w`, err)}
+		}
+	}
 	return nil
 }
 
@@ -1028,6 +1086,12 @@ func (_u *CategoryUpdateOne) sqlSave(ctx context.Context) (_node *Category, err
 	if _u.mutation.MetaCleared() {
 		_spec.ClearField(category.FieldMeta, field.TypeJSON)
 	}
+	if value, ok := _u.mutation.Position(); ok {
+		_spec.SetField(category.FieldPosition, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedPosition(); ok {
+		_spec.AddField(category.FieldPosition, field.TypeInt, value)
+	}
 	_node = &Category{config: _u.config}
 	_spec.Assign = _node.assignValues
 	_spec.ScanValues = _node.scanValues
@@ -0,0 +1,285 @@
+// Code generated by ent, DO NOT EDIT.
+
+package categoryslughistory
+
+import (
+	"cortex/ent/predicate"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.CategorySlugHistory {
+	return predicate.CategorySlugHistory(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.CategorySlugHistory {
+	return predicate.CategorySlugHistory(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.CategorySlugHistory {
+	return predicate.CategorySlugHistory(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.CategorySlugHistory {
+	return predicate.CategorySlugHistory(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.CategorySlugHistory {
+	return predicate.CategorySlugHistory(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.CategorySlugHistory {
+	return predicate.CategorySlugHistory(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.CategorySlugHistory {
+	return predicate.CategorySlugHistory(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.CategorySlugHistory {
+	return predicate.CategorySlugHistory(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.CategorySlugHistory {
+	return predicate.CategorySlugHistory(sql.FieldLTE(FieldID, id))
+}
+
+// CategoryID applies equality check predicate on the "category_id" field. It's identical to CategoryIDEQ.
+func CategoryID(v int) predicate.CategorySlugHistory {
+	return predicate.CategorySlugHistory(sql.FieldEQ(FieldCategoryID, v))
+}
+
+// Slug applies equality check predicate on the "slug" field. It's identical to SlugEQ.
+func Slug(v string) predicate.CategorySlugHistory {
+	return predicate.CategorySlugHistory(sql.FieldEQ(FieldSlug, v))
+}
+
+// ChangedBy applies equality check predicate on the "changed_by" field. It's identical to ChangedByEQ.
+func ChangedBy(v int) predicate.CategorySlugHistory {
+	return predicate.CategorySlugHistory(sql.FieldEQ(FieldChangedBy, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.CategorySlugHistory {
+	return predicate.CategorySlugHistory(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CategoryIDEQ applies the EQ predicate on the "category_id" field.
+func CategoryIDEQ(v int) predicate.CategorySlugHistory {
+	return predicate.CategorySlugHistory(sql.FieldEQ(FieldCategoryID, v))
+}
+
+// CategoryIDNEQ applies the NEQ predicate on the "category_id" field.
+func CategoryIDNEQ(v int) predicate.CategorySlugHistory {
+	return predicate.CategorySlugHistory(sql.FieldNEQ(FieldCategoryID, v))
+}
+
+// CategoryIDIn applies the In predicate on the "category_id" field.
+func CategoryIDIn(vs ...int) predicate.CategorySlugHistory {
+	return predicate.CategorySlugHistory(sql.FieldIn(FieldCategoryID, vs...))
+}
+
+// CategoryIDNotIn applies the NotIn predicate on the "category_id" field.
+func CategoryIDNotIn(vs ...int) predicate.CategorySlugHistory {
+	return predicate.CategorySlugHistory(sql.FieldNotIn(FieldCategoryID, vs...))
+}
+
+// CategoryIDGT applies the GT predicate on the "category_id" field.
+func CategoryIDGT(v int) predicate.CategorySlugHistory {
+	return predicate.CategorySlugHistory(sql.FieldGT(FieldCategoryID, v))
+}
+
+// CategoryIDGTE applies the GTE predicate on the "category_id" field.
+func CategoryIDGTE(v int) predicate.CategorySlugHistory {
+	return predicate.CategorySlugHistory(sql.FieldGTE(FieldCategoryID, v))
+}
+
+// CategoryIDLT applies the LT predicate on the "category_id" field.
+func CategoryIDLT(v int) predicate.CategorySlugHistory {
+	return predicate.CategorySlugHistory(sql.FieldLT(FieldCategoryID, v))
+}
+
+// CategoryIDLTE applies the LTE predicate on the "category_id" field.
+func CategoryIDLTE(v int) predicate.CategorySlugHistory {
+	return predicate.CategorySlugHistory(sql.FieldLTE(FieldCategoryID, v))
+}
+
+// SlugEQ applies the EQ predicate on the "slug" field.
+func SlugEQ(v string) predicate.CategorySlugHistory {
+	return predicate.CategorySlugHistory(sql.FieldEQ(FieldSlug, v))
+}
+
+// SlugNEQ applies the NEQ predicate on the "slug" field.
+func SlugNEQ(v string) predicate.CategorySlugHistory {
+	return predicate.CategorySlugHistory(sql.FieldNEQ(FieldSlug, v))
+}
+
+// SlugIn applies the In predicate on the "slug" field.
+func SlugIn(vs ...string) predicate.CategorySlugHistory {
+	return predicate.CategorySlugHistory(sql.FieldIn(FieldSlug, vs...))
+}
+
+// SlugNotIn applies the NotIn predicate on the "slug" field.
+func SlugNotIn(vs ...string) predicate.CategorySlugHistory {
+	return predicate.CategorySlugHistory(sql.FieldNotIn(FieldSlug, vs...))
+}
+
+// SlugGT applies the GT predicate on the "slug" field.
+func SlugGT(v string) predicate.CategorySlugHistory {
+	return predicate.CategorySlugHistory(sql.FieldGT(FieldSlug, v))
+}
+
+// SlugGTE applies the GTE predicate on the "slug" field.
+func SlugGTE(v string) predicate.CategorySlugHistory {
+	return predicate.CategorySlugHistory(sql.FieldGTE(FieldSlug, v))
+}
+
+// SlugLT applies the LT predicate on the "slug" field.
+func SlugLT(v string) predicate.CategorySlugHistory {
+	return predicate.CategorySlugHistory(sql.FieldLT(FieldSlug, v))
+}
+
+// SlugLTE applies the LTE predicate on the "slug" field.
+func SlugLTE(v string) predicate.CategorySlugHistory {
+	return predicate.CategorySlugHistory(sql.FieldLTE(FieldSlug, v))
+}
+
+// SlugContains applies the Contains predicate on the "slug" field.
+func SlugContains(v string) predicate.CategorySlugHistory {
+	return predicate.CategorySlugHistory(sql.FieldContains(FieldSlug, v))
+}
+
+// SlugHasPrefix applies the HasPrefix predicate on the "slug" field.
+func SlugHasPrefix(v string) predicate.CategorySlugHistory {
+	return predicate.CategorySlugHistory(sql.FieldHasPrefix(FieldSlug, v))
+}
+
+// SlugHasSuffix applies the HasSuffix predicate on the "slug" field.
+func SlugHasSuffix(v string) predicate.CategorySlugHistory {
+	return predicate.CategorySlugHistory(sql.FieldHasSuffix(FieldSlug, v))
+}
+
+// SlugEqualFold applies the EqualFold predicate on the "slug" field.
+func SlugEqualFold(v string) predicate.CategorySlugHistory {
+	return predicate.CategorySlugHistory(sql.FieldEqualFold(FieldSlug, v))
+}
+
+// SlugContainsFold applies the ContainsFold predicate on the "slug" field.
+func SlugContainsFold(v string) predicate.CategorySlugHistory {
+	return predicate.CategorySlugHistory(sql.FieldContainsFold(FieldSlug, v))
+}
+
+// ChangedByEQ applies the EQ predicate on the "changed_by" field.
+func ChangedByEQ(v int) predicate.CategorySlugHistory {
+	return predicate.CategorySlugHistory(sql.FieldEQ(FieldChangedBy, v))
+}
+
+// ChangedByNEQ applies the NEQ predicate on the "changed_by" field.
+func ChangedByNEQ(v int) predicate.CategorySlugHistory {
+	return predicate.CategorySlugHistory(sql.FieldNEQ(FieldChangedBy, v))
+}
+
+// ChangedByIn applies the In predicate on the "changed_by" field.
+func ChangedByIn(vs ...int) predicate.CategorySlugHistory {
+	return predicate.CategorySlugHistory(sql.FieldIn(FieldChangedBy, vs...))
+}
+
+// ChangedByNotIn applies the NotIn predicate on the "changed_by" field.
+func ChangedByNotIn(vs ...int) predicate.CategorySlugHistory {
+	return predicate.CategorySlugHistory(sql.FieldNotIn(FieldChangedBy, vs...))
+}
+
+// ChangedByGT applies the GT predicate on the "changed_by" field.
+func ChangedByGT(v int) predicate.CategorySlugHistory {
+	return predicate.CategorySlugHistory(sql.FieldGT(FieldChangedBy, v))
+}
+
+// ChangedByGTE applies the GTE predicate on the "changed_by" field.
+func ChangedByGTE(v int) predicate.CategorySlugHistory {
+	return predicate.CategorySlugHistory(sql.FieldGTE(FieldChangedBy, v))
+}
+
+// ChangedByLT applies the LT predicate on the "changed_by" field.
+func ChangedByLT(v int) predicate.CategorySlugHistory {
+	return predicate.CategorySlugHistory(sql.FieldLT(FieldChangedBy, v))
+}
+
+// ChangedByLTE applies the LTE predicate on the "changed_by" field.
+func ChangedByLTE(v int) predicate.CategorySlugHistory {
+	return predicate.CategorySlugHistory(sql.FieldLTE(FieldChangedBy, v))
+}
+
+// ChangedByIsNil applies the IsNil predicate on the "changed_by" field.
+func ChangedByIsNil() predicate.CategorySlugHistory {
+	return predicate.CategorySlugHistory(sql.FieldIsNull(FieldChangedBy))
+}
+
+// ChangedByNotNil applies the NotNil predicate on the "changed_by" field.
+func ChangedByNotNil() predicate.CategorySlugHistory {
+	return predicate.CategorySlugHistory(sql.FieldNotNull(FieldChangedBy))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.CategorySlugHistory {
+	return predicate.CategorySlugHistory(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.CategorySlugHistory {
+	return predicate.CategorySlugHistory(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.CategorySlugHistory {
+	return predicate.CategorySlugHistory(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.CategorySlugHistory {
+	return predicate.CategorySlugHistory(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.CategorySlugHistory {
+	return predicate.CategorySlugHistory(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.CategorySlugHistory {
+	return predicate.CategorySlugHistory(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.CategorySlugHistory {
+	return predicate.CategorySlugHistory(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.CategorySlugHistory {
+	return predicate.CategorySlugHistory(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.CategorySlugHistory) predicate.CategorySlugHistory {
+	return predicate.CategorySlugHistory(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.CategorySlugHistory) predicate.CategorySlugHistory {
+	return predicate.CategorySlugHistory(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.CategorySlugHistory) predicate.CategorySlugHistory {
+	return predicate.CategorySlugHistory(sql.NotPredicates(p))
+}
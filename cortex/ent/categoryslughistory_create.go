@@ -0,0 +1,256 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"cortex/ent/categoryslughistory"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// CategorySlugHistoryCreate is the builder for creating a CategorySlugHistory entity.
+type CategorySlugHistoryCreate struct {
+	config
+	mutation *CategorySlugHistoryMutation
+	hooks    []Hook
+}
+
+// SetCategoryID sets the "category_id" field.
+func (_c *CategorySlugHistoryCreate) SetCategoryID(v int) *CategorySlugHistoryCreate {
+	_c.mutation.SetCategoryID(v)
+	return _c
+}
+
+// SetSlug sets the "slug" field.
+func (_c *CategorySlugHistoryCreate) SetSlug(v string) *CategorySlugHistoryCreate {
+	_c.mutation.SetSlug(v)
+	return _c
+}
+
+// SetChangedBy sets the "changed_by" field.
+func (_c *CategorySlugHistoryCreate) SetChangedBy(v int) *CategorySlugHistoryCreate {
+	_c.mutation.SetChangedBy(v)
+	return _c
+}
+
+// SetNillableChangedBy sets the "changed_by" field if the given value is not nil.
+func (_c *CategorySlugHistoryCreate) SetNillableChangedBy(v *int) *CategorySlugHistoryCreate {
+	if v != nil {
+		_c.SetChangedBy(*v)
+	}
+	return _c
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *CategorySlugHistoryCreate) SetCreatedAt(v time.Time) *CategorySlugHistoryCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *CategorySlugHistoryCreate) SetNillableCreatedAt(v *time.Time) *CategorySlugHistoryCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// Mutation returns the CategorySlugHistoryMutation object of the builder.
+func (_c *CategorySlugHistoryCreate) Mutation() *CategorySlugHistoryMutation {
+	return _c.mutation
+}
+
+// Save creates the CategorySlugHistory in the database.
+func (_c *CategorySlugHistoryCreate) Save(ctx context.Context) (*CategorySlugHistory, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *CategorySlugHistoryCreate) SaveX(ctx context.Context) *CategorySlugHistory {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *CategorySlugHistoryCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *CategorySlugHistoryCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *CategorySlugHistoryCreate) defaults() {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := categoryslughistory.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *CategorySlugHistoryCreate) check() error {
+	if _, ok := _c.mutation.CategoryID(); !ok {
+		return &ValidationError{Name: "category_id", err: errors.New(`ent: missing required field "CategorySlugHistory.category_id"`)}
+	}
+	if v, ok := _c.mutation.CategoryID(); ok {
+		if err := categoryslughistory.CategoryIDValidator(v); err != nil {
+			return &ValidationError{Name: "category_id", err: fmt.Errorf(`ent: validator failed for field "CategorySlugHistory.category_id": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Slug(); !ok {
+		return &ValidationError{Name: "slug", err: errors.New(`ent: missing required field "CategorySlugHistory.slug"`)}
+	}
+	if v, ok := _c.mutation.Slug(); ok {
+		if err := categoryslughistory.SlugValidator(v); err != nil {
+			return &ValidationError{Name: "slug", err: fmt.Errorf(`ent: validator failed for field "CategorySlugHistory.slug": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "CategorySlugHistory.created_at"`)}
+	}
+	return nil
+}
+
+func (_c *CategorySlugHistoryCreate) sqlSave(ctx context.Context) (*CategorySlugHistory, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *CategorySlugHistoryCreate) createSpec() (*CategorySlugHistory, *sqlgraph.CreateSpec) {
+	var (
+		_node = &CategorySlugHistory{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(categoryslughistory.Table, sqlgraph.NewFieldSpec(categoryslughistory.FieldID, field.TypeInt))
+	)
+	if value, ok := _c.mutation.CategoryID(); ok {
+		_spec.SetField(categoryslughistory.FieldCategoryID, field.TypeInt, value)
+		_node.CategoryID = value
+	}
+	if value, ok := _c.mutation.Slug(); ok {
+		_spec.SetField(categoryslughistory.FieldSlug, field.TypeString, value)
+		_node.Slug = value
+	}
+	if value, ok := _c.mutation.ChangedBy(); ok {
+		_spec.SetField(categoryslughistory.FieldChangedBy, field.TypeInt, value)
+		_node.ChangedBy = value
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(categoryslughistory.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	return _node, _spec
+}
+
+// CategorySlugHistoryCreateBulk is the builder for creating many CategorySlugHistory entities in bulk.
+type CategorySlugHistoryCreateBulk struct {
+	config
+	err      error
+	builders []*CategorySlugHistoryCreate
+}
+
+// Save creates the CategorySlugHistory entities in the database.
+func (_c *CategorySlugHistoryCreateBulk) Save(ctx context.Context) ([]*CategorySlugHistory, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*CategorySlugHistory, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*CategorySlugHistoryMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *CategorySlugHistoryCreateBulk) SaveX(ctx context.Context) []*CategorySlugHistory {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *CategorySlugHistoryCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *CategorySlugHistoryCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
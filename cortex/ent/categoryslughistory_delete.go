@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"cortex/ent/categoryslughistory"
+	"cortex/ent/predicate"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// CategorySlugHistoryDelete is the builder for deleting a CategorySlugHistory entity.
+type CategorySlugHistoryDelete struct {
+	config
+	hooks    []Hook
+	mutation *CategorySlugHistoryMutation
+}
+
+// Where appends a list predicates to the CategorySlugHistoryDelete builder.
+func (_d *CategorySlugHistoryDelete) Where(ps ...predicate.CategorySlugHistory) *CategorySlugHistoryDelete {
+	_d.mutation.Where(ps...)
+	return _d
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (_d *CategorySlugHistoryDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, _d.sqlExec, _d.mutation, _d.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_d *CategorySlugHistoryDelete) ExecX(ctx context.Context) int {
+	n, err := _d.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (_d *CategorySlugHistoryDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(categoryslughistory.Table, sqlgraph.NewFieldSpec(categoryslughistory.FieldID, field.TypeInt))
+	if ps := _d.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, _d.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	_d.mutation.done = true
+	return affected, err
+}
+
+// CategorySlugHistoryDeleteOne is the builder for deleting a single CategorySlugHistory entity.
+type CategorySlugHistoryDeleteOne struct {
+	_d *CategorySlugHistoryDelete
+}
+
+// Where appends a list predicates to the CategorySlugHistoryDelete builder.
+func (_d *CategorySlugHistoryDeleteOne) Where(ps ...predicate.CategorySlugHistory) *CategorySlugHistoryDeleteOne {
+	_d._d.mutation.Where(ps...)
+	return _d
+}
+
+// Exec executes the deletion query.
+func (_d *CategorySlugHistoryDeleteOne) Exec(ctx context.Context) error {
+	n, err := _d._d.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{categoryslughistory.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_d *CategorySlugHistoryDeleteOne) ExecX(ctx context.Context) {
+	if err := _d.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,371 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"cortex/ent/categoryslughistory"
+	"cortex/ent/predicate"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// CategorySlugHistoryUpdate is the builder for updating CategorySlugHistory entities.
+type CategorySlugHistoryUpdate struct {
+	config
+	hooks    []Hook
+	mutation *CategorySlugHistoryMutation
+}
+
+// Where appends a list predicates to the CategorySlugHistoryUpdate builder.
+func (_u *CategorySlugHistoryUpdate) Where(ps ...predicate.CategorySlugHistory) *CategorySlugHistoryUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetCategoryID sets the "category_id" field.
+func (_u *CategorySlugHistoryUpdate) SetCategoryID(v int) *CategorySlugHistoryUpdate {
+	_u.mutation.ResetCategoryID()
+	_u.mutation.SetCategoryID(v)
+	return _u
+}
+
+// SetNillableCategoryID sets the "category_id" field if the given value is not nil.
+func (_u *CategorySlugHistoryUpdate) SetNillableCategoryID(v *int) *CategorySlugHistoryUpdate {
+	if v != nil {
+		_u.SetCategoryID(*v)
+	}
+	return _u
+}
+
+// AddCategoryID adds value to the "category_id" field.
+func (_u *CategorySlugHistoryUpdate) AddCategoryID(v int) *CategorySlugHistoryUpdate {
+	_u.mutation.AddCategoryID(v)
+	return _u
+}
+
+// SetSlug sets the "slug" field.
+func (_u *CategorySlugHistoryUpdate) SetSlug(v string) *CategorySlugHistoryUpdate {
+	_u.mutation.SetSlug(v)
+	return _u
+}
+
+// SetNillableSlug sets the "slug" field if the given value is not nil.
+func (_u *CategorySlugHistoryUpdate) SetNillableSlug(v *string) *CategorySlugHistoryUpdate {
+	if v != nil {
+		_u.SetSlug(*v)
+	}
+	return _u
+}
+
+// SetChangedBy sets the "changed_by" field.
+func (_u *CategorySlugHistoryUpdate) SetChangedBy(v int) *CategorySlugHistoryUpdate {
+	_u.mutation.ResetChangedBy()
+	_u.mutation.SetChangedBy(v)
+	return _u
+}
+
+// SetNillableChangedBy sets the "changed_by" field if the given value is not nil.
+func (_u *CategorySlugHistoryUpdate) SetNillableChangedBy(v *int) *CategorySlugHistoryUpdate {
+	if v != nil {
+		_u.SetChangedBy(*v)
+	}
+	return _u
+}
+
+// AddChangedBy adds value to the "changed_by" field.
+func (_u *CategorySlugHistoryUpdate) AddChangedBy(v int) *CategorySlugHistoryUpdate {
+	_u.mutation.AddChangedBy(v)
+	return _u
+}
+
+// ClearChangedBy clears the value of the "changed_by" field.
+func (_u *CategorySlugHistoryUpdate) ClearChangedBy() *CategorySlugHistoryUpdate {
+	_u.mutation.ClearChangedBy()
+	return _u
+}
+
+// Mutation returns the CategorySlugHistoryMutation object of the builder.
+func (_u *CategorySlugHistoryUpdate) Mutation() *CategorySlugHistoryMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *CategorySlugHistoryUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *CategorySlugHistoryUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *CategorySlugHistoryUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *CategorySlugHistoryUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *CategorySlugHistoryUpdate) check() error {
+	if v, ok := _u.mutation.CategoryID(); ok {
+		if err := categoryslughistory.CategoryIDValidator(v); err != nil {
+			return &ValidationError{Name: "category_id", err: fmt.Errorf(`ent: validator failed for field "CategorySlugHistory.category_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Slug(); ok {
+		if err := categoryslughistory.SlugValidator(v); err != nil {
+			return &ValidationError{Name: "slug", err: fmt.Errorf(`ent: validator failed for field "CategorySlugHistory.slug": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *CategorySlugHistoryUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(categoryslughistory.Table, categoryslughistory.Columns, sqlgraph.NewFieldSpec(categoryslughistory.FieldID, field.TypeInt))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.CategoryID(); ok {
+		_spec.SetField(categoryslughistory.FieldCategoryID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedCategoryID(); ok {
+		_spec.AddField(categoryslughistory.FieldCategoryID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.Slug(); ok {
+		_spec.SetField(categoryslughistory.FieldSlug, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.ChangedBy(); ok {
+		_spec.SetField(categoryslughistory.FieldChangedBy, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedChangedBy(); ok {
+		_spec.AddField(categoryslughistory.FieldChangedBy, field.TypeInt, value)
+	}
+	if _u.mutation.ChangedByCleared() {
+		_spec.ClearField(categoryslughistory.FieldChangedBy, field.TypeInt)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{categoryslughistory.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// CategorySlugHistoryUpdateOne is the builder for updating a single CategorySlugHistory entity.
+type CategorySlugHistoryUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *CategorySlugHistoryMutation
+}
+
+// SetCategoryID sets the "category_id" field.
+func (_u *CategorySlugHistoryUpdateOne) SetCategoryID(v int) *CategorySlugHistoryUpdateOne {
+	_u.mutation.ResetCategoryID()
+	_u.mutation.SetCategoryID(v)
+	return _u
+}
+
+// SetNillableCategoryID sets the "category_id" field if the given value is not nil.
+func (_u *CategorySlugHistoryUpdateOne) SetNillableCategoryID(v *int) *CategorySlugHistoryUpdateOne {
+	if v != nil {
+		_u.SetCategoryID(*v)
+	}
+	return _u
+}
+
+// AddCategoryID adds value to the "category_id" field.
+func (_u *CategorySlugHistoryUpdateOne) AddCategoryID(v int) *CategorySlugHistoryUpdateOne {
+	_u.mutation.AddCategoryID(v)
+	return _u
+}
+
+// SetSlug sets the "slug" field.
+func (_u *CategorySlugHistoryUpdateOne) SetSlug(v string) *CategorySlugHistoryUpdateOne {
+	_u.mutation.SetSlug(v)
+	return _u
+}
+
+// SetNillableSlug sets the "slug" field if the given value is not nil.
+func (_u *CategorySlugHistoryUpdateOne) SetNillableSlug(v *string) *CategorySlugHistoryUpdateOne {
+	if v != nil {
+		_u.SetSlug(*v)
+	}
+	return _u
+}
+
+// SetChangedBy sets the "changed_by" field.
+func (_u *CategorySlugHistoryUpdateOne) SetChangedBy(v int) *CategorySlugHistoryUpdateOne {
+	_u.mutation.ResetChangedBy()
+	_u.mutation.SetChangedBy(v)
+	return _u
+}
+
+// SetNillableChangedBy sets the "changed_by" field if the given value is not nil.
+func (_u *CategorySlugHistoryUpdateOne) SetNillableChangedBy(v *int) *CategorySlugHistoryUpdateOne {
+	if v != nil {
+		_u.SetChangedBy(*v)
+	}
+	return _u
+}
+
+// AddChangedBy adds value to the "changed_by" field.
+func (_u *CategorySlugHistoryUpdateOne) AddChangedBy(v int) *CategorySlugHistoryUpdateOne {
+	_u.mutation.AddChangedBy(v)
+	return _u
+}
+
+// ClearChangedBy clears the value of the "changed_by" field.
+func (_u *CategorySlugHistoryUpdateOne) ClearChangedBy() *CategorySlugHistoryUpdateOne {
+	_u.mutation.ClearChangedBy()
+	return _u
+}
+
+// Mutation returns the CategorySlugHistoryMutation object of the builder.
+func (_u *CategorySlugHistoryUpdateOne) Mutation() *CategorySlugHistoryMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the CategorySlugHistoryUpdate builder.
+func (_u *CategorySlugHistoryUpdateOne) Where(ps ...predicate.CategorySlugHistory) *CategorySlugHistoryUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *CategorySlugHistoryUpdateOne) Select(field string, fields ...string) *CategorySlugHistoryUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated CategorySlugHistory entity.
+func (_u *CategorySlugHistoryUpdateOne) Save(ctx context.Context) (*CategorySlugHistory, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *CategorySlugHistoryUpdateOne) SaveX(ctx context.Context) *CategorySlugHistory {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *CategorySlugHistoryUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *CategorySlugHistoryUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *CategorySlugHistoryUpdateOne) check() error {
+	if v, ok := _u.mutation.CategoryID(); ok {
+		if err := categoryslughistory.CategoryIDValidator(v); err != nil {
+			return &ValidationError{Name: "category_id", err: fmt.Errorf(`ent: validator failed for field "CategorySlugHistory.category_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Slug(); ok {
+		if err := categoryslughistory.SlugValidator(v); err != nil {
+			return &ValidationError{Name: "slug", err: fmt.Errorf(`ent: validator failed for field "CategorySlugHistory.slug": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *CategorySlugHistoryUpdateOne) sqlSave(ctx context.Context) (_node *CategorySlugHistory, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(categoryslughistory.Table, categoryslughistory.Columns, sqlgraph.NewFieldSpec(categoryslughistory.FieldID, field.TypeInt))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "CategorySlugHistory.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, categoryslughistory.FieldID)
+		for _, f := range fields {
+			if !categoryslughistory.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != categoryslughistory.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.CategoryID(); ok {
+		_spec.SetField(categoryslughistory.FieldCategoryID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedCategoryID(); ok {
+		_spec.AddField(categoryslughistory.FieldCategoryID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.Slug(); ok {
+		_spec.SetField(categoryslughistory.FieldSlug, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.ChangedBy(); ok {
+		_spec.SetField(categoryslughistory.FieldChangedBy, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedChangedBy(); ok {
+		_spec.AddField(categoryslughistory.FieldChangedBy, field.TypeInt, value)
+	}
+	if _u.mutation.ChangedByCleared() {
+		_spec.ClearField(categoryslughistory.FieldChangedBy, field.TypeInt)
+	}
+	_node = &CategorySlugHistory{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{categoryslughistory.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
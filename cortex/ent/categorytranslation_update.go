@@ -0,0 +1,576 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"cortex/ent/categorytranslation"
+	"cortex/ent/predicate"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// CategoryTranslationUpdate is the builder for updating CategoryTranslation entities.
+type CategoryTranslationUpdate struct {
+	config
+	hooks    []Hook
+	mutation *CategoryTranslationMutation
+}
+
+// Where appends a list predicates to the CategoryTranslationUpdate builder.
+func (_u *CategoryTranslationUpdate) Where(ps ...predicate.CategoryTranslation) *CategoryTranslationUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetCategoryID sets the "category_id" field.
+func (_u *CategoryTranslationUpdate) SetCategoryID(v int) *CategoryTranslationUpdate {
+	_u.mutation.ResetCategoryID()
+	_u.mutation.SetCategoryID(v)
+	return _u
+}
+
+// SetNillableCategoryID sets the "category_id" field if the given value is not nil.
+func (_u *CategoryTranslationUpdate) SetNillableCategoryID(v *int) *CategoryTranslationUpdate {
+	if v != nil {
+		_u.SetCategoryID(*v)
+	}
+	return _u
+}
+
+// AddCategoryID adds value to the "category_id" field.
+func (_u *CategoryTranslationUpdate) AddCategoryID(v int) *CategoryTranslationUpdate {
+	_u.mutation.AddCategoryID(v)
+	return _u
+}
+
+// SetLocale sets the "locale" field.
+func (_u *CategoryTranslationUpdate) SetLocale(v string) *CategoryTranslationUpdate {
+	_u.mutation.SetLocale(v)
+	return _u
+}
+
+// SetNillableLocale sets the "locale" field if the given value is not nil.
+func (_u *CategoryTranslationUpdate) SetNillableLocale(v *string) *CategoryTranslationUpdate {
+	if v != nil {
+		_u.SetLocale(*v)
+	}
+	return _u
+}
+
+// SetLabel sets the "label" field.
+func (_u *CategoryTranslationUpdate) SetLabel(v string) *CategoryTranslationUpdate {
+	_u.mutation.SetLabel(v)
+	return _u
+}
+
+// SetNillableLabel sets the "label" field if the given value is not nil.
+func (_u *CategoryTranslationUpdate) SetNillableLabel(v *string) *CategoryTranslationUpdate {
+	if v != nil {
+		_u.SetLabel(*v)
+	}
+	return _u
+}
+
+// SetDescription sets the "description" field.
+func (_u *CategoryTranslationUpdate) SetDescription(v string) *CategoryTranslationUpdate {
+	_u.mutation.SetDescription(v)
+	return _u
+}
+
+// SetNillableDescription sets the "description" field if the given value is not nil.
+func (_u *CategoryTranslationUpdate) SetNillableDescription(v *string) *CategoryTranslationUpdate {
+	if v != nil {
+		_u.SetDescription(*v)
+	}
+	return _u
+}
+
+// ClearDescription clears the value of the "description" field.
+func (_u *CategoryTranslationUpdate) ClearDescription() *CategoryTranslationUpdate {
+	_u.mutation.ClearDescription()
+	return _u
+}
+
+// SetCreatedBy sets the "created_by" field.
+func (_u *CategoryTranslationUpdate) SetCreatedBy(v int) *CategoryTranslationUpdate {
+	_u.mutation.ResetCreatedBy()
+	_u.mutation.SetCreatedBy(v)
+	return _u
+}
+
+// SetNillableCreatedBy sets the "created_by" field if the given value is not nil.
+func (_u *CategoryTranslationUpdate) SetNillableCreatedBy(v *int) *CategoryTranslationUpdate {
+	if v != nil {
+		_u.SetCreatedBy(*v)
+	}
+	return _u
+}
+
+// AddCreatedBy adds value to the "created_by" field.
+func (_u *CategoryTranslationUpdate) AddCreatedBy(v int) *CategoryTranslationUpdate {
+	_u.mutation.AddCreatedBy(v)
+	return _u
+}
+
+// ClearCreatedBy clears the value of the "created_by" field.
+func (_u *CategoryTranslationUpdate) ClearCreatedBy() *CategoryTranslationUpdate {
+	_u.mutation.ClearCreatedBy()
+	return _u
+}
+
+// SetUpdatedBy sets the "updated_by" field.
+func (_u *CategoryTranslationUpdate) SetUpdatedBy(v int) *CategoryTranslationUpdate {
+	_u.mutation.ResetUpdatedBy()
+	_u.mutation.SetUpdatedBy(v)
+	return _u
+}
+
+// SetNillableUpdatedBy sets the "updated_by" field if the given value is not nil.
+func (_u *CategoryTranslationUpdate) SetNillableUpdatedBy(v *int) *CategoryTranslationUpdate {
+	if v != nil {
+		_u.SetUpdatedBy(*v)
+	}
+	return _u
+}
+
+// AddUpdatedBy adds value to the "updated_by" field.
+func (_u *CategoryTranslationUpdate) AddUpdatedBy(v int) *CategoryTranslationUpdate {
+	_u.mutation.AddUpdatedBy(v)
+	return _u
+}
+
+// ClearUpdatedBy clears the value of the "updated_by" field.
+func (_u *CategoryTranslationUpdate) ClearUpdatedBy() *CategoryTranslationUpdate {
+	_u.mutation.ClearUpdatedBy()
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *CategoryTranslationUpdate) SetUpdatedAt(v time.Time) *CategoryTranslationUpdate {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// Mutation returns the CategoryTranslationMutation object of the builder.
+func (_u *CategoryTranslationUpdate) Mutation() *CategoryTranslationMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *CategoryTranslationUpdate) Save(ctx context.Context) (int, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *CategoryTranslationUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *CategoryTranslationUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *CategoryTranslationUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *CategoryTranslationUpdate) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := categorytranslation.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *CategoryTranslationUpdate) check() error {
+	if v, ok := _u.mutation.CategoryID(); ok {
+		if err := categorytranslation.CategoryIDValidator(v); err != nil {
+			return &ValidationError{Name: "category_id", err: fmt.Errorf(`ent: validator failed for field "CategoryTranslation.category_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Locale(); ok {
+		if err := categorytranslation.LocaleValidator(v); err != nil {
+			return &ValidationError{Name: "locale", err: fmt.Errorf(`ent: validator failed for field "CategoryTranslation.locale": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Label(); ok {
+		if err := categorytranslation.LabelValidator(v); err != nil {
+			return &ValidationError{Name: "label", err: fmt.Errorf(`ent: validator failed for field "CategoryTranslation.label": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *CategoryTranslationUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(categorytranslation.Table, categorytranslation.Columns, sqlgraph.NewFieldSpec(categorytranslation.FieldID, field.TypeInt))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.CategoryID(); ok {
+		_spec.SetField(categorytranslation.FieldCategoryID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedCategoryID(); ok {
+		_spec.AddField(categorytranslation.FieldCategoryID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.Locale(); ok {
+		_spec.SetField(categorytranslation.FieldLocale, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Label(); ok {
+		_spec.SetField(categorytranslation.FieldLabel, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Description(); ok {
+		_spec.SetField(categorytranslation.FieldDescription, field.TypeString, value)
+	}
+	if _u.mutation.DescriptionCleared() {
+		_spec.ClearField(categorytranslation.FieldDescription, field.TypeString)
+	}
+	if value, ok := _u.mutation.CreatedBy(); ok {
+		_spec.SetField(categorytranslation.FieldCreatedBy, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedCreatedBy(); ok {
+		_spec.AddField(categorytranslation.FieldCreatedBy, field.TypeInt, value)
+	}
+	if _u.mutation.CreatedByCleared() {
+		_spec.ClearField(categorytranslation.FieldCreatedBy, field.TypeInt)
+	}
+	if value, ok := _u.mutation.UpdatedBy(); ok {
+		_spec.SetField(categorytranslation.FieldUpdatedBy, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedUpdatedBy(); ok {
+		_spec.AddField(categorytranslation.FieldUpdatedBy, field.TypeInt, value)
+	}
+	if _u.mutation.UpdatedByCleared() {
+		_spec.ClearField(categorytranslation.FieldUpdatedBy, field.TypeInt)
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(categorytranslation.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{categorytranslation.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// CategoryTranslationUpdateOne is the builder for updating a single CategoryTranslation entity.
+type CategoryTranslationUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *CategoryTranslationMutation
+}
+
+// SetCategoryID sets the "category_id" field.
+func (_u *CategoryTranslationUpdateOne) SetCategoryID(v int) *CategoryTranslationUpdateOne {
+	_u.mutation.ResetCategoryID()
+	_u.mutation.SetCategoryID(v)
+	return _u
+}
+
+// SetNillableCategoryID sets the "category_id" field if the given value is not nil.
+func (_u *CategoryTranslationUpdateOne) SetNillableCategoryID(v *int) *CategoryTranslationUpdateOne {
+	if v != nil {
+		_u.SetCategoryID(*v)
+	}
+	return _u
+}
+
+// AddCategoryID adds value to the "category_id" field.
+func (_u *CategoryTranslationUpdateOne) AddCategoryID(v int) *CategoryTranslationUpdateOne {
+	_u.mutation.AddCategoryID(v)
+	return _u
+}
+
+// SetLocale sets the "locale" field.
+func (_u *CategoryTranslationUpdateOne) SetLocale(v string) *CategoryTranslationUpdateOne {
+	_u.mutation.SetLocale(v)
+	return _u
+}
+
+// SetNillableLocale sets the "locale" field if the given value is not nil.
+func (_u *CategoryTranslationUpdateOne) SetNillableLocale(v *string) *CategoryTranslationUpdateOne {
+	if v != nil {
+		_u.SetLocale(*v)
+	}
+	return _u
+}
+
+// SetLabel sets the "label" field.
+func (_u *CategoryTranslationUpdateOne) SetLabel(v string) *CategoryTranslationUpdateOne {
+	_u.mutation.SetLabel(v)
+	return _u
+}
+
+// SetNillableLabel sets the "label" field if the given value is not nil.
+func (_u *CategoryTranslationUpdateOne) SetNillableLabel(v *string) *CategoryTranslationUpdateOne {
+	if v != nil {
+		_u.SetLabel(*v)
+	}
+	return _u
+}
+
+// SetDescription sets the "description" field.
+func (_u *CategoryTranslationUpdateOne) SetDescription(v string) *CategoryTranslationUpdateOne {
+	_u.mutation.SetDescription(v)
+	return _u
+}
+
+// SetNillableDescription sets the "description" field if the given value is not nil.
+func (_u *CategoryTranslationUpdateOne) SetNillableDescription(v *string) *CategoryTranslationUpdateOne {
+	if v != nil {
+		_u.SetDescription(*v)
+	}
+	return _u
+}
+
+// ClearDescription clears the value of the "description" field.
+func (_u *CategoryTranslationUpdateOne) ClearDescription() *CategoryTranslationUpdateOne {
+	_u.mutation.ClearDescription()
+	return _u
+}
+
+// SetCreatedBy sets the "created_by" field.
+func (_u *CategoryTranslationUpdateOne) SetCreatedBy(v int) *CategoryTranslationUpdateOne {
+	_u.mutation.ResetCreatedBy()
+	_u.mutation.SetCreatedBy(v)
+	return _u
+}
+
+// SetNillableCreatedBy sets the "created_by" field if the given value is not nil.
+func (_u *CategoryTranslationUpdateOne) SetNillableCreatedBy(v *int) *CategoryTranslationUpdateOne {
+	if v != nil {
+		_u.SetCreatedBy(*v)
+	}
+	return _u
+}
+
+// AddCreatedBy adds value to the "created_by" field.
+func (_u *CategoryTranslationUpdateOne) AddCreatedBy(v int) *CategoryTranslationUpdateOne {
+	_u.mutation.AddCreatedBy(v)
+	return _u
+}
+
+// ClearCreatedBy clears the value of the "created_by" field.
+func (_u *CategoryTranslationUpdateOne) ClearCreatedBy() *CategoryTranslationUpdateOne {
+	_u.mutation.ClearCreatedBy()
+	return _u
+}
+
+// SetUpdatedBy sets the "updated_by" field.
+func (_u *CategoryTranslationUpdateOne) SetUpdatedBy(v int) *CategoryTranslationUpdateOne {
+	_u.mutation.ResetUpdatedBy()
+	_u.mutation.SetUpdatedBy(v)
+	return _u
+}
+
+// SetNillableUpdatedBy sets the "updated_by" field if the given value is not nil.
+func (_u *CategoryTranslationUpdateOne) SetNillableUpdatedBy(v *int) *CategoryTranslationUpdateOne {
+	if v != nil {
+		_u.SetUpdatedBy(*v)
+	}
+	return _u
+}
+
+// AddUpdatedBy adds value to the "updated_by" field.
+func (_u *CategoryTranslationUpdateOne) AddUpdatedBy(v int) *CategoryTranslationUpdateOne {
+	_u.mutation.AddUpdatedBy(v)
+	return _u
+}
+
+// ClearUpdatedBy clears the value of the "updated_by" field.
+func (_u *CategoryTranslationUpdateOne) ClearUpdatedBy() *CategoryTranslationUpdateOne {
+	_u.mutation.ClearUpdatedBy()
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *CategoryTranslationUpdateOne) SetUpdatedAt(v time.Time) *CategoryTranslationUpdateOne {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// Mutation returns the CategoryTranslationMutation object of the builder.
+func (_u *CategoryTranslationUpdateOne) Mutation() *CategoryTranslationMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the CategoryTranslationUpdate builder.
+func (_u *CategoryTranslationUpdateOne) Where(ps ...predicate.CategoryTranslation) *CategoryTranslationUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *CategoryTranslationUpdateOne) Select(field string, fields ...string) *CategoryTranslationUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated CategoryTranslation entity.
+func (_u *CategoryTranslationUpdateOne) Save(ctx context.Context) (*CategoryTranslation, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *CategoryTranslationUpdateOne) SaveX(ctx context.Context) *CategoryTranslation {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *CategoryTranslationUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *CategoryTranslationUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *CategoryTranslationUpdateOne) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := categorytranslation.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *CategoryTranslationUpdateOne) check() error {
+	if v, ok := _u.mutation.CategoryID(); ok {
+		if err := categorytranslation.CategoryIDValidator(v); err != nil {
+			return &ValidationError{Name: "category_id", err: fmt.Errorf(`ent: validator failed for field "CategoryTranslation.category_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Locale(); ok {
+		if err := categorytranslation.LocaleValidator(v); err != nil {
+			return &ValidationError{Name: "locale", err: fmt.Errorf(`ent: validator failed for field "CategoryTranslation.locale": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Label(); ok {
+		if err := categorytranslation.LabelValidator(v); err != nil {
+			return &ValidationError{Name: "label", err: fmt.Errorf(`ent: validator failed for field "CategoryTranslation.label": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *CategoryTranslationUpdateOne) sqlSave(ctx context.Context) (_node *CategoryTranslation, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(categorytranslation.Table, categorytranslation.Columns, sqlgraph.NewFieldSpec(categorytranslation.FieldID, field.TypeInt))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "CategoryTranslation.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, categorytranslation.FieldID)
+		for _, f := range fields {
+			if !categorytranslation.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != categorytranslation.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.CategoryID(); ok {
+		_spec.SetField(categorytranslation.FieldCategoryID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedCategoryID(); ok {
+		_spec.AddField(categorytranslation.FieldCategoryID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.Locale(); ok {
+		_spec.SetField(categorytranslation.FieldLocale, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Label(); ok {
+		_spec.SetField(categorytranslation.FieldLabel, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Description(); ok {
+		_spec.SetField(categorytranslation.FieldDescription, field.TypeString, value)
+	}
+	if _u.mutation.DescriptionCleared() {
+		_spec.ClearField(categorytranslation.FieldDescription, field.TypeString)
+	}
+	if value, ok := _u.mutation.CreatedBy(); ok {
+		_spec.SetField(categorytranslation.FieldCreatedBy, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedCreatedBy(); ok {
+		_spec.AddField(categorytranslation.FieldCreatedBy, field.TypeInt, value)
+	}
+	if _u.mutation.CreatedByCleared() {
+		_spec.ClearField(categorytranslation.FieldCreatedBy, field.TypeInt)
+	}
+	if value, ok := _u.mutation.UpdatedBy(); ok {
+		_spec.SetField(categorytranslation.FieldUpdatedBy, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedUpdatedBy(); ok {
+		_spec.AddField(categorytranslation.FieldUpdatedBy, field.TypeInt, value)
+	}
+	if _u.mutation.UpdatedByCleared() {
+		_spec.ClearField(categorytranslation.FieldUpdatedBy, field.TypeInt)
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(categorytranslation.FieldUpdatedAt, field.TypeTime, value)
+	}
+	_node = &CategoryTranslation{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{categorytranslation.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
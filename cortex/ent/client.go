@@ -12,12 +12,16 @@ import (
 	"cortex/ent/migrate"
 
 	"cortex/ent/category"
+	"cortex/ent/categoryslughistory"
+	"cortex/ent/categorytranslation"
 	"cortex/ent/tenant"
 	"cortex/ent/user"
 
 	"entgo.io/ent"
 	"entgo.io/ent/dialect"
 	"entgo.io/ent/dialect/sql"
+
+	stdsql "database/sql"
 )
 
 // Client is the client that holds all ent builders.
@@ -27,6 +31,10 @@ type Client struct {
 	Schema *migrate.Schema
 	// Category is the client for interacting with the Category builders.
 	Category *CategoryClient
+	// CategorySlugHistory is the client for interacting with the CategorySlugHistory builders.
+	CategorySlugHistory *CategorySlugHistoryClient
+	// CategoryTranslation is the client for interacting with the CategoryTranslation builders.
+	CategoryTranslation *CategoryTranslationClient
 	// Tenant is the client for interacting with the Tenant builders.
 	Tenant *TenantClient
 	// User is the client for interacting with the User builders.
@@ -43,6 +51,8 @@ func NewClient(opts ...Option) *Client {
 func (c *Client) init() {
 	c.Schema = migrate.NewSchema(c.driver)
 	c.Category = NewCategoryClient(c.config)
+	c.CategorySlugHistory = NewCategorySlugHistoryClient(c.config)
+	c.CategoryTranslation = NewCategoryTranslationClient(c.config)
 	c.Tenant = NewTenantClient(c.config)
 	c.User = NewUserClient(c.config)
 }
@@ -135,11 +145,13 @@ func (c *Client) Tx(ctx context.Context) (*Tx, error) {
 	cfg := c.config
 	cfg.driver = tx
 	return &Tx{
-		ctx:      ctx,
-		config:   cfg,
-		Category: NewCategoryClient(cfg),
-		Tenant:   NewTenantClient(cfg),
-		User:     NewUserClient(cfg),
+		ctx:                 ctx,
+		config:              cfg,
+		Category:            NewCategoryClient(cfg),
+		CategorySlugHistory: NewCategorySlugHistoryClient(cfg),
+		CategoryTranslation: NewCategoryTranslationClient(cfg),
+		Tenant:              NewTenantClient(cfg),
+		User:                NewUserClient(cfg),
 	}, nil
 }
 
@@ -157,11 +169,13 @@ func (c *Client) BeginTx(ctx context.Context, opts *sql.TxOptions) (*Tx, error)
 	cfg := c.config
 	cfg.driver = &txDriver{tx: tx, drv: c.driver}
 	return &Tx{
-		ctx:      ctx,
-		config:   cfg,
-		Category: NewCategoryClient(cfg),
-		Tenant:   NewTenantClient(cfg),
-		User:     NewUserClient(cfg),
+		ctx:                 ctx,
+		config:              cfg,
+		Category:            NewCategoryClient(cfg),
+		CategorySlugHistory: NewCategorySlugHistoryClient(cfg),
+		CategoryTranslation: NewCategoryTranslationClient(cfg),
+		Tenant:              NewTenantClient(cfg),
+		User:                NewUserClient(cfg),
 	}, nil
 }
 
@@ -191,6 +205,8 @@ func (c *Client) Close() error {
 // In order to add hooks to a specific client, call: `client.Node.Use(...)`.
 func (c *Client) Use(hooks ...Hook) {
 	c.Category.Use(hooks...)
+	c.CategorySlugHistory.Use(hooks...)
+	c.CategoryTranslation.Use(hooks...)
 	c.Tenant.Use(hooks...)
 	c.User.Use(hooks...)
 }
@@ -199,6 +215,8 @@ func (c *Client) Use(hooks ...Hook) {
 // In order to add interceptors to a specific client, call: `client.Node.Intercept(...)`.
 func (c *Client) Intercept(interceptors ...Interceptor) {
 	c.Category.Intercept(interceptors...)
+	c.CategorySlugHistory.Intercept(interceptors...)
+	c.CategoryTranslation.Intercept(interceptors...)
 	c.Tenant.Intercept(interceptors...)
 	c.User.Intercept(interceptors...)
 }
@@ -208,6 +226,10 @@ func (c *Client) Mutate(ctx context.Context, m Mutation) (Value, error) {
 	switch m := m.(type) {
 	case *CategoryMutation:
 		return c.Category.mutate(ctx, m)
+	case *CategorySlugHistoryMutation:
+		return c.CategorySlugHistory.mutate(ctx, m)
+	case *CategoryTranslationMutation:
+		return c.CategoryTranslation.mutate(ctx, m)
 	case *TenantMutation:
 		return c.Tenant.mutate(ctx, m)
 	case *UserMutation:
@@ -350,6 +372,272 @@ func (c *CategoryClient) mutate(ctx context.Context, m *CategoryMutation) (Value
 	}
 }
 
+// CategorySlugHistoryClient is a client for the CategorySlugHistory schema.
+type CategorySlugHistoryClient struct {
+	config
+}
+
+// NewCategorySlugHistoryClient returns a client for the CategorySlugHistory from the given config.
+func NewCategorySlugHistoryClient(c config) *CategorySlugHistoryClient {
+	return &CategorySlugHistoryClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `categoryslughistory.Hooks(f(g(h())))`.
+func (c *CategorySlugHistoryClient) Use(hooks ...Hook) {
+	c.hooks.CategorySlugHistory = append(c.hooks.CategorySlugHistory, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `categoryslughistory.Intercept(f(g(h())))`.
+func (c *CategorySlugHistoryClient) Intercept(interceptors ...Interceptor) {
+	c.inters.CategorySlugHistory = append(c.inters.CategorySlugHistory, interceptors...)
+}
+
+// Create returns a builder for creating a CategorySlugHistory entity.
+func (c *CategorySlugHistoryClient) Create() *CategorySlugHistoryCreate {
+	mutation := newCategorySlugHistoryMutation(c.config, OpCreate)
+	return &CategorySlugHistoryCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of CategorySlugHistory entities.
+func (c *CategorySlugHistoryClient) CreateBulk(builders ...*CategorySlugHistoryCreate) *CategorySlugHistoryCreateBulk {
+	return &CategorySlugHistoryCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *CategorySlugHistoryClient) MapCreateBulk(slice any, setFunc func(*CategorySlugHistoryCreate, int)) *CategorySlugHistoryCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &CategorySlugHistoryCreateBulk{err: fmt.Errorf("calling to CategorySlugHistoryClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*CategorySlugHistoryCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &CategorySlugHistoryCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for CategorySlugHistory.
+func (c *CategorySlugHistoryClient) Update() *CategorySlugHistoryUpdate {
+	mutation := newCategorySlugHistoryMutation(c.config, OpUpdate)
+	return &CategorySlugHistoryUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *CategorySlugHistoryClient) UpdateOne(_m *CategorySlugHistory) *CategorySlugHistoryUpdateOne {
+	mutation := newCategorySlugHistoryMutation(c.config, OpUpdateOne, withCategorySlugHistory(_m))
+	return &CategorySlugHistoryUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *CategorySlugHistoryClient) UpdateOneID(id int) *CategorySlugHistoryUpdateOne {
+	mutation := newCategorySlugHistoryMutation(c.config, OpUpdateOne, withCategorySlugHistoryID(id))
+	return &CategorySlugHistoryUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for CategorySlugHistory.
+func (c *CategorySlugHistoryClient) Delete() *CategorySlugHistoryDelete {
+	mutation := newCategorySlugHistoryMutation(c.config, OpDelete)
+	return &CategorySlugHistoryDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *CategorySlugHistoryClient) DeleteOne(_m *CategorySlugHistory) *CategorySlugHistoryDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *CategorySlugHistoryClient) DeleteOneID(id int) *CategorySlugHistoryDeleteOne {
+	builder := c.Delete().Where(categoryslughistory.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &CategorySlugHistoryDeleteOne{builder}
+}
+
+// Query returns a query builder for CategorySlugHistory.
+func (c *CategorySlugHistoryClient) Query() *CategorySlugHistoryQuery {
+	return &CategorySlugHistoryQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeCategorySlugHistory},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a CategorySlugHistory entity by its id.
+func (c *CategorySlugHistoryClient) Get(ctx context.Context, id int) (*CategorySlugHistory, error) {
+	return c.Query().Where(categoryslughistory.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *CategorySlugHistoryClient) GetX(ctx context.Context, id int) *CategorySlugHistory {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *CategorySlugHistoryClient) Hooks() []Hook {
+	return c.hooks.CategorySlugHistory
+}
+
+// Interceptors returns the client interceptors.
+func (c *CategorySlugHistoryClient) Interceptors() []Interceptor {
+	return c.inters.CategorySlugHistory
+}
+
+func (c *CategorySlugHistoryClient) mutate(ctx context.Context, m *CategorySlugHistoryMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&CategorySlugHistoryCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&CategorySlugHistoryUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&CategorySlugHistoryUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&CategorySlugHistoryDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown CategorySlugHistory mutation op: %q", m.Op())
+	}
+}
+
+// CategoryTranslationClient is a client for the CategoryTranslation schema.
+type CategoryTranslationClient struct {
+	config
+}
+
+// NewCategoryTranslationClient returns a client for the CategoryTranslation from the given config.
+func NewCategoryTranslationClient(c config) *CategoryTranslationClient {
+	return &CategoryTranslationClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `categorytranslation.Hooks(f(g(h())))`.
+func (c *CategoryTranslationClient) Use(hooks ...Hook) {
+	c.hooks.CategoryTranslation = append(c.hooks.CategoryTranslation, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `categorytranslation.Intercept(f(g(h())))`.
+func (c *CategoryTranslationClient) Intercept(interceptors ...Interceptor) {
+	c.inters.CategoryTranslation = append(c.inters.CategoryTranslation, interceptors...)
+}
+
+// Create returns a builder for creating a CategoryTranslation entity.
+func (c *CategoryTranslationClient) Create() *CategoryTranslationCreate {
+	mutation := newCategoryTranslationMutation(c.config, OpCreate)
+	return &CategoryTranslationCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of CategoryTranslation entities.
+func (c *CategoryTranslationClient) CreateBulk(builders ...*CategoryTranslationCreate) *CategoryTranslationCreateBulk {
+	return &CategoryTranslationCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *CategoryTranslationClient) MapCreateBulk(slice any, setFunc func(*CategoryTranslationCreate, int)) *CategoryTranslationCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &CategoryTranslationCreateBulk{err: fmt.Errorf("calling to CategoryTranslationClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*CategoryTranslationCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &CategoryTranslationCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for CategoryTranslation.
+func (c *CategoryTranslationClient) Update() *CategoryTranslationUpdate {
+	mutation := newCategoryTranslationMutation(c.config, OpUpdate)
+	return &CategoryTranslationUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *CategoryTranslationClient) UpdateOne(_m *CategoryTranslation) *CategoryTranslationUpdateOne {
+	mutation := newCategoryTranslationMutation(c.config, OpUpdateOne, withCategoryTranslation(_m))
+	return &CategoryTranslationUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *CategoryTranslationClient) UpdateOneID(id int) *CategoryTranslationUpdateOne {
+	mutation := newCategoryTranslationMutation(c.config, OpUpdateOne, withCategoryTranslationID(id))
+	return &CategoryTranslationUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for CategoryTranslation.
+func (c *CategoryTranslationClient) Delete() *CategoryTranslationDelete {
+	mutation := newCategoryTranslationMutation(c.config, OpDelete)
+	return &CategoryTranslationDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *CategoryTranslationClient) DeleteOne(_m *CategoryTranslation) *CategoryTranslationDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *CategoryTranslationClient) DeleteOneID(id int) *CategoryTranslationDeleteOne {
+	builder := c.Delete().Where(categorytranslation.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &CategoryTranslationDeleteOne{builder}
+}
+
+// Query returns a query builder for CategoryTranslation.
+func (c *CategoryTranslationClient) Query() *CategoryTranslationQuery {
+	return &CategoryTranslationQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeCategoryTranslation},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a CategoryTranslation entity by its id.
+func (c *CategoryTranslationClient) Get(ctx context.Context, id int) (*CategoryTranslation, error) {
+	return c.Query().Where(categorytranslation.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *CategoryTranslationClient) GetX(ctx context.Context, id int) *CategoryTranslation {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *CategoryTranslationClient) Hooks() []Hook {
+	return c.hooks.CategoryTranslation
+}
+
+// Interceptors returns the client interceptors.
+func (c *CategoryTranslationClient) Interceptors() []Interceptor {
+	return c.inters.CategoryTranslation
+}
+
+func (c *CategoryTranslationClient) mutate(ctx context.Context, m *CategoryTranslationMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&CategoryTranslationCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&CategoryTranslationUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&CategoryTranslationUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&CategoryTranslationDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown CategoryTranslation mutation op: %q", m.Op())
+	}
+}
+
 // TenantClient is a client for the Tenant schema.
 type TenantClient struct {
 	config
@@ -619,9 +907,34 @@ func (c *UserClient) mutate(ctx context.Context, m *UserMutation) (Value, error)
 // hooks and interceptors per client, for fast access.
 type (
 	hooks struct {
-		Category, Tenant, User []ent.Hook
+		Category, CategorySlugHistory, CategoryTranslation, Tenant, User []ent.Hook
 	}
 	inters struct {
-		Category, Tenant, User []ent.Interceptor
+		Category, CategorySlugHistory, CategoryTranslation, Tenant,
+		User []ent.Interceptor
 	}
 )
+
+// ExecContext allows calling the underlying ExecContext method of the driver if it is supported by it.
+// See, database/sql#DB.ExecContext for more information.
+func (c *config) ExecContext(ctx context.Context, query string, args ...any) (stdsql.Result, error) {
+	ex, ok := c.driver.(interface {
+		ExecContext(context.Context, string, ...any) (stdsql.Result, error)
+	})
+	if !ok {
+		return nil, fmt.Errorf("Driver.ExecContext is not supported")
+	}
+	return ex.ExecContext(ctx, query, args...)
+}
+
+// QueryContext allows calling the underlying QueryContext method of the driver if it is supported by it.
+// See, database/sql#DB.QueryContext for more information.
+func (c *config) QueryContext(ctx context.Context, query string, args ...any) (*stdsql.Rows, error) {
+	q, ok := c.driver.(interface {
+		QueryContext(context.Context, string, ...any) (*stdsql.Rows, error)
+	})
+	if !ok {
+		return nil, fmt.Errorf("Driver.QueryContext is not supported")
+	}
+	return q.QueryContext(ctx, query, args...)
+}
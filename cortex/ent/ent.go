@@ -5,6 +5,8 @@ package ent
 import (
 	"context"
 	"cortex/ent/category"
+	"cortex/ent/categoryslughistory"
+	"cortex/ent/categorytranslation"
 	"cortex/ent/tenant"
 	"cortex/ent/user"
 	"errors"
@@ -75,9 +77,11 @@ var (
 func checkColumn(t, c string) error {
 	initCheck.Do(func() {
 		columnCheck = sql.NewColumnCheck(map[string]func(string) bool{
-			category.Table: category.ValidColumn,
-			tenant.Table:   tenant.ValidColumn,
-			user.Table:     user.ValidColumn,
+			category.Table:            category.ValidColumn,
+			categoryslughistory.Table: categoryslughistory.ValidColumn,
+			categorytranslation.Table: categorytranslation.ValidColumn,
+			tenant.Table:              tenant.ValidColumn,
+			user.Table:                user.ValidColumn,
 		})
 	})
 	return columnCheck(t, c)
@@ -20,6 +20,30 @@ func (f CategoryFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, er
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.CategoryMutation", m)
 }
 
+// The CategorySlugHistoryFunc type is an adapter to allow the use of ordinary
+// function as CategorySlugHistory mutator.
+type CategorySlugHistoryFunc func(context.Context, *ent.CategorySlugHistoryMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f CategorySlugHistoryFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.CategorySlugHistoryMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.CategorySlugHistoryMutation", m)
+}
+
+// The CategoryTranslationFunc type is an adapter to allow the use of ordinary
+// function as CategoryTranslation mutator.
+type CategoryTranslationFunc func(context.Context, *ent.CategoryTranslationMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f CategoryTranslationFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.CategoryTranslationMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.CategoryTranslationMutation", m)
+}
+
 // The TenantFunc type is an adapter to allow the use of ordinary
 // function as Tenant mutator.
 type TenantFunc func(context.Context, *ent.TenantMutation) (ent.Value, error)
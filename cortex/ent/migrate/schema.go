@@ -27,12 +27,66 @@ var (
 		{Name: "deleted_at", Type: field.TypeTime, Nullable: true},
 		{Name: "status", Type: field.TypeEnum, Enums: []string{"pending", "approved", "rejected", "deleted"}, Default: "pending"},
 		{Name: "meta", Type: field.TypeJSON, Nullable: true},
+		{Name: "position", Type: field.TypeInt, Default: 0},
 	}
 	// CategoriesTable holds the schema information for the "categories" table.
 	CategoriesTable = &schema.Table{
 		Name:       "categories",
 		Columns:    CategoriesColumns,
 		PrimaryKey: []*schema.Column{CategoriesColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "category_parent_id_position",
+				Unique:  false,
+				Columns: []*schema.Column{CategoriesColumns[4], CategoriesColumns[17]},
+			},
+		},
+	}
+	// CategorySlugHistoriesColumns holds the columns for the "category_slug_histories" table.
+	CategorySlugHistoriesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "category_id", Type: field.TypeInt},
+		{Name: "slug", Type: field.TypeString, Unique: true},
+		{Name: "changed_by", Type: field.TypeInt, Nullable: true},
+		{Name: "created_at", Type: field.TypeTime},
+	}
+	// CategorySlugHistoriesTable holds the schema information for the "category_slug_histories" table.
+	CategorySlugHistoriesTable = &schema.Table{
+		Name:       "category_slug_histories",
+		Columns:    CategorySlugHistoriesColumns,
+		PrimaryKey: []*schema.Column{CategorySlugHistoriesColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "categoryslughistory_category_id",
+				Unique:  false,
+				Columns: []*schema.Column{CategorySlugHistoriesColumns[1]},
+			},
+		},
+	}
+	// CategoryTranslationsColumns holds the columns for the "category_translations" table.
+	CategoryTranslationsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "category_id", Type: field.TypeInt},
+		{Name: "locale", Type: field.TypeString, Size: 35},
+		{Name: "label", Type: field.TypeString},
+		{Name: "description", Type: field.TypeString, Nullable: true, Size: 2147483647},
+		{Name: "created_by", Type: field.TypeInt, Nullable: true},
+		{Name: "updated_by", Type: field.TypeInt, Nullable: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+	}
+	// CategoryTranslationsTable holds the schema information for the "category_translations" table.
+	CategoryTranslationsTable = &schema.Table{
+		Name:       "category_translations",
+		Columns:    CategoryTranslationsColumns,
+		PrimaryKey: []*schema.Column{CategoryTranslationsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "categorytranslation_category_id_locale",
+				Unique:  true,
+				Columns: []*schema.Column{CategoryTranslationsColumns[1], CategoryTranslationsColumns[2]},
+			},
+		},
 	}
 	// TenantsColumns holds the columns for the "tenants" table.
 	TenantsColumns = []*schema.Column{
@@ -95,6 +149,8 @@ var (
 	// Tables holds all the tables in the schema.
 	Tables = []*schema.Table{
 		CategoriesTable,
+		CategorySlugHistoriesTable,
+		CategoryTranslationsTable,
 		TenantsTable,
 		UsersTable,
 	}
@@ -9,6 +9,12 @@ import (
 // Category is the predicate function for category builders.
 type Category func(*sql.Selector)
 
+// CategorySlugHistory is the predicate function for categoryslughistory builders.
+type CategorySlugHistory func(*sql.Selector)
+
+// CategoryTranslation is the predicate function for categorytranslation builders.
+type CategoryTranslation func(*sql.Selector)
+
 // Tenant is the predicate function for tenant builders.
 type Tenant func(*sql.Selector)
 
@@ -4,6 +4,8 @@ package ent
 
 import (
 	"cortex/ent/category"
+	"cortex/ent/categoryslughistory"
+	"cortex/ent/categorytranslation"
 	"cortex/ent/schema"
 	"cortex/ent/tenant"
 	"cortex/ent/user"
@@ -47,6 +49,64 @@ func init() {
 	categoryDescCreatorID := categoryFields[3].Descriptor()
 	// category.CreatorIDValidator is a validator for the "creator_id" field. It is called by the builders before save.
 	category.CreatorIDValidator = categoryDescCreatorID.Validators[0].(func(int) error)
+	// categoryDescPosition is the schema descriptor for position field.
+	categoryDescPosition := categoryFields[13].Descriptor()
+	// category.DefaultPosition holds the default value on creation for the position field.
+	category.DefaultPosition = categoryDescPosition.Default.(int)
+	// category.PositionValidator is a validator for the "position" field. It is called by the builders before save.
+	category.PositionValidator = categoryDescPosition.Validators[0].(func(int) error)
+	categoryslughistoryFields := schema.CategorySlugHistory{}.Fields()
+	_ = categoryslughistoryFields
+	// categoryslughistoryDescCategoryID is the schema descriptor for category_id field.
+	categoryslughistoryDescCategoryID := categoryslughistoryFields[0].Descriptor()
+	// categoryslughistory.CategoryIDValidator is a validator for the "category_id" field. It is called by the builders before save.
+	categoryslughistory.CategoryIDValidator = categoryslughistoryDescCategoryID.Validators[0].(func(int) error)
+	// categoryslughistoryDescSlug is the schema descriptor for slug field.
+	categoryslughistoryDescSlug := categoryslughistoryFields[1].Descriptor()
+	// categoryslughistory.SlugValidator is a validator for the "slug" field. It is called by the builders before save.
+	categoryslughistory.SlugValidator = categoryslughistoryDescSlug.Validators[0].(func(string) error)
+	// categoryslughistoryDescCreatedAt is the schema descriptor for created_at field.
+	categoryslughistoryDescCreatedAt := categoryslughistoryFields[3].Descriptor()
+	// categoryslughistory.DefaultCreatedAt holds the default value on creation for the created_at field.
+	categoryslughistory.DefaultCreatedAt = categoryslughistoryDescCreatedAt.Default.(func() time.Time)
+	categorytranslationFields := schema.CategoryTranslation{}.Fields()
+	_ = categorytranslationFields
+	// categorytranslationDescCategoryID is the schema descriptor for category_id field.
+	categorytranslationDescCategoryID := categorytranslationFields[0].Descriptor()
+	// categorytranslation.CategoryIDValidator is a validator for the "category_id" field. It is called by the builders before save.
+	categorytranslation.CategoryIDValidator = categorytranslationDescCategoryID.Validators[0].(func(int) error)
+	// categorytranslationDescLocale is the schema descriptor for locale field.
+	categorytranslationDescLocale := categorytranslationFields[1].Descriptor()
+	// categorytranslation.LocaleValidator is a validator for the "locale" field. It is called by the builders before save.
+	categorytranslation.LocaleValidator = func() func(string) error {
+		validators := categorytranslationDescLocale.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(locale string) error {
+			for _, fn := range fns {
+				if err := fn(locale); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// categorytranslationDescLabel is the schema descriptor for label field.
+	categorytranslationDescLabel := categorytranslationFields[2].Descriptor()
+	// categorytranslation.LabelValidator is a validator for the "label" field. It is called by the builders before save.
+	categorytranslation.LabelValidator = categorytranslationDescLabel.Validators[0].(func(string) error)
+	// categorytranslationDescCreatedAt is the schema descriptor for created_at field.
+	categorytranslationDescCreatedAt := categorytranslationFields[6].Descriptor()
+	// categorytranslation.DefaultCreatedAt holds the default value on creation for the created_at field.
+	categorytranslation.DefaultCreatedAt = categorytranslationDescCreatedAt.Default.(func() time.Time)
+	// categorytranslationDescUpdatedAt is the schema descriptor for updated_at field.
+	categorytranslationDescUpdatedAt := categorytranslationFields[7].Descriptor()
+	// categorytranslation.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	categorytranslation.DefaultUpdatedAt = categorytranslationDescUpdatedAt.Default.(func() time.Time)
+	// categorytranslation.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	categorytranslation.UpdateDefaultUpdatedAt = categorytranslationDescUpdatedAt.UpdateDefault.(func() time.Time)
 	tenantFields := schema.Tenant{}.Fields()
 	_ = tenantFields
 	// tenantDescUUID is the schema descriptor for uuid field.
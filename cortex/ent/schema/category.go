@@ -3,6 +3,7 @@ package schema
 import (
 	"entgo.io/ent"
 	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
 )
 
 // Category holds the schema definition for the Category entity.
@@ -56,6 +57,18 @@ func (Category) Fields() []ent.Field {
 
 		field.JSON("meta", map[string]any{}).
 			Optional(),
+
+		// Manual ordering among siblings (categories sharing the same parent)
+		field.Int("position").
+			NonNegative().
+			Default(0),
+	}
+}
+
+// Indexes of the Category.
+func (Category) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("parent_id", "position"),
 	}
 }
 
@@ -0,0 +1,47 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// CategorySlugHistory holds the schema definition for the CategorySlugHistory entity.
+// Every time a category (or subcategory) slug changes, the previous slug is stored here
+// so that old links can be redirected to the canonical slug.
+type CategorySlugHistory struct {
+	ent.Schema
+}
+
+// Fields of the CategorySlugHistory.
+func (CategorySlugHistory) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int("category_id").
+			Positive(),
+
+		field.String("slug").
+			Unique().
+			NotEmpty(),
+
+		field.Int("changed_by").
+			Optional(),
+
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable(),
+	}
+}
+
+// Edges of the CategorySlugHistory.
+func (CategorySlugHistory) Edges() []ent.Edge {
+	return nil
+}
+
+// Indexes of the CategorySlugHistory.
+func (CategorySlugHistory) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("category_id"),
+	}
+}
@@ -0,0 +1,62 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// CategoryTranslation holds the schema definition for the CategoryTranslation entity.
+// It stores the label and description of a category (or subcategory) in one locale,
+// the category row itself keeps the tenant default text.
+type CategoryTranslation struct {
+	ent.Schema
+}
+
+// Fields of the CategoryTranslation.
+func (CategoryTranslation) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int("category_id").
+			Positive(),
+
+		// BCP 47 language tag in lower case, e.g. "bn" or "en-us"
+		field.String("locale").
+			NotEmpty().
+			MaxLen(35),
+
+		field.String("label").
+			NotEmpty(),
+
+		field.Text("description").
+			Optional(),
+
+		field.Int("created_by").
+			Optional(),
+
+		field.Int("updated_by").
+			Optional(),
+
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable(),
+
+		field.Time("updated_at").
+			Default(time.Now).
+			UpdateDefault(time.Now),
+	}
+}
+
+// Edges of the CategoryTranslation.
+func (CategoryTranslation) Edges() []ent.Edge {
+	return nil
+}
+
+// Indexes of the CategoryTranslation.
+func (CategoryTranslation) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("category_id", "locale").
+			Unique(),
+	}
+}
@@ -1,9 +1,29 @@
 package customerrors
 
-import "errors"
+import (
+	"errors"
+	"time"
+)
 
 var (
 	ErrSlugExists             = errors.New("category slug already exists")
 	ErrCategoryNotFound       = errors.New("category not found")
 	ErrCategoryAlreadyDeleted = errors.New("category deleted")
+	ErrInvalidLocale          = errors.New("invalid locale")
+	ErrTranslationNotFound    = errors.New("translation not found")
+	ErrInvalidTopPostsWindow  = errors.New("invalid top posts window, expected one of 1d, 7d, 30d")
+	ErrInvalidImport          = errors.New("import file contains invalid rows")
+	ErrInvalidCategoryOrder   = errors.New("category order must only contain distinct children of the given parent")
+	ErrParentNotTopLevel      = errors.New("parent must be a top-level category")
 )
+
+// ModifiedError reports an update based on an updated_at that is no longer the
+// stored one, Current lets the client refetch or retry against the latest revision
+type ModifiedError struct {
+	Resource string
+	Current  time.Time
+}
+
+func (e *ModifiedError) Error() string {
+	return e.Resource + " has been modified, current revision is " + e.Current.Format(time.RFC3339Nano)
+}
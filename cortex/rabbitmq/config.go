@@ -6,6 +6,9 @@ import (
 
 const CortexExchange = "cortex"
 
+// PostalExchange is the exchange postal publishes its post events to
+const PostalExchange = "postal"
+
 func QueueName(queuePrefix, routingKey string) string {
 	return fmt.Sprintf("%s%s:queue", queuePrefix, routingKey)
 }
@@ -0,0 +1,124 @@
+package handlers
+
+import (
+	"encoding/json"
+	"errors"
+	"log/slog"
+	"net/http"
+
+	"cortex/category"
+	customerrors "cortex/pkg/custom_errors"
+	"cortex/rest/middlewares"
+	"cortex/rest/utils"
+
+	"github.com/google/uuid"
+)
+
+type UpsertCategoryTranslationReq struct {
+	Label       string `json:"label" validate:"required"`
+	Description string `json:"description,omitempty"`
+}
+
+// GetCategoryTranslations lists every translation of a category or subcategory
+func (h *Handlers) GetCategoryTranslations(w http.ResponseWriter, r *http.Request) {
+	categoryUUID, err := uuid.Parse(r.PathValue("category_uuid"))
+	if err != nil {
+		utils.SendError(w, http.StatusBadRequest, "invalid UUID", nil)
+		return
+	}
+
+	translations, err := h.CategoryService.GetCategoryTranslations(r.Context(), categoryUUID)
+	if err != nil {
+		sendTranslationError(w, err)
+		return
+	}
+
+	utils.SendJson(w, http.StatusOK, SuccessResponse{
+		Message: "Translations retrieved successfully",
+		Status:  true,
+		Data:    translations,
+	})
+}
+
+// UpsertCategoryTranslation creates or replaces the translation of a category in one locale
+func (h *Handlers) UpsertCategoryTranslation(w http.ResponseWriter, r *http.Request) {
+	userID := middlewares.GetUserId(r)
+	if userID == 0 {
+		utils.SendError(w, http.StatusUnauthorized, "User not authenticated", nil)
+		return
+	}
+
+	categoryUUID, err := uuid.Parse(r.PathValue("category_uuid"))
+	if err != nil {
+		utils.SendError(w, http.StatusBadRequest, "invalid UUID", nil)
+		return
+	}
+
+	var req UpsertCategoryTranslationReq
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		utils.SendError(w, http.StatusBadRequest, "Failed to decode request body", nil)
+		return
+	}
+
+	if err := utils.Validate(req); err != nil {
+		utils.SendError(w, http.StatusBadRequest, "Invalid request", err.Error())
+		return
+	}
+
+	translation, err := h.CategoryService.UpsertCategoryTranslation(r.Context(), category.UpsertTranslationParams{
+		CategoryUUID: categoryUUID,
+		Locale:       r.PathValue("locale"),
+		Label:        req.Label,
+		Description:  req.Description,
+		UpdatedBy:    userID,
+	})
+	if err != nil {
+		sendTranslationError(w, err)
+		return
+	}
+
+	utils.SendJson(w, http.StatusOK, SuccessResponse{
+		Message: "Translation saved successfully",
+		Status:  true,
+		Data:    translation,
+	})
+}
+
+// DeleteCategoryTranslation removes the translation of a category in one locale
+func (h *Handlers) DeleteCategoryTranslation(w http.ResponseWriter, r *http.Request) {
+	userID := middlewares.GetUserId(r)
+	if userID == 0 {
+		utils.SendError(w, http.StatusUnauthorized, "User not authenticated", nil)
+		return
+	}
+
+	categoryUUID, err := uuid.Parse(r.PathValue("category_uuid"))
+	if err != nil {
+		utils.SendError(w, http.StatusBadRequest, "invalid UUID", nil)
+		return
+	}
+
+	if err := h.CategoryService.DeleteCategoryTranslation(r.Context(), categoryUUID, r.PathValue("locale")); err != nil {
+		sendTranslationError(w, err)
+		return
+	}
+
+	utils.SendJson(w, http.StatusOK, SuccessResponse{
+		Message: "Translation deleted successfully",
+		Status:  true,
+	})
+}
+
+func sendTranslationError(w http.ResponseWriter, err error) {
+	switch {
+	case errors.Is(err, customerrors.ErrInvalidLocale):
+		utils.SendError(w, http.StatusBadRequest, err.Error(), nil)
+	case errors.Is(err, customerrors.ErrCategoryNotFound):
+		utils.SendError(w, http.StatusNotFound, "Category not found", nil)
+	case errors.Is(err, customerrors.ErrTranslationNotFound):
+		utils.SendError(w, http.StatusNotFound, err.Error(), nil)
+	default:
+		slog.Error("handler: category translation request failed", slog.Any("error", err))
+		utils.SendError(w, http.StatusInternalServerError, "Failed to process translation", nil)
+	}
+}
@@ -21,6 +21,14 @@ func (handlers *Handlers) GetCategoryByUUID(w http.ResponseWriter, r *http.Reque
 		utils.SendError(w, http.StatusInternalServerError, "failed to find category", err)
 		return
 	}
+	locale, err := handlers.CategoryService.LocalizeCategory(r.Context(), cateory, handlers.requestLocales(w, r))
+	if err != nil {
+		slog.Error("handler: category translation retrieval failed", slog.Any("error", err))
+	}
+	if locale != "" {
+		w.Header().Set("Content-Language", locale)
+	}
+	w.Header().Set("ETag", utils.UpdatedAtETag(cateory.UpdatedAt))
 	utils.SendJson(w, http.StatusOK, SuccessResponse{
 		Data:   cateory,
 		Status: true,
@@ -0,0 +1,54 @@
+package handlers
+
+import (
+	"log/slog"
+	"net/http"
+
+	"cortex/rest/utils"
+)
+
+type SlugRedirect struct {
+	CanonicalSlug string `json:"canonical_slug"`
+}
+
+// GetCategoryBySlug returns a category or subcategory by its slug.
+// When the slug is a previous slug of a renamed category, it responds with
+// 301 Moved Permanently carrying the canonical slug so clients can redirect.
+func (h *Handlers) GetCategoryBySlug(w http.ResponseWriter, r *http.Request) {
+	slug := r.PathValue("slug")
+	if slug == "" {
+		utils.SendError(w, http.StatusBadRequest, "slug is required in URL", nil)
+		return
+	}
+
+	cat, err := h.CategoryService.FindCategoryBySlug(r.Context(), slug)
+	if err != nil {
+		utils.SendError(w, http.StatusNotFound, "Category not found", nil)
+		return
+	}
+
+	if cat.Slug != slug {
+		w.Header().Set("Location", "/api/v1/categories/slug/"+cat.Slug)
+		utils.SendJson(w, http.StatusMovedPermanently, SuccessResponse{
+			Message: "Category has moved permanently",
+			Status:  true,
+			Data:    SlugRedirect{CanonicalSlug: cat.Slug},
+		})
+		return
+	}
+
+	locale, err := h.CategoryService.LocalizeCategory(r.Context(), cat, h.requestLocales(w, r))
+	if err != nil {
+		slog.Error("handler: category translation retrieval failed", slog.Any("error", err))
+	}
+	if locale != "" {
+		w.Header().Set("Content-Language", locale)
+	}
+
+	w.Header().Set("ETag", utils.UpdatedAtETag(cat.UpdatedAt))
+	utils.SendJson(w, http.StatusOK, SuccessResponse{
+		Message: "Category retrieved successfully",
+		Status:  true,
+		Data:    cat,
+	})
+}
@@ -40,6 +40,8 @@ func (h *Handlers) GetCategoryList(w http.ResponseWriter, r *http.Request) {
 		filter.Label = &label
 	}
 
+	filter.Locales = h.requestLocales(w, r)
+
 	categories, err := h.CategoryService.GetCategoryList(r.Context(), filter)
 	if err != nil {
 		utils.SendError(w, http.StatusInternalServerError, "failed to retrieve categories", err)
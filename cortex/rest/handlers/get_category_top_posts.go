@@ -0,0 +1,51 @@
+package handlers
+
+import (
+	"errors"
+	"log/slog"
+	"net/http"
+
+	customerrors "cortex/pkg/custom_errors"
+	"cortex/rest/utils"
+
+	"github.com/google/uuid"
+)
+
+const (
+	defaultTopPostsLimit = 10
+	maxTopPostsLimit     = 100
+)
+
+// GetCategoryTopPosts returns the most viewed posts of a category, ?window=1d|7d|30d
+func (h *Handlers) GetCategoryTopPosts(w http.ResponseWriter, r *http.Request) {
+	categoryUUID, err := uuid.Parse(r.PathValue("category_uuid"))
+	if err != nil {
+		utils.SendError(w, http.StatusBadRequest, "invalid UUID", nil)
+		return
+	}
+
+	limit := defaultTopPostsLimit
+	if l := parseIntPointer(r.URL.Query().Get("limit")); l != nil && *l > 0 {
+		limit = min(*l, maxTopPostsLimit)
+	}
+
+	posts, err := h.CategoryService.GetTopPosts(r.Context(), categoryUUID, r.URL.Query().Get("window"), limit)
+	if err != nil {
+		switch {
+		case errors.Is(err, customerrors.ErrInvalidTopPostsWindow):
+			utils.SendError(w, http.StatusBadRequest, err.Error(), nil)
+		case errors.Is(err, customerrors.ErrCategoryNotFound):
+			utils.SendError(w, http.StatusNotFound, "Category not found", nil)
+		default:
+			slog.Error("handler: category top posts retrieval failed", slog.Any("error", err))
+			utils.SendError(w, http.StatusInternalServerError, "Failed to retrieve top posts", nil)
+		}
+		return
+	}
+
+	utils.SendJson(w, http.StatusOK, SuccessResponse{
+		Message: "Top posts retrieved successfully",
+		Status:  true,
+		Data:    posts,
+	})
+}
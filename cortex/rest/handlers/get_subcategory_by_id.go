@@ -1,11 +1,13 @@
 package handlers
 
 import (
+	"log/slog"
 	"net/http"
 	"strconv"
 
 	customerrors "cortex/pkg/custom_errors"
 	"cortex/rest/utils"
+	"cortex/subcategory"
 
 	"github.com/google/uuid"
 )
@@ -44,6 +46,8 @@ func (h *Handlers) GetSubCategoryByID(w http.ResponseWriter, r *http.Request) {
 			return
 		}
 
+		h.localizeSubcategory(w, r, subcategory)
+
 		response := SuccessResponse{
 			Message: "Subcategory retrieved successfully",
 			Data:    subcategory,
@@ -71,6 +75,8 @@ func (h *Handlers) GetSubCategoryByID(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	h.localizeSubcategory(w, r, subcategory)
+
 	response := SuccessResponse{
 		Message: "Subcategory retrieved successfully",
 		Data:    subcategory,
@@ -79,3 +85,15 @@ func (h *Handlers) GetSubCategoryByID(w http.ResponseWriter, r *http.Request) {
 
 	utils.SendJson(w, http.StatusOK, response)
 }
+
+// localizeSubcategory translates the subcategory to the requested locale and
+// announces the served locale through the Content-Language header
+func (h *Handlers) localizeSubcategory(w http.ResponseWriter, r *http.Request, sc *subcategory.Subcategory) {
+	if err := h.SubcategoryService.LocalizeSubcategories(r.Context(), h.requestLocales(w, r), sc); err != nil {
+		slog.Error("handler: subcategory translation retrieval failed", slog.Any("error", err))
+		return
+	}
+	if sc.Locale != "" {
+		w.Header().Set("Content-Language", sc.Locale)
+	}
+}
@@ -40,6 +40,8 @@ func (h *Handlers) GetSubCategoryList(w http.ResponseWriter, r *http.Request) {
 		filter.Label = &label
 	}
 
+	filter.Locales = h.requestLocales(w, r)
+
 	// Get parent UUID from query parameter (optional)
 	parentUUIDStr := r.URL.Query().Get("parent_uuid")
 
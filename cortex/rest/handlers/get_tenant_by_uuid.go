@@ -4,6 +4,8 @@ import (
 	"encoding/json"
 	"net/http"
 
+	"cortex/rest/utils"
+
 	"github.com/google/uuid"
 )
 
@@ -42,6 +44,7 @@ func (h *Handlers) GetTenantByUUID(w http.ResponseWriter, r *http.Request) {
 	}
 
 	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("ETag", utils.UpdatedAtETag(tenant.UpdatedAt))
 	w.WriteHeader(http.StatusOK)
 	json.NewEncoder(w).Encode(SuccessResponse{
 		Message: "Tenant retrieved successfully",
@@ -0,0 +1,79 @@
+package handlers
+
+import (
+	"errors"
+	"log/slog"
+	"net/http"
+	"strconv"
+
+	"cortex/category"
+	customerrors "cortex/pkg/custom_errors"
+	"cortex/rest/middlewares"
+	"cortex/rest/utils"
+)
+
+const maxCategoryImportSize = 10 << 20 // 10 MB
+
+// ImportCategories creates categories and subcategories in bulk from an uploaded
+// CSV or YAML file (multipart field "file"). With dry_run=true the file is only validated.
+func (h *Handlers) ImportCategories(w http.ResponseWriter, r *http.Request) {
+	userID := middlewares.GetUserId(r)
+	if userID == 0 {
+		utils.SendError(w, http.StatusUnauthorized, "User not authenticated", nil)
+		return
+	}
+
+	r.Body = http.MaxBytesReader(w, r.Body, maxCategoryImportSize)
+	if err := r.ParseMultipartForm(maxCategoryImportSize); err != nil {
+		utils.SendError(w, http.StatusBadRequest, "Invalid multipart form or file too large", nil)
+		return
+	}
+
+	file, header, err := r.FormFile("file")
+	if err != nil {
+		utils.SendError(w, http.StatusBadRequest, "file is required", nil)
+		return
+	}
+	defer file.Close()
+
+	format := r.FormValue("format")
+	if format == "" {
+		format = category.ImportFormatFromFilename(header.Filename)
+	}
+
+	rows, err := category.ParseImportFile(file, format)
+	if err != nil {
+		utils.SendError(w, http.StatusBadRequest, err.Error(), nil)
+		return
+	}
+
+	dryRun, _ := strconv.ParseBool(r.FormValue("dry_run"))
+
+	result, err := h.CategoryService.ImportCategories(r.Context(), category.ImportCategoriesParams{
+		Rows:      rows,
+		CreatorID: userID,
+		DryRun:    dryRun,
+	})
+	if err != nil {
+		if errors.Is(err, customerrors.ErrInvalidImport) {
+			utils.SendError(w, http.StatusUnprocessableEntity, err.Error(), result)
+			return
+		}
+		slog.Error("handler: category import failed", slog.Any("error", err))
+		utils.SendError(w, http.StatusInternalServerError, "Failed to import categories", nil)
+		return
+	}
+
+	message := "Categories imported successfully"
+	status := http.StatusCreated
+	if dryRun {
+		message = "Import file is valid"
+		status = http.StatusOK
+	}
+
+	utils.SendJson(w, status, SuccessResponse{
+		Message: message,
+		Status:  true,
+		Data:    result,
+	})
+}
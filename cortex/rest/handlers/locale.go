@@ -0,0 +1,97 @@
+package handlers
+
+import (
+	"net"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// tenantDefaultLocaleSetting is the tenant settings key holding the fallback locale
+const tenantDefaultLocaleSetting = "default_locale"
+
+// requestLocales returns the locales a request asks for in order of preference:
+// the "lang" query param, then the Accept-Language header, then the default locale
+// of the tenant serving the request. The response then depends on Accept-Language,
+// so it is added to Vary for shared caches
+func (h *Handlers) requestLocales(w http.ResponseWriter, r *http.Request) []string {
+	w.Header().Add("Vary", "Accept-Language")
+
+	var locales []string
+	if lang := r.URL.Query().Get("lang"); lang != "" {
+		locales = append(locales, lang)
+	}
+	locales = append(locales, parseAcceptLanguage(r.Header.Get("Accept-Language"))...)
+
+	if locale := h.tenantDefaultLocale(r); locale != "" {
+		locales = append(locales, locale)
+	}
+	return locales
+}
+
+// tenantDefaultLocale resolves the tenant from the X-Tenant header or the request host
+func (h *Handlers) tenantDefaultLocale(r *http.Request) string {
+	if h.TenantService == nil {
+		return ""
+	}
+
+	identifier := r.Header.Get("X-Tenant")
+	if identifier == "" {
+		identifier = r.Host
+		if host, _, err := net.SplitHostPort(r.Host); err == nil {
+			identifier = host
+		}
+	}
+	if identifier == "" {
+		return ""
+	}
+
+	t, err := h.TenantService.GetTenantByDomain(r.Context(), identifier)
+	if err != nil {
+		return ""
+	}
+	locale, _ := t.Settings[tenantDefaultLocaleSetting].(string)
+	return locale
+}
+
+// parseAcceptLanguage returns the language tags of an Accept-Language header
+// ordered by their quality value, wildcards and q=0 entries are dropped
+func parseAcceptLanguage(header string) []string {
+	type weighted struct {
+		tag string
+		q   float64
+	}
+
+	var tags []weighted
+	for _, part := range strings.Split(header, ",") {
+		tag, params, _ := strings.Cut(strings.TrimSpace(part), ";")
+		tag = strings.TrimSpace(tag)
+		if tag == "" || tag == "*" {
+			continue
+		}
+
+		q := 1.0
+		if value, found := strings.CutPrefix(strings.TrimSpace(params), "q="); found {
+			parsed, err := strconv.ParseFloat(value, 64)
+			if err != nil {
+				continue
+			}
+			q = parsed
+		}
+		if q <= 0 {
+			continue
+		}
+		tags = append(tags, weighted{tag: tag, q: q})
+	}
+
+	sort.SliceStable(tags, func(i, j int) bool {
+		return tags[i].q > tags[j].q
+	})
+
+	locales := make([]string, 0, len(tags))
+	for _, t := range tags {
+		locales = append(locales, t.tag)
+	}
+	return locales
+}
@@ -0,0 +1,136 @@
+package handlers
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+
+	"cortex/category"
+	customerrors "cortex/pkg/custom_errors"
+	"cortex/rest/middlewares"
+	"cortex/rest/utils"
+	"cortex/subcategory"
+
+	"github.com/google/uuid"
+)
+
+type ReorderCategoriesReq struct {
+	ParentUUID string   `json:"parent_uuid,omitempty" validate:"omitempty,uuid"`
+	UUIDs      []string `json:"uuids" validate:"required,min=1,dive,uuid"`
+}
+
+// ReorderCategories sets the manual order of top-level categories, or of the
+// subcategories of parent_uuid when it is given
+func (h *Handlers) ReorderCategories(w http.ResponseWriter, r *http.Request) {
+	userID := middlewares.GetUserId(r)
+	if userID == 0 {
+		utils.SendError(w, http.StatusUnauthorized, "User not authenticated", nil)
+		return
+	}
+
+	req, uuids, ok := decodeReorderRequest(w, r)
+	if !ok {
+		return
+	}
+
+	var err error
+	if req.ParentUUID != "" {
+		parentUUID, parseErr := uuid.Parse(req.ParentUUID)
+		if parseErr != nil {
+			utils.SendError(w, http.StatusBadRequest, "invalid parent_uuid", nil)
+			return
+		}
+		err = h.SubcategoryService.ReorderSubcategories(r.Context(), subcategory.ReorderSubcategoriesParams{
+			ParentUUID: parentUUID,
+			UUIDs:      uuids,
+			UpdatedBy:  userID,
+		})
+	} else {
+		err = h.CategoryService.ReorderCategories(r.Context(), category.ReorderCategoriesParams{
+			UUIDs:     uuids,
+			UpdatedBy: userID,
+		})
+	}
+	if err != nil {
+		sendReorderError(w, err)
+		return
+	}
+
+	utils.SendJson(w, http.StatusOK, SuccessResponse{
+		Message: "Categories reordered successfully",
+		Status:  true,
+		Data:    req.UUIDs,
+	})
+}
+
+// ReorderSubcategories sets the manual order of the subcategories of a parent category
+func (h *Handlers) ReorderSubcategories(w http.ResponseWriter, r *http.Request) {
+	userID := middlewares.GetUserId(r)
+	if userID == 0 {
+		utils.SendError(w, http.StatusUnauthorized, "User not authenticated", nil)
+		return
+	}
+
+	req, uuids, ok := decodeReorderRequest(w, r)
+	if !ok {
+		return
+	}
+
+	parentUUID, err := uuid.Parse(req.ParentUUID)
+	if err != nil {
+		utils.SendError(w, http.StatusBadRequest, "parent_uuid is required", nil)
+		return
+	}
+
+	err = h.SubcategoryService.ReorderSubcategories(r.Context(), subcategory.ReorderSubcategoriesParams{
+		ParentUUID: parentUUID,
+		UUIDs:      uuids,
+		UpdatedBy:  userID,
+	})
+	if err != nil {
+		sendReorderError(w, err)
+		return
+	}
+
+	utils.SendJson(w, http.StatusOK, SuccessResponse{
+		Message: "Subcategories reordered successfully",
+		Status:  true,
+		Data:    req.UUIDs,
+	})
+}
+
+func decodeReorderRequest(w http.ResponseWriter, r *http.Request) (*ReorderCategoriesReq, []uuid.UUID, bool) {
+	var req ReorderCategoriesReq
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		utils.SendError(w, http.StatusBadRequest, "Failed to decode request body", nil)
+		return nil, nil, false
+	}
+
+	if err := utils.Validate(req); err != nil {
+		utils.SendError(w, http.StatusBadRequest, "Invalid request", err.Error())
+		return nil, nil, false
+	}
+
+	uuids := make([]uuid.UUID, 0, len(req.UUIDs))
+	for _, u := range req.UUIDs {
+		parsed, err := uuid.Parse(u)
+		if err != nil {
+			utils.SendError(w, http.StatusBadRequest, "invalid UUID", u)
+			return nil, nil, false
+		}
+		uuids = append(uuids, parsed)
+	}
+
+	return &req, uuids, true
+}
+
+func sendReorderError(w http.ResponseWriter, err error) {
+	switch {
+	case errors.Is(err, customerrors.ErrCategoryNotFound):
+		utils.SendError(w, http.StatusNotFound, "Parent category not found", nil)
+	case errors.Is(err, customerrors.ErrInvalidCategoryOrder), errors.Is(err, customerrors.ErrParentNotTopLevel):
+		utils.SendError(w, http.StatusBadRequest, err.Error(), nil)
+	default:
+		utils.SendError(w, http.StatusInternalServerError, "Failed to reorder categories", nil)
+	}
+}
@@ -2,6 +2,7 @@ package handlers
 
 import (
 	"encoding/json"
+	"errors"
 	"net/http"
 	"time"
 
@@ -19,6 +20,9 @@ type UpdateCategoryReq struct {
 	ApprovedAt  *time.Time      `json:"approved_at,omitempty"`
 	Status      string          `json:"status,omitempty"`
 	Meta        json.RawMessage `json:"meta,omitempty"`
+
+	// UpdatedAt is the revision the edit is based on, the If-Match header may send it instead
+	UpdatedAt *time.Time `json:"updated_at,omitempty"`
 }
 
 func (h *Handlers) UpdateCategory(w http.ResponseWriter, r *http.Request) {
@@ -46,10 +50,16 @@ func (h *Handlers) UpdateCategory(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	expected, fromIfMatch, ok := utils.ExpectedRevision(w, r, req.UpdatedAt)
+	if !ok {
+		return
+	}
+
 	// Prepare update params
 	updateParams := category.UpdateCategoryParams{
-		Slug:      &slug,
-		UpdatedBy: userID,
+		Slug:              &slug,
+		UpdatedBy:         userID,
+		ExpectedUpdatedAt: expected,
 	}
 
 	// Only set fields that are provided
@@ -74,8 +84,14 @@ func (h *Handlers) UpdateCategory(w http.ResponseWriter, r *http.Request) {
 		updateParams.Meta = req.Meta
 	}
 
-	err := h.CategoryService.UpdateCategory(r.Context(), updateParams)
+	updated, err := h.CategoryService.UpdateCategory(r.Context(), updateParams)
 	if err != nil {
+		var modified *customerrors.ModifiedError
+		if errors.As(err, &modified) {
+			utils.SendModified(w, modified, fromIfMatch)
+			return
+		}
+
 		switch err {
 		case customerrors.ErrCategoryNotFound:
 			utils.SendError(w, http.StatusNotFound, "Category not found", nil)
@@ -87,8 +103,9 @@ func (h *Handlers) UpdateCategory(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	w.Header().Set("ETag", utils.UpdatedAtETag(updated.UpdatedAt))
 	utils.SendJson(w, http.StatusOK, SuccessResponse{
-		Data:    nil,
+		Data:    updated,
 		Message: "Category updated successfully",
 		Status:  true,
 	})
@@ -2,8 +2,12 @@ package handlers
 
 import (
 	"encoding/json"
+	"errors"
 	"net/http"
+	"time"
 
+	customerrors "cortex/pkg/custom_errors"
+	"cortex/rest/utils"
 	"cortex/tenant"
 
 	"github.com/google/uuid"
@@ -16,6 +20,9 @@ type UpdateTenantRequest struct {
 	Status   *string                `json:"status"`
 	Plan     *string                `json:"plan"`
 	Settings map[string]interface{} `json:"settings"`
+
+	// UpdatedAt is the revision the edit is based on, the If-Match header may send it instead
+	UpdatedAt *time.Time `json:"updated_at"`
 }
 
 // UpdateTenant godoc
@@ -25,10 +32,14 @@ type UpdateTenantRequest struct {
 // @Accept json
 // @Produce json
 // @Param id path string true "Tenant UUID"
+// @Param If-Match header string false "ETag of the tenant revision the edit is based on"
 // @Param tenant body UpdateTenantRequest true "Tenant data"
 // @Success 200 {object} SuccessResponse
 // @Failure 400 {object} map[string]string
 // @Failure 404 {object} map[string]string
+// @Failure 409 {object} map[string]interface{}
+// @Failure 412 {object} map[string]interface{}
+// @Failure 428 {object} map[string]interface{}
 // @Failure 500 {object} map[string]string
 // @Router /tenants/{id} [put]
 // @Security BearerAuth
@@ -54,18 +65,30 @@ func (h *Handlers) UpdateTenant(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	expected, fromIfMatch, ok := utils.ExpectedRevision(w, r, req.UpdatedAt)
+	if !ok {
+		return
+	}
+
 	params := tenant.UpdateTenantParams{
-		UUID:     tenantUUID,
-		Name:     req.Name,
-		Slug:     req.Slug,
-		Domain:   req.Domain,
-		Status:   req.Status,
-		Plan:     req.Plan,
-		Settings: req.Settings,
+		UUID:              tenantUUID,
+		Name:              req.Name,
+		Slug:              req.Slug,
+		Domain:            req.Domain,
+		Status:            req.Status,
+		Plan:              req.Plan,
+		Settings:          req.Settings,
+		ExpectedUpdatedAt: expected,
 	}
 
 	updatedTenant, err := h.TenantService.UpdateTenant(r.Context(), params)
 	if err != nil {
+		var modified *customerrors.ModifiedError
+		if errors.As(err, &modified) {
+			utils.SendModified(w, modified, fromIfMatch)
+			return
+		}
+
 		w.WriteHeader(http.StatusInternalServerError)
 		json.NewEncoder(w).Encode(map[string]string{
 			"error":   "Failed to update tenant",
@@ -75,6 +98,7 @@ func (h *Handlers) UpdateTenant(w http.ResponseWriter, r *http.Request) {
 	}
 
 	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("ETag", utils.UpdatedAtETag(updatedTenant.UpdatedAt))
 	w.WriteHeader(http.StatusOK)
 	json.NewEncoder(w).Encode(SuccessResponse{
 		Message: "Tenant updated successfully",
@@ -30,17 +30,43 @@ func NewServeMux(mw *middlewares.Middlewares, handlers *handlers.Handlers) (http
 	mux.HandleFunc("POST /api/v1/categories", func(w http.ResponseWriter, r *http.Request) {
 		mw.AuthenticateJWT(http.HandlerFunc(handlers.CreateCategory)).ServeHTTP(w, r)
 	})
+	mux.HandleFunc("POST /api/v1/categories/reorder", func(w http.ResponseWriter, r *http.Request) {
+		mw.AuthenticateJWT(http.HandlerFunc(handlers.ReorderCategories)).ServeHTTP(w, r)
+	})
+	mux.HandleFunc("POST /api/v1/categories/import", func(w http.ResponseWriter, r *http.Request) {
+		mw.AuthenticateJWT(http.HandlerFunc(handlers.ImportCategories)).ServeHTTP(w, r)
+	})
 	mux.HandleFunc("GET /api/v1/categories", handlers.GetCategoryList)
 	mux.HandleFunc("GET /api/v1/categories/{category_uuid}", handlers.GetCategoryByUUID)
+	mux.HandleFunc("GET /api/v1/categories/slug/{slug}", handlers.GetCategoryBySlug)
 	mux.HandleFunc("PUT /api/v1/categories/{slug}", func(w http.ResponseWriter, r *http.Request) {
 		mw.AuthenticateJWT(http.HandlerFunc(handlers.UpdateCategory)).ServeHTTP(w, r)
 	})
 	mux.HandleFunc("DELETE /api/v1/categories/{category_id}", handlers.DeleteCategoryByID)
 
+	// Category sub-resources
+	// "/categories/{category_uuid}/top-posts" would conflict with "/categories/slug/{slug}",
+	// so these routes live on their own mux below /api/v1/categories, where no slug route exists
+	categoryResources := http.NewServeMux()
+	categoryResources.HandleFunc("GET /{category_uuid}/top-posts", handlers.GetCategoryTopPosts)
+	mux.Handle("GET /api/v1/categories/{category_uuid}/{resource}", http.StripPrefix("/api/v1/categories", categoryResources))
+
+	// Category translations, subcategories live in the categories table and share them
+	mux.HandleFunc("GET /api/v1/category-translations/{category_uuid}", handlers.GetCategoryTranslations)
+	mux.HandleFunc("PUT /api/v1/category-translations/{category_uuid}/{locale}", func(w http.ResponseWriter, r *http.Request) {
+		mw.AuthenticateJWT(http.HandlerFunc(handlers.UpsertCategoryTranslation)).ServeHTTP(w, r)
+	})
+	mux.HandleFunc("DELETE /api/v1/category-translations/{category_uuid}/{locale}", func(w http.ResponseWriter, r *http.Request) {
+		mw.AuthenticateJWT(http.HandlerFunc(handlers.DeleteCategoryTranslation)).ServeHTTP(w, r)
+	})
+
 	// Subcategory routes
 	mux.HandleFunc("POST /api/v1/sub-categories", func(w http.ResponseWriter, r *http.Request) {
 		mw.AuthenticateJWT(http.HandlerFunc(handlers.CreateSubCategory)).ServeHTTP(w, r)
 	})
+	mux.HandleFunc("POST /api/v1/sub-categories/reorder", func(w http.ResponseWriter, r *http.Request) {
+		mw.AuthenticateJWT(http.HandlerFunc(handlers.ReorderSubcategories)).ServeHTTP(w, r)
+	})
 	mux.HandleFunc("GET /api/v1/sub-categories", handlers.GetSubCategoryList)
 	mux.HandleFunc("GET /api/v1/sub-categories/{id}", handlers.GetSubCategoryByID)
 	mux.HandleFunc("PUT /api/v1/sub-categories/{id}", func(w http.ResponseWriter, r *http.Request) {
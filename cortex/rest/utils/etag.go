@@ -0,0 +1,79 @@
+package utils
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	customerrors "cortex/pkg/custom_errors"
+)
+
+// UpdatedAtETag identifies a stored revision by its updated_at, at the
+// microsecond precision Postgres keeps
+func UpdatedAtETag(updatedAt time.Time) string {
+	return fmt.Sprintf(`"%d"`, updatedAt.UnixMicro())
+}
+
+// IfMatchUpdatedAt reads the revision an edit is based on from If-Match.
+// present reports whether the header was sent, "*" matches any revision and
+// leaves updatedAt nil. If-Match compares strong ETags only, a weak one is
+// rejected rather than matched.
+func IfMatchUpdatedAt(r *http.Request) (updatedAt *time.Time, present bool, err error) {
+	match := strings.TrimSpace(r.Header.Get("If-Match"))
+	if match == "" {
+		return nil, false, nil
+	}
+	if match == "*" {
+		return nil, true, nil
+	}
+	if strings.HasPrefix(match, "W/") {
+		return nil, true, errors.New("If-Match must be a strong ETag")
+	}
+
+	micros, err := strconv.ParseInt(strings.Trim(match, `"`), 10, 64)
+	if err != nil {
+		return nil, true, errors.New("If-Match must be an ETag returned by this API")
+	}
+	t := time.UnixMicro(micros)
+	return &t, true, nil
+}
+
+// SendModified answers an edit based on a stale revision with the current one,
+// 412 when the stale revision came from If-Match and 409 when it came from the body
+func SendModified(w http.ResponseWriter, modified *customerrors.ModifiedError, fromIfMatch bool) {
+	status := http.StatusConflict
+	if fromIfMatch {
+		status = http.StatusPreconditionFailed
+	}
+
+	w.Header().Set("ETag", UpdatedAtETag(modified.Current))
+	SendJson(w, status, map[string]any{
+		"status":  false,
+		"message": modified.Error(),
+		"data": map[string]any{
+			"updated_at": modified.Current,
+		},
+	})
+}
+
+// ExpectedRevision picks the revision an edit is based on, If-Match wins over the
+// updated_at sent in the body. It writes the error response and returns ok=false
+// when the header is malformed or neither was sent.
+func ExpectedRevision(w http.ResponseWriter, r *http.Request, bodyUpdatedAt *time.Time) (expected *time.Time, fromIfMatch bool, ok bool) {
+	expected, fromIfMatch, err := IfMatchUpdatedAt(r)
+	if err != nil {
+		SendError(w, http.StatusBadRequest, err.Error(), nil)
+		return nil, false, false
+	}
+	if fromIfMatch {
+		return expected, true, true
+	}
+	if bodyUpdatedAt == nil {
+		SendError(w, http.StatusPreconditionRequired, "If-Match header or updated_at is required", nil)
+		return nil, false, false
+	}
+	return bodyUpdatedAt, false, true
+}
@@ -0,0 +1,72 @@
+package utils
+
+import (
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestIfMatchUpdatedAt(t *testing.T) {
+	updatedAt := time.Date(2026, 3, 14, 15, 9, 26, 535897000, time.UTC)
+
+	tests := []struct {
+		name              string
+		ifMatch           string
+		expectedUpdatedAt *time.Time
+		expectedPresent   bool
+		expectError       bool
+	}{
+		{
+			name:            "No Header",
+			expectedPresent: false,
+		},
+		{
+			name:            "Any Revision",
+			ifMatch:         "*",
+			expectedPresent: true,
+		},
+		{
+			name:              "Round Trips UpdatedAtETag",
+			ifMatch:           UpdatedAtETag(updatedAt),
+			expectedUpdatedAt: &updatedAt,
+			expectedPresent:   true,
+		},
+		{
+			name:            "Weak ETag Rejected",
+			ifMatch:         "W/" + UpdatedAtETag(updatedAt),
+			expectedPresent: true,
+			expectError:     true,
+		},
+		{
+			name:            "Not An ETag Of This API",
+			ifMatch:         `"v2"`,
+			expectedPresent: true,
+			expectError:     true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := httptest.NewRequest("PUT", "/api/v1/categories/1", nil)
+			if tt.ifMatch != "" {
+				r.Header.Set("If-Match", tt.ifMatch)
+			}
+
+			got, present, err := IfMatchUpdatedAt(r)
+
+			require.Equal(t, tt.expectedPresent, present)
+			if tt.expectError {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			if tt.expectedUpdatedAt == nil {
+				require.Nil(t, got)
+				return
+			}
+			require.True(t, tt.expectedUpdatedAt.Equal(*got))
+		})
+	}
+}
@@ -14,20 +14,19 @@ func (s *service) invalidateSubcategoryListCache(ctx context.Context) {
 		return
 	}
 
-	// Delete common cache key patterns for subcategory lists
-	// These patterns match the keys generated in buildSubcategoryListCacheKey
-	patterns := []string{
-		"subcategory:list",
-		"subcategory:list:*",
+	// Delete the unfiltered list key and every filtered/sorted variant
+	// These keys match the ones generated in buildSubcategoryListCacheKey
+	if err := s.cache.Del(ctx, "subcategory:list"); err != nil {
+		slog.WarnContext(ctx, "Failed to invalidate subcategory list cache", logger.Extra(map[string]any{
+			"key":   "subcategory:list",
+			"error": err.Error(),
+		}))
 	}
-
-	for _, pattern := range patterns {
-		if err := s.cache.Del(ctx, pattern); err != nil {
-			slog.WarnContext(ctx, "Failed to invalidate subcategory list cache", logger.Extra(map[string]any{
-				"pattern": pattern,
-				"error":   err.Error(),
-			}))
-		}
+	if err := s.cache.DelPattern(ctx, "subcategory:list:*"); err != nil {
+		slog.WarnContext(ctx, "Failed to invalidate subcategory list cache", logger.Extra(map[string]any{
+			"pattern": "subcategory:list:*",
+			"error":   err.Error(),
+		}))
 	}
 
 	slog.InfoContext(ctx, "Subcategory list cache invalidated")
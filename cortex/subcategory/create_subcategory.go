@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 
+	categorysvc "cortex/category"
 	"cortex/ent/category"
 	customerrors "cortex/pkg/custom_errors"
 )
@@ -31,8 +32,20 @@ func (s *service) CreateSubcategory(ctx context.Context, params CreateSubcategor
 		return fmt.Errorf("parent category with ID %d not found", params.ParentID)
 	}
 
+	tx, err := s.ent.Tx(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to start transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	// New subcategories are appended after their existing siblings
+	position, err := categorysvc.NextPosition(ctx, tx.Client(), params.ParentID)
+	if err != nil {
+		return fmt.Errorf("failed to create subcategory: %w", err)
+	}
+
 	// Create the subcategory
-	_, err = s.ent.Category.Create().
+	_, err = tx.Category.Create().
 		SetSlug(params.Slug).
 		SetLabel(params.Label).
 		SetDescription(params.Description).
@@ -40,11 +53,16 @@ func (s *service) CreateSubcategory(ctx context.Context, params CreateSubcategor
 		SetCreatorID(params.CreatorID).
 		SetParentID(params.ParentID).
 		SetMeta(params.Meta).
+		SetPosition(position).
 		Save(ctx)
 	if err != nil {
 		return fmt.Errorf("failed to create subcategory: %w", err)
 	}
 
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to create subcategory: %w", err)
+	}
+
 	// Invalidate subcategory list cache to show new subcategory immediately
 	if s.cache != nil {
 		s.invalidateSubcategoryListCache(ctx)
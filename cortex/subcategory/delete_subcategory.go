@@ -25,11 +25,7 @@ func (s *service) DeleteSubcategory(ctx context.Context, uuid uuid.UUID, deleted
 	}
 
 	// Soft delete the subcategory
-	_, err = s.ent.Category.UpdateOneID(existingSubcategory.ID).
-		SetDeletedBy(deletedBy).
-		SetStatus(category.StatusDeleted).
-		Save(ctx)
-	if err != nil {
+	if err := s.softDelete(ctx, existingSubcategory.ID, deletedBy); err != nil {
 		return errors.New("failed to delete subcategory")
 	}
 
@@ -5,6 +5,7 @@ import (
 	"fmt"
 
 	"cortex/ent/category"
+	"cortex/ent/categorytranslation"
 	customerrors "cortex/pkg/custom_errors"
 )
 
@@ -19,11 +20,7 @@ func (s *service) DeleteSubcategoryByID(ctx context.Context, id int, deletedBy i
 	}
 
 	// Soft delete by updating status and deleted_by
-	err = s.ent.Category.UpdateOne(subcategory).
-		SetStatus(category.StatusDeleted).
-		SetDeletedBy(deletedBy).
-		Exec(ctx)
-	if err != nil {
+	if err := s.softDelete(ctx, subcategory.ID, deletedBy); err != nil {
 		return fmt.Errorf("failed to delete subcategory: %w", err)
 	}
 
@@ -34,3 +31,28 @@ func (s *service) DeleteSubcategoryByID(ctx context.Context, id int, deletedBy i
 
 	return nil
 }
+
+// softDelete marks a subcategory deleted and drops its translations, which are
+// only ever shown for live subcategories
+func (s *service) softDelete(ctx context.Context, id int, deletedBy int) error {
+	tx, err := s.ent.Tx(ctx)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	if err := tx.Category.UpdateOneID(id).
+		SetStatus(category.StatusDeleted).
+		SetDeletedBy(deletedBy).
+		Exec(ctx); err != nil {
+		return err
+	}
+
+	if _, err := tx.CategoryTranslation.Delete().
+		Where(categorytranslation.CategoryIDEQ(id)).
+		Exec(ctx); err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
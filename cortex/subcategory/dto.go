@@ -24,6 +24,8 @@ type Subcategory struct {
 	DeletedAt   *time.Time     `json:"deleted_at,omitempty" db:"deleted_at"`
 	Status      string         `json:"status,omitempty" db:"status"`
 	Meta        map[string]any `json:"meta,omitempty" db:"meta"`
+	Position    int            `json:"position" db:"position"`
+	Locale      string         `json:"locale,omitempty" db:"-"`
 }
 
 type CreateSubcategoryParams struct {
@@ -45,6 +47,12 @@ type UpdateSubcategoryParams struct {
 	Meta        json.RawMessage
 }
 
+type ReorderSubcategoriesParams struct {
+	ParentUUID uuid.UUID
+	UUIDs      []uuid.UUID
+	UpdatedBy  int
+}
+
 type GetSubcategoryFilter struct {
 	ID     *int
 	UUID   *uuid.UUID
@@ -52,6 +60,9 @@ type GetSubcategoryFilter struct {
 	Label  *string
 	Status *string
 
+	// Locales in order of preference, the first available translation is used
+	Locales []string
+
 	Limit  *int
 	Offset *int
 
@@ -5,8 +5,11 @@ import (
 	"encoding/json"
 	"errors"
 	"log/slog"
+	"strconv"
+	"strings"
 	"time"
 
+	categorysvc "cortex/category"
 	"cortex/ent"
 	"cortex/ent/category"
 	"cortex/logger"
@@ -15,6 +18,12 @@ import (
 )
 
 func (s *service) GetAllSubcategories(ctx context.Context, filter GetSubcategoryFilter) ([]*Subcategory, error) {
+	locales, err := categorysvc.ResolveLocales(ctx, s.ent, s.cache, filter.Locales)
+	if err != nil {
+		return nil, errors.New("failed to retrieve subcategory translations")
+	}
+	filter.Locales = locales
+
 	// Try cache first
 	if s.cache != nil {
 		cacheKey := buildSubcategoryListCacheKey(filter)
@@ -59,26 +68,32 @@ func (s *service) GetAllSubcategories(ctx context.Context, filter GetSubcategory
 	if filter.Offset != nil {
 		query = query.Offset(*filter.Offset)
 	}
-	if filter.SortBy != nil && filter.SortOrder != nil {
-		switch *filter.SortBy {
-		case "created_at":
-			if *filter.SortOrder == "desc" {
-				query = query.Order(ent.Desc(category.FieldCreatedAt))
-			} else {
-				query = query.Order(ent.Asc(category.FieldCreatedAt))
-			}
-		case "label":
-			if *filter.SortOrder == "desc" {
-				query = query.Order(ent.Desc(category.FieldLabel))
-			} else {
-				query = query.Order(ent.Asc(category.FieldLabel))
-			}
-		case "slug":
-			if *filter.SortOrder == "desc" {
-				query = query.Order(ent.Desc(category.FieldSlug))
-			} else {
-				query = query.Order(ent.Asc(category.FieldSlug))
-			}
+	sortBy, sortOrder := resolveSubcategorySort(filter)
+	switch sortBy {
+	case "created_at":
+		if sortOrder == "desc" {
+			query = query.Order(ent.Desc(category.FieldCreatedAt))
+		} else {
+			query = query.Order(ent.Asc(category.FieldCreatedAt))
+		}
+	case "label":
+		if sortOrder == "desc" {
+			query = query.Order(ent.Desc(category.FieldLabel))
+		} else {
+			query = query.Order(ent.Asc(category.FieldLabel))
+		}
+	case "slug":
+		if sortOrder == "desc" {
+			query = query.Order(ent.Desc(category.FieldSlug))
+		} else {
+			query = query.Order(ent.Asc(category.FieldSlug))
+		}
+	default:
+		// Manual order curated by editors; parent first keeps siblings together
+		if sortOrder == "desc" {
+			query = query.Order(ent.Asc(category.FieldParentID), ent.Desc(category.FieldPosition), ent.Desc(category.FieldCreatedAt))
+		} else {
+			query = query.Order(ent.Asc(category.FieldParentID), ent.Asc(category.FieldPosition), ent.Asc(category.FieldCreatedAt))
 		}
 	}
 
@@ -132,9 +147,14 @@ func (s *service) GetAllSubcategories(ctx context.Context, filter GetSubcategory
 			DeletedAt:   deletedAt,
 			Status:      string(sc.Status),
 			Meta:        sc.Meta,
+			Position:    sc.Position,
 		})
 	}
 
+	if err := s.LocalizeSubcategories(ctx, filter.Locales, result...); err != nil {
+		return nil, err
+	}
+
 	// Cache the result (5 minutes TTL for lists)
 	if s.cache != nil {
 		cacheKey := buildSubcategoryListCacheKey(filter)
@@ -148,10 +168,24 @@ func (s *service) GetAllSubcategories(ctx context.Context, filter GetSubcategory
 	return result, nil
 }
 
+// resolveSubcategorySort returns the sort field and order of a list query,
+// defaulting to the manual position order
+func resolveSubcategorySort(filter GetSubcategoryFilter) (string, string) {
+	sortBy := "position"
+	if filter.SortBy != nil && *filter.SortBy != "" {
+		sortBy = *filter.SortBy
+	}
+	sortOrder := "asc"
+	if filter.SortOrder != nil && *filter.SortOrder != "" {
+		sortOrder = *filter.SortOrder
+	}
+	return sortBy, sortOrder
+}
+
 func buildSubcategoryListCacheKey(filter GetSubcategoryFilter) string {
 	key := "subcategory:list"
 	if filter.ID != nil {
-		key += ":id:" + string(rune(*filter.ID))
+		key += ":id:" + strconv.Itoa(*filter.ID)
 	}
 	if filter.UUID != nil {
 		key += ":uuid:" + filter.UUID.String()
@@ -159,14 +193,22 @@ func buildSubcategoryListCacheKey(filter GetSubcategoryFilter) string {
 	if filter.Slug != nil {
 		key += ":slug:" + *filter.Slug
 	}
+	if filter.Label != nil {
+		key += ":label:" + *filter.Label
+	}
 	if filter.Status != nil {
 		key += ":status:" + *filter.Status
 	}
 	if filter.Limit != nil {
-		key += ":limit:" + string(rune(*filter.Limit))
+		key += ":limit:" + strconv.Itoa(*filter.Limit)
 	}
 	if filter.Offset != nil {
-		key += ":offset:" + string(rune(*filter.Offset))
+		key += ":offset:" + strconv.Itoa(*filter.Offset)
+	}
+	if len(filter.Locales) > 0 {
+		key += ":lang:" + strings.Join(filter.Locales, ",")
 	}
+	sortBy, sortOrder := resolveSubcategorySort(filter)
+	key += ":sort:" + sortBy + ":" + sortOrder
 	return key
 }
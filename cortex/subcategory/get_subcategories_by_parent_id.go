@@ -46,26 +46,32 @@ func (s *service) GetSubcategoriesByParentID(ctx context.Context, parentUUID uui
 	if filter.Offset != nil {
 		query = query.Offset(*filter.Offset)
 	}
-	if filter.SortBy != nil && filter.SortOrder != nil {
-		switch *filter.SortBy {
-		case "created_at":
-			if *filter.SortOrder == "desc" {
-				query = query.Order(ent.Desc(category.FieldCreatedAt))
-			} else {
-				query = query.Order(ent.Asc(category.FieldCreatedAt))
-			}
-		case "label":
-			if *filter.SortOrder == "desc" {
-				query = query.Order(ent.Desc(category.FieldLabel))
-			} else {
-				query = query.Order(ent.Asc(category.FieldLabel))
-			}
-		case "slug":
-			if *filter.SortOrder == "desc" {
-				query = query.Order(ent.Desc(category.FieldSlug))
-			} else {
-				query = query.Order(ent.Asc(category.FieldSlug))
-			}
+	sortBy, sortOrder := resolveSubcategorySort(filter)
+	switch sortBy {
+	case "created_at":
+		if sortOrder == "desc" {
+			query = query.Order(ent.Desc(category.FieldCreatedAt))
+		} else {
+			query = query.Order(ent.Asc(category.FieldCreatedAt))
+		}
+	case "label":
+		if sortOrder == "desc" {
+			query = query.Order(ent.Desc(category.FieldLabel))
+		} else {
+			query = query.Order(ent.Asc(category.FieldLabel))
+		}
+	case "slug":
+		if sortOrder == "desc" {
+			query = query.Order(ent.Desc(category.FieldSlug))
+		} else {
+			query = query.Order(ent.Asc(category.FieldSlug))
+		}
+	default:
+		// Manual order curated by editors; parent first keeps siblings together
+		if sortOrder == "desc" {
+			query = query.Order(ent.Asc(category.FieldParentID), ent.Desc(category.FieldPosition), ent.Desc(category.FieldCreatedAt))
+		} else {
+			query = query.Order(ent.Asc(category.FieldParentID), ent.Asc(category.FieldPosition), ent.Asc(category.FieldCreatedAt))
 		}
 	}
 
@@ -119,8 +125,13 @@ func (s *service) GetSubcategoriesByParentID(ctx context.Context, parentUUID uui
 			DeletedAt:   deletedAt,
 			Status:      string(sc.Status),
 			Meta:        sc.Meta,
+			Position:    sc.Position,
 		})
 	}
 
+	if err := s.LocalizeSubcategories(ctx, filter.Locales, result...); err != nil {
+		return nil, err
+	}
+
 	return result, nil
 }
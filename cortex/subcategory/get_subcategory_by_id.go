@@ -70,6 +70,7 @@ func (s *service) GetSubcategoryByID(ctx context.Context, id int) (*Subcategory,
 		DeletedAt:   &sc.DeletedAt,
 		Status:      string(sc.Status),
 		Meta:        sc.Meta,
+		Position:    sc.Position,
 	}
 
 	// Cache the result (24 hours TTL)
@@ -140,6 +141,7 @@ func (s *service) GetSubcategoryByUUID(ctx context.Context, subcategoryUUID uuid
 		DeletedAt:   &sc.DeletedAt,
 		Status:      string(sc.Status),
 		Meta:        sc.Meta,
+		Position:    sc.Position,
 	}
 
 	// Cache the result (24 hours TTL)
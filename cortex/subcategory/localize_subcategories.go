@@ -0,0 +1,37 @@
+package subcategory
+
+import (
+	"context"
+	"fmt"
+
+	categorysvc "cortex/category"
+)
+
+// LocalizeSubcategories replaces label and description of each subcategory with its
+// translation in the most preferred available locale. Subcategories without a
+// matching translation keep the tenant default text.
+func (s *service) LocalizeSubcategories(ctx context.Context, locales []string, subcategories ...*Subcategory) error {
+	if len(locales) == 0 || len(subcategories) == 0 {
+		return nil
+	}
+
+	ids := make([]int, 0, len(subcategories))
+	for _, sc := range subcategories {
+		ids = append(ids, sc.ID)
+	}
+
+	translations, err := categorysvc.BestTranslations(ctx, s.ent, ids, locales)
+	if err != nil {
+		return fmt.Errorf("ent: subcategory translations retrieval failed: %w", err)
+	}
+
+	for _, sc := range subcategories {
+		if t, ok := translations[sc.ID]; ok {
+			sc.Label = t.Label
+			sc.Description = t.Description
+			sc.Locale = t.Locale
+		}
+	}
+
+	return nil
+}
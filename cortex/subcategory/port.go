@@ -16,6 +16,8 @@ type Service interface {
 	UpdateSubcategory(ctx context.Context, params UpdateSubcategoryParams) error
 	DeleteSubcategory(ctx context.Context, uuid uuid.UUID, deletedBy int) error
 	DeleteSubcategoryByID(ctx context.Context, id int, deletedBy int) error
+	ReorderSubcategories(ctx context.Context, params ReorderSubcategoriesParams) error
+	LocalizeSubcategories(ctx context.Context, locales []string, subcategories ...*Subcategory) error
 }
 
 type Cache interface {
@@ -30,4 +32,5 @@ type Cache interface {
 	CategoryObjectKey(uuid uuid.UUID) string
 	CategoryTopPostsKey(uuid uuid.UUID) string
 	Del(ctx context.Context, key string) error
+	DelPattern(ctx context.Context, pattern string) error
 }
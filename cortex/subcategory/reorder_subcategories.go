@@ -0,0 +1,32 @@
+package subcategory
+
+import (
+	"context"
+
+	categorysvc "cortex/category"
+	"cortex/ent/category"
+	customerrors "cortex/pkg/custom_errors"
+)
+
+func (s *service) ReorderSubcategories(ctx context.Context, params ReorderSubcategoriesParams) error {
+	parent, err := s.ent.Category.Query().
+		Where(category.UUIDEQ(params.ParentUUID.String())).
+		First(ctx)
+	if err != nil {
+		return customerrors.ErrCategoryNotFound
+	}
+	if parent.ParentID != 0 {
+		return customerrors.ErrParentNotTopLevel
+	}
+
+	if err := categorysvc.Reorder(ctx, s.ent, parent.ID, params.UUIDs, params.UpdatedBy); err != nil {
+		return err
+	}
+
+	// Invalidate subcategory list cache so the new order shows immediately
+	if s.cache != nil {
+		s.invalidateSubcategoryListCache(ctx)
+	}
+
+	return nil
+}
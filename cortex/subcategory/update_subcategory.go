@@ -5,7 +5,9 @@ import (
 	"encoding/json"
 	"errors"
 
+	categorysvc "cortex/category"
 	"cortex/ent/category"
+	"cortex/ent/categoryslughistory"
 	customerrors "cortex/pkg/custom_errors"
 )
 
@@ -24,6 +26,7 @@ func (s *service) UpdateSubcategory(ctx context.Context, params UpdateSubcategor
 	}
 
 	// If updating slug, check for uniqueness
+	slugChanged := false
 	if params.Slug != nil && *params.Slug != existingSubcategory.Slug {
 		exists, err := s.ent.Category.Query().
 			Where(category.SlugEQ(*params.Slug)).
@@ -34,10 +37,31 @@ func (s *service) UpdateSubcategory(ctx context.Context, params UpdateSubcategor
 		if exists {
 			return customerrors.ErrSlugExists
 		}
+
+		// Previous slugs of other categories stay reserved for redirects
+		taken, err := s.ent.CategorySlugHistory.Query().
+			Where(
+				categoryslughistory.SlugEQ(*params.Slug),
+				categoryslughistory.CategoryIDNEQ(existingSubcategory.ID),
+			).
+			Exist(ctx)
+		if err != nil {
+			return errors.New("failed to check slug uniqueness")
+		}
+		if taken {
+			return customerrors.ErrSlugExists
+		}
+		slugChanged = true
+	}
+
+	tx, err := s.ent.Tx(ctx)
+	if err != nil {
+		return errors.New("failed to start transaction")
 	}
+	defer tx.Rollback()
 
 	// Build update query
-	updateQuery := s.ent.Category.UpdateOneID(existingSubcategory.ID).
+	updateQuery := tx.Category.UpdateOneID(existingSubcategory.ID).
 		SetUpdatedBy(params.UpdatedBy)
 
 	if params.Slug != nil {
@@ -65,6 +89,17 @@ func (s *service) UpdateSubcategory(ctx context.Context, params UpdateSubcategor
 		return errors.New("failed to update subcategory")
 	}
 
+	// Keep the old slug so existing links can be redirected
+	if slugChanged {
+		if err := categorysvc.RecordSlugChange(ctx, tx.Client(), existingSubcategory.ID, existingSubcategory.Slug, *params.Slug, params.UpdatedBy); err != nil {
+			return errors.New("failed to update subcategory slug history")
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return errors.New("failed to update subcategory")
+	}
+
 	// Invalidate subcategory list cache to reflect updates immediately
 	if s.cache != nil {
 		s.invalidateSubcategoryListCache(ctx)
@@ -1,6 +1,8 @@
 package tenant
 
 import (
+	"time"
+
 	"github.com/google/uuid"
 )
 
@@ -19,6 +21,10 @@ type UpdateTenantParams struct {
 	Status   *string
 	Plan     *string
 	Settings map[string]interface{}
+
+	// ExpectedUpdatedAt guards the update, it fails with a ModifiedError
+	// when the tenant changed since the client read it
+	ExpectedUpdatedAt *time.Time
 }
 
 type GetTenantFilter struct {
@@ -6,6 +6,7 @@ import (
 
 	"cortex/ent"
 	"cortex/ent/tenant"
+	customerrors "cortex/pkg/custom_errors"
 
 	"github.com/google/uuid"
 )
@@ -121,13 +122,21 @@ func (r *repository) Update(ctx context.Context, params UpdateTenantParams) (*en
 	if params.Settings != nil {
 		builder.SetSettings(params.Settings)
 	}
+	if params.ExpectedUpdatedAt != nil {
+		builder.Where(tenant.UpdatedAtEQ(*params.ExpectedUpdatedAt))
+	}
 
 	count, err := builder.Save(ctx)
 	if err != nil {
 		return nil, err
 	}
 	if count == 0 {
-		return nil, fmt.Errorf("tenant not found")
+		// Nothing matched, either the tenant is gone or it changed under the guard
+		current, err := r.FindByUUID(ctx, params.UUID)
+		if err != nil || params.ExpectedUpdatedAt == nil {
+			return nil, fmt.Errorf("tenant not found")
+		}
+		return nil, &customerrors.ModifiedError{Resource: "tenant", Current: current.UpdatedAt}
 	}
 
 	return r.FindByUUID(ctx, params.UUID)
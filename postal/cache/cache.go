@@ -11,10 +11,17 @@ import (
 // Services implement their own caching logic using these primitives
 type Cache interface {
 	Set(ctx context.Context, key string, value any, expiration time.Duration) error
+	SetNX(ctx context.Context, key string, value any, expiration time.Duration) (bool, error)
 	Get(ctx context.Context, key string) (string, error)
 	Del(ctx context.Context, keys ...string) error
+	DelIfValue(ctx context.Context, key, value string) (bool, error)
 	DelPattern(ctx context.Context, pattern string) error
 	Exists(ctx context.Context, keys ...string) (int64, error)
+	HGetAll(ctx context.Context, keys ...string) ([]map[string]string, error)
+	HSetIfNotExists(ctx context.Context, key string, fields map[string]any, expiration time.Duration) (bool, error)
+	HIncrByIfExists(ctx context.Context, key, field string, incr int64) error
+	HIncrBy(ctx context.Context, key, field string, incr int64) error
+	HDrain(ctx context.Context, key string) (map[string]string, error)
 }
 
 type cache struct {
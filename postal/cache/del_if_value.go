@@ -0,0 +1,30 @@
+package cache
+
+import (
+	"context"
+	"fmt"
+
+	goRedis "github.com/redis/go-redis/v9"
+)
+
+// delIfValueScript deletes a key only while it still holds the given value, a
+// lock that expired and was taken by another replica is left alone
+var delIfValueScript = goRedis.NewScript(`
+if redis.call("GET", KEYS[1]) == ARGV[1] then
+	return redis.call("DEL", KEYS[1])
+end
+return 0
+`)
+
+// DelIfValue deletes the key if it holds value and reports whether it did
+func (c *cache) DelIfValue(ctx context.Context, key, value string) (bool, error) {
+	if c.writeClient == nil {
+		return false, nil
+	}
+
+	deleted, err := delIfValueScript.Run(ctx, c.writeClient, []string{key}, value).Int64()
+	if err != nil && err != goRedis.Nil {
+		return false, fmt.Errorf("failed to delete key from redis: %w", err)
+	}
+	return deleted == 1, nil
+}
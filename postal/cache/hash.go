@@ -0,0 +1,129 @@
+package cache
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	goRedis "github.com/redis/go-redis/v9"
+)
+
+// hIncrByIfExistsScript only increments counters of a hash that is already
+// seeded, an expired hash is rebuilt from the database instead of restarting at 0
+var hIncrByIfExistsScript = goRedis.NewScript(`
+if redis.call("EXISTS", KEYS[1]) == 1 then
+	return redis.call("HINCRBY", KEYS[1], ARGV[1], ARGV[2])
+end
+return false
+`)
+
+// hSetIfNotExistsScript seeds a hash in one step, ARGV[1] is the expiration in
+// milliseconds (0 for none) and the rest are field value pairs
+var hSetIfNotExistsScript = goRedis.NewScript(`
+if redis.call("EXISTS", KEYS[1]) == 1 then
+	return 0
+end
+redis.call("HSET", KEYS[1], unpack(ARGV, 2))
+if tonumber(ARGV[1]) > 0 then
+	redis.call("PEXPIRE", KEYS[1], ARGV[1])
+end
+return 1
+`)
+
+// HGetAll returns the fields of every hash, a missing hash is returned as nil
+func (c *cache) HGetAll(ctx context.Context, keys ...string) ([]map[string]string, error) {
+	results := make([]map[string]string, len(keys))
+	if c.readClient == nil || len(keys) == 0 {
+		return results, nil
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, 1*time.Second)
+	defer cancel()
+
+	pipe := c.readClient.Pipeline()
+	cmds := make([]*goRedis.MapStringStringCmd, len(keys))
+	for i, key := range keys {
+		cmds[i] = pipe.HGetAll(ctx, key)
+	}
+	if _, err := pipe.Exec(ctx); err != nil && err != goRedis.Nil {
+		return nil, fmt.Errorf("failed to get hashes from redis: %w", err)
+	}
+
+	for i, cmd := range cmds {
+		if fields := cmd.Val(); len(fields) > 0 {
+			results[i] = fields
+		}
+	}
+	return results, nil
+}
+
+// HSetIfNotExists creates a hash with its expiration unless it already exists,
+// an existing hash keeps the increments it received since it was seeded
+func (c *cache) HSetIfNotExists(ctx context.Context, key string, fields map[string]any, expiration time.Duration) (bool, error) {
+	if c.writeClient == nil || len(fields) == 0 {
+		return false, nil
+	}
+
+	args := make([]any, 0, 1+2*len(fields))
+	args = append(args, expiration.Milliseconds())
+	for field, value := range fields {
+		args = append(args, field, value)
+	}
+
+	created, err := hSetIfNotExistsScript.Run(ctx, c.writeClient, []string{key}, args...).Int64()
+	if err != nil && err != goRedis.Nil {
+		return false, fmt.Errorf("failed to set hash in redis: %w", err)
+	}
+	return created == 1, nil
+}
+
+// HIncrByIfExists increments a field of a hash, but only if the hash exists
+func (c *cache) HIncrByIfExists(ctx context.Context, key, field string, incr int64) error {
+	if c.writeClient == nil {
+		return nil
+	}
+
+	err := hIncrByIfExistsScript.Run(ctx, c.writeClient, []string{key}, field, incr).Err()
+	if err != nil && err != goRedis.Nil {
+		return fmt.Errorf("failed to increment hash field in redis: %w", err)
+	}
+	return nil
+}
+
+// hDrainScript reads and deletes a hash in one step, increments that arrive
+// afterwards start a new hash instead of being lost
+var hDrainScript = goRedis.NewScript(`
+local fields = redis.call("HGETALL", KEYS[1])
+redis.call("DEL", KEYS[1])
+return fields
+`)
+
+// HIncrBy increments a field of a hash, creating the hash when it is missing
+func (c *cache) HIncrBy(ctx context.Context, key, field string, incr int64) error {
+	if c.writeClient == nil {
+		return fmt.Errorf("redis write client is not configured")
+	}
+
+	if err := c.writeClient.HIncrBy(ctx, key, field, incr).Err(); err != nil {
+		return fmt.Errorf("failed to increment hash field in redis: %w", err)
+	}
+	return nil
+}
+
+// HDrain returns the fields of a hash and deletes it atomically
+func (c *cache) HDrain(ctx context.Context, key string) (map[string]string, error) {
+	if c.writeClient == nil {
+		return nil, fmt.Errorf("redis write client is not configured")
+	}
+
+	values, err := hDrainScript.Run(ctx, c.writeClient, []string{key}).StringSlice()
+	if err != nil && err != goRedis.Nil {
+		return nil, fmt.Errorf("failed to drain hash from redis: %w", err)
+	}
+
+	fields := make(map[string]string, len(values)/2)
+	for i := 0; i+1 < len(values); i += 2 {
+		fields[values[i]] = values[i+1]
+	}
+	return fields, nil
+}
@@ -0,0 +1,26 @@
+package cache
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// TryLock takes a lock shared by the replicas. The key holds a random token and
+// the returned unlock only deletes it while it still holds that token, a run
+// that outlived the ttl can't release the lock another replica took since.
+func TryLock(ctx context.Context, c Cache, key string, ttl time.Duration) (unlock func(), acquired bool, err error) {
+	token := uuid.New().String()
+	acquired, err = c.SetNX(ctx, key, token, ttl)
+	if err != nil || !acquired {
+		return func() {}, acquired, err
+	}
+
+	return func() {
+		if _, err := c.DelIfValue(context.WithoutCancel(ctx), key, token); err != nil {
+			log.Printf("⚠️ Failed to release lock %s: %v", key, err)
+		}
+	}, true, nil
+}
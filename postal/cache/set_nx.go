@@ -0,0 +1,22 @@
+package cache
+
+import (
+	"context"
+	"fmt"
+	"time"
+)
+
+// SetNX sets the key only if it does not exist yet and reports whether it did.
+// It is used as a short lived lock between replicas.
+func (c *cache) SetNX(ctx context.Context, key string, value any, expiration time.Duration) (bool, error) {
+	if c.writeClient == nil {
+		return false, fmt.Errorf("redis write client is not configured")
+	}
+
+	ok, err := c.writeClient.SetNX(ctx, key, value, expiration).Result()
+	if err != nil {
+		return false, fmt.Errorf("failed to set key in redis: %w", err)
+	}
+
+	return ok, nil
+}
@@ -0,0 +1,109 @@
+package cmd
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"strings"
+
+	"postal/config"
+	"postal/domain"
+	"postal/post"
+	"postal/repo"
+	"postal/util"
+
+	"github.com/spf13/cobra"
+)
+
+var exportCmd = &cobra.Command{
+	Use:   "export",
+	Short: "Export posts as a zip archive",
+	Long: `Export posts as a zip of Markdown files with front matter, JSON lines or
+the CSV layout of the batch upload. Posts are read in batches and written as they
+are read, so exports of any size run in constant memory.`,
+	Args: cobra.NoArgs,
+	RunE: runExport,
+}
+
+func init() {
+	flags := exportCmd.Flags()
+	flags.StringP("output", "o", "", "file to write the zip to, - for stdout (default posts-<format>.zip)")
+	flags.String("format", string(post.ExportMarkdown), "markdown, jsonl or csv")
+	flags.Bool("versions", false, "include the version history of every post")
+	flags.String("status", "", "only posts with the status")
+	flags.Uint("category-id", 0, "only posts of the category")
+	flags.Uint("sub-category-id", 0, "only posts of the sub-category")
+	flags.StringSlice("tags", nil, "only posts with any of the tags")
+	flags.Uint("author-id", 0, "only posts the user is credited on")
+}
+
+func runExport(cmd *cobra.Command, args []string) error {
+	flags := cmd.Flags()
+	output, _ := flags.GetString("output")
+	format, _ := flags.GetString("format")
+	withVersions, _ := flags.GetBool("versions")
+
+	opts := post.ExportOptions{Format: post.ExportFormat(format), WithVersions: withVersions}
+	if !post.IsValidExportFormat(opts.Format) {
+		return post.ErrInvalidExportFormat
+	}
+
+	var filter post.PostFilter
+	if status, _ := flags.GetString("status"); status != "" {
+		s := domain.PostStatus(status)
+		filter.Status = &s
+	}
+	if id, _ := flags.GetUint("category-id"); id != 0 {
+		filter.CategoryID = &id
+	}
+	if id, _ := flags.GetUint("sub-category-id"); id != 0 {
+		filter.SubCategoryID = &id
+	}
+	if tags, _ := flags.GetStringSlice("tags"); len(tags) > 0 {
+		for _, name := range util.ParseTags(tags) {
+			filter.Tags = append(filter.Tags, util.TagSlug(name))
+		}
+		sort.Strings(filter.Tags)
+		filter.TagMatch = post.TagMatchAny
+	}
+	if id, _ := flags.GetUint("author-id"); id != 0 {
+		filter.AuthorID = &id
+	}
+
+	cfg := config.LoadConfig()
+	db, err := config.InitDatabase(cfg)
+	if err != nil {
+		return fmt.Errorf("failed to initialize database: %w", err)
+	}
+	defer config.CloseDatabase()
+
+	postService := post.NewService(repo.NewPostRepository(db), repo.NewPostVersionRepository(db), nil, nil, nil, nil)
+
+	if output == "" {
+		output = "posts-" + format + ".zip"
+	}
+	var w io.Writer = os.Stdout
+	if output != "-" {
+		file, err := os.Create(output)
+		if err != nil {
+			return fmt.Errorf("failed to create %s: %w", output, err)
+		}
+		defer file.Close()
+		w = file
+	}
+
+	buffered := bufio.NewWriter(w)
+	if err := postService.ExportPosts(cmd.Context(), buffered, filter, opts); err != nil {
+		return fmt.Errorf("export failed: %w", err)
+	}
+	if err := buffered.Flush(); err != nil {
+		return fmt.Errorf("failed to write %s: %w", output, err)
+	}
+
+	if output != "-" {
+		fmt.Fprintf(os.Stderr, "Exported posts to %s\n", strings.TrimPrefix(output, "./"))
+	}
+	return nil
+}
@@ -0,0 +1,127 @@
+package cmd
+
+import (
+	"fmt"
+	"log"
+
+	"postal/cache"
+	"postal/config"
+	"postal/domain"
+	"postal/media"
+	"postal/post"
+	"postal/repo"
+
+	"github.com/spf13/cobra"
+)
+
+var importCmd = &cobra.Command{
+	Use:   "import",
+	Short: "Import posts from files",
+}
+
+var importDocsCmd = &cobra.Command{
+	Use:   "docs <dir>",
+	Short: "Import a tree of Markdown notes as posts",
+	Long: `Import a tree of Markdown notes, like docs/interview-qna, as posts.
+
+The tree becomes a cortex category and its folders sub-categories, missing ones
+are created with CORTEX_TOKEN. A note is a Markdown file of a folder, or a folder
+with a README.md; notes are ordered by the numbers in their names. Running the
+import again updates the posts of changed notes and creates the new ones.`,
+	Args: cobra.ExactArgs(1),
+	RunE: runImportDocs,
+}
+
+func init() {
+	importDocsCmd.Flags().Uint("user-id", 0, "ID of the user the posts are created by (required)")
+	importDocsCmd.Flags().String("status", string(domain.StatusPublished), "status of new posts, draft or published")
+	importDocsCmd.Flags().String("category", "", "slug of the category, defaults to the folder name")
+	importDocsCmd.Flags().String("cortex-token", "", "JWT to create cortex categories with, defaults to CORTEX_TOKEN")
+	importDocsCmd.Flags().Bool("dry-run", false, "report the changes without making them")
+	importDocsCmd.MarkFlagRequired("user-id")
+
+	importCmd.AddCommand(importDocsCmd)
+}
+
+func runImportDocs(cmd *cobra.Command, args []string) error {
+	flags := cmd.Flags()
+	userID, _ := flags.GetUint("user-id")
+	status, _ := flags.GetString("status")
+	category, _ := flags.GetString("category")
+	token, _ := flags.GetString("cortex-token")
+	dryRun, _ := flags.GetBool("dry-run")
+
+	cfg := config.LoadConfig()
+	if token == "" {
+		token = cfg.CortexToken
+	}
+
+	db, err := config.InitDatabase(cfg)
+	if err != nil {
+		return fmt.Errorf("failed to initialize database: %w", err)
+	}
+	defer config.CloseDatabase()
+
+	if err := repo.AutoMigrate(db); err != nil {
+		return fmt.Errorf("failed to run migrations: %w", err)
+	}
+
+	// Posts the server has cached must be refreshed, so the import shares its cache
+	var cacheClient cache.Cache
+	redisClient, err := cache.NewRedisClient(cfg.WriteRedisURL, cfg.EnableRedisTLSMode, true)
+	if err != nil {
+		log.Printf("⚠️ Failed to initialize Redis client, cache disabled: %v", err)
+	} else if redisClient != nil {
+		cacheClient = cache.NewCache(redisClient, redisClient)
+		defer redisClient.Close()
+	}
+
+	// Imported posts link the uploads they reference like posts saved over HTTP
+	mediaStorage, err := media.NewLocalStorage(cfg.MediaDir)
+	if err != nil {
+		return fmt.Errorf("failed to initialize media storage: %w", err)
+	}
+	mediaService := media.NewService(repo.NewMediaRepository(db), mediaStorage, cacheClient, media.Config{
+		BaseURL: cfg.MediaBaseURL,
+	})
+
+	postService := post.NewService(
+		repo.NewPostRepository(db),
+		repo.NewPostVersionRepository(db),
+		cacheClient,
+		nil,
+		post.NewCortexCategories(cfg.CortexURL, token),
+		mediaService,
+	)
+
+	report, err := postService.ImportDocs(cmd.Context(), args[0], post.DocsImportOptions{
+		UserID:   userID,
+		Category: category,
+		Status:   domain.PostStatus(status),
+		DryRun:   dryRun,
+	})
+	if report != nil {
+		printDocsImportReport(report, dryRun)
+	}
+	if err != nil {
+		return fmt.Errorf("import failed: %w", err)
+	}
+	return nil
+}
+
+func printDocsImportReport(report *post.DocsImportReport, dryRun bool) {
+	if dryRun {
+		fmt.Println("Dry run, nothing was changed")
+	}
+	for _, slug := range report.Categories {
+		fmt.Printf("category   %s\n", slug)
+	}
+	for _, source := range report.Created {
+		fmt.Printf("created    %s\n", source)
+	}
+	for _, source := range report.Updated {
+		fmt.Printf("updated    %s\n", source)
+	}
+	fmt.Printf("%d categories, %d created, %d updated, %d unchanged\n",
+		len(report.Categories), len(report.Created), len(report.Updated), len(report.Unchanged))
+}
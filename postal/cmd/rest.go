@@ -1,19 +1,31 @@
 package cmd
 
 import (
+	"context"
+	"errors"
 	"fmt"
 	"log"
 	"net/http"
+	"os"
+	"os/signal"
+	"sync"
+	"syscall"
 	"time"
 
 	"postal/cache"
+	"postal/comment"
 	"postal/config"
+	"postal/events"
+	"postal/importjob"
+	"postal/media"
 	"postal/post"
+	"postal/preview"
 	"postal/repo"
 	"postal/rest"
 	"postal/rest/handlers"
 	"postal/rest/middlewares"
 	"postal/rest/utils"
+	"postal/sitemap"
 
 	"github.com/spf13/cobra"
 	"github.com/ulule/limiter/v3"
@@ -57,6 +69,16 @@ func runRESTServer(cmd *cobra.Command, args []string) error {
 	log.Println("🔄 Initializing repositories...")
 	postRepo := repo.NewPostRepository(db)
 	versionRepo := repo.NewPostVersionRepository(db)
+	commentRepo := repo.NewCommentRepository(db)
+	sitemapRepo := repo.NewSitemapRepository(db)
+	previewRepo := repo.NewPreviewRepository(db)
+	mediaRepo := repo.NewMediaRepository(db)
+	importJobRepo := repo.NewImportJobRepository(db)
+
+	mediaStorage, err := media.NewLocalStorage(cfg.MediaDir)
+	if err != nil {
+		return fmt.Errorf("failed to initialize media storage: %w", err)
+	}
 
 	// Initialize cache
 	log.Println("🔄 Initializing cache...")
@@ -87,13 +109,91 @@ func runRESTServer(cmd *cobra.Command, args []string) error {
 		log.Println("✅ Redis cache initialized")
 	}
 
+	// Initialize event publisher
+	log.Println("🔄 Connecting to RabbitMQ...")
+	var publisher events.Publisher
+	rmqPublisher, err := events.NewRabbitMQPublisher(cfg.RabbitMQURL, cfg.RMQReconnectDelay)
+	if err != nil {
+		log.Printf("⚠️ Failed to connect to RabbitMQ, events disabled: %v", err)
+	} else {
+		publisher = rmqPublisher
+		defer func() {
+			if closeErr := rmqPublisher.Close(); closeErr != nil {
+				log.Printf("⚠️ Failed to close RabbitMQ connection: %v", closeErr)
+			}
+		}()
+		log.Println("✅ RabbitMQ publisher initialized")
+	}
+
+	previewSecret := cfg.PreviewSecret
+	if previewSecret == "" {
+		if cfg.Mode == config.ReleaseMode {
+			return fmt.Errorf("PREVIEW_SECRET is required in release mode")
+		}
+		log.Println("⚠️ PREVIEW_SECRET is not set, deriving the preview key from JWT_SECRET")
+		if previewSecret, err = preview.DeriveSecret(cfg.JWTSecret); err != nil {
+			return fmt.Errorf("failed to derive preview secret: %w", err)
+		}
+	}
+
 	// Initialize services
 	log.Println("🔄 Initializing services...")
-	postService := post.NewService(postRepo, versionRepo, cacheClient)
+	mediaService := media.NewService(mediaRepo, mediaStorage, cacheClient, media.Config{
+		BaseURL:       cfg.MediaBaseURL,
+		MaxUploadSize: cfg.MaxMediaUploadSizeMB << 20,
+		Quota:         cfg.MediaTenantQuotaMB << 20,
+	})
+	postService := post.NewService(postRepo, versionRepo, cacheClient, publisher, post.NewCortexCategories(cfg.CortexURL, ""), mediaService)
+	commentService := comment.NewService(commentRepo, postRepo)
+	sitemapService := sitemap.NewService(sitemapRepo, sitemap.NewCortexCategories(cfg.CortexURL), cacheClient)
+	previewService := preview.NewService(previewRepo, postService, previewSecret, cfg.PreviewLinkTTL)
+	importJobService := importjob.NewService(importJobRepo, postService)
+
+	// Workers stop on SIGINT/SIGTERM or when the server fails, their current run
+	// finishes before the database and cache connections are closed
+	var workers sync.WaitGroup
+	defer workers.Wait()
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	// Start the scheduler that publishes and unpublishes scheduled posts
+	workers.Add(1)
+	go func() {
+		defer workers.Done()
+		runPostScheduler(ctx, postService, cfg.SchedulerInterval)
+	}()
+
+	// Start the worker that writes the views buffered in Redis to the database
+	workers.Add(1)
+	go func() {
+		defer workers.Done()
+		runViewFlusher(ctx, postService, cfg.ViewFlushInterval)
+	}()
+
+	// Start the worker that recomputes related posts after posts change
+	workers.Add(1)
+	go func() {
+		defer workers.Done()
+		runRelatedPostsWorker(ctx, postService, cfg.RelatedInterval)
+	}()
+
+	// Start the worker that deletes uploads no post uses
+	workers.Add(1)
+	go func() {
+		defer workers.Done()
+		runMediaGC(ctx, mediaService, cfg.MediaGCInterval)
+	}()
+
+	// Start the worker that runs queued CSV import jobs
+	workers.Add(1)
+	go func() {
+		defer workers.Done()
+		runImportJobWorker(ctx, importJobService, cfg.ImportJobInterval)
+	}()
 
 	// Initialize handlers
 	log.Println("🔄 Initializing handlers...")
-	h := handlers.NewHandlers(postService, versionRepo, validator)
+	h := handlers.NewHandlers(postService, commentService, sitemapService, previewService, mediaService, importJobService, versionRepo, validator, cfg.PublicBaseURLs)
 
 	// Initialize middlewares
 	log.Println("🔄 Initializing middlewares...")
@@ -102,7 +202,7 @@ func runRESTServer(cmd *cobra.Command, args []string) error {
 		Prefix:          "postal:limiter",
 		CleanUpInterval: time.Minute,
 	})
-	mw := middlewares.NewMiddlewares(cfg.JWTSecret, ipStore)
+	mw := middlewares.NewMiddlewares(cfg.JWTSecret, cfg.TrustedProxies, ipStore)
 
 	// Create server
 	log.Println("🔄 Creating HTTP server...")
@@ -120,10 +220,191 @@ func runRESTServer(cmd *cobra.Command, args []string) error {
 	log.Printf("📚 API Base: http://localhost%s/api/v1", addr)
 	log.Println("Press Ctrl+C to stop")
 
-	if err := http.ListenAndServe(addr, mux); err != nil {
+	server := &http.Server{Addr: addr, Handler: mux}
+	go func() {
+		<-ctx.Done()
+		log.Println("🛑 Shutting down...")
+		shutdownCtx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+		defer cancel()
+		if err := server.Shutdown(shutdownCtx); err != nil {
+			log.Printf("⚠️ Server shutdown failed: %v", err)
+		}
+	}()
+
+	if err := server.ListenAndServe(); err != nil && err != http.ErrServerClosed {
 		log.Printf("❌ Server error: %v", err)
 		return fmt.Errorf("server error: %w", err)
 	}
 
 	return nil
 }
+
+// runPostScheduler applies due scheduled transitions every interval until ctx is
+// done, a run in progress is finished first
+func runPostScheduler(ctx context.Context, postService post.Service, interval time.Duration) {
+	if interval <= 0 {
+		log.Println("⚠️ Post scheduler disabled")
+		return
+	}
+
+	log.Printf("⏰ Post scheduler running every %s", interval)
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			log.Println("⏰ Post scheduler stopped")
+			return
+		case <-ticker.C:
+		}
+
+		runCtx, cancel := context.WithTimeout(context.WithoutCancel(ctx), interval)
+		count, err := postService.RunScheduledTransitions(runCtx, time.Now())
+		cancel()
+		if err != nil {
+			log.Printf("❌ Post scheduler run failed: %v", err)
+			continue
+		}
+		if count > 0 {
+			log.Printf("⏰ Post scheduler applied %d transitions", count)
+		}
+	}
+}
+
+// runViewFlusher writes the buffered post views to the database every interval,
+// once ctx is done it flushes a last time so no counted view is left in Redis
+func runViewFlusher(ctx context.Context, postService post.Service, interval time.Duration) {
+	if interval <= 0 {
+		log.Println("⚠️ View flusher disabled")
+		return
+	}
+
+	log.Printf("👀 View flusher running every %s", interval)
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		stopping := false
+		select {
+		case <-ctx.Done():
+			stopping = true
+		case <-ticker.C:
+		}
+
+		flushCtx, cancel := context.WithTimeout(context.WithoutCancel(ctx), interval)
+		count, err := postService.FlushViews(flushCtx)
+		cancel()
+		if err != nil {
+			log.Printf("❌ View flush failed: %v", err)
+		} else if count > 0 {
+			log.Printf("👀 Flushed %d post views", count)
+		}
+
+		if stopping {
+			log.Println("👀 View flusher stopped")
+			return
+		}
+	}
+}
+
+// runRelatedPostsWorker recomputes the related posts recommendations every interval
+// when posts were published, unpublished or edited since the last run
+func runRelatedPostsWorker(ctx context.Context, postService post.Service, interval time.Duration) {
+	if interval <= 0 {
+		log.Println("⚠️ Related posts worker disabled")
+		return
+	}
+
+	log.Printf("🔗 Related posts worker running every %s", interval)
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			log.Println("🔗 Related posts worker stopped")
+			return
+		case <-ticker.C:
+		}
+
+		runCtx, cancel := context.WithTimeout(context.WithoutCancel(ctx), interval)
+		count, err := postService.RecomputeRelatedPosts(runCtx)
+		cancel()
+		if err != nil {
+			log.Printf("❌ Related posts recompute failed: %v", err)
+			continue
+		}
+		if count > 0 {
+			log.Printf("🔗 Stored %d related post recommendations", count)
+		}
+	}
+}
+
+// runMediaGC deletes the assets no post has used since the grace period every interval
+func runMediaGC(ctx context.Context, mediaService media.Service, interval time.Duration) {
+	if interval <= 0 {
+		log.Println("⚠️ Media garbage collector disabled")
+		return
+	}
+
+	log.Printf("🧹 Media garbage collector running every %s", interval)
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			log.Println("🧹 Media garbage collector stopped")
+			return
+		case <-ticker.C:
+		}
+
+		runCtx, cancel := context.WithTimeout(context.WithoutCancel(ctx), interval)
+		count, err := mediaService.CollectGarbage(runCtx, time.Now())
+		cancel()
+		if err != nil {
+			log.Printf("❌ Media garbage collection failed: %v", err)
+			continue
+		}
+		if count > 0 {
+			log.Printf("🧹 Deleted %d unused media assets", count)
+		}
+	}
+}
+
+// runImportJobWorker runs the queued CSV import jobs every interval. A job may
+// take longer than an interval, so runs get no deadline of their own. On shutdown
+// a running job stops after its current batch.
+func runImportJobWorker(ctx context.Context, importJobService importjob.Service, interval time.Duration) {
+	if interval <= 0 {
+		log.Println("⚠️ Import job worker disabled")
+		return
+	}
+
+	log.Printf("📥 Import job worker running every %s", interval)
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			log.Println("📥 Import job worker stopped")
+			return
+		case <-ticker.C:
+		}
+
+		count, err := importJobService.RunPendingJobs(ctx, time.Now())
+		if errors.Is(err, context.Canceled) {
+			log.Println("📥 Import job worker stopped")
+			return
+		}
+		if err != nil {
+			log.Printf("❌ Import job run failed: %v", err)
+			continue
+		}
+		if count > 0 {
+			log.Printf("📥 Ran %d import jobs", count)
+		}
+	}
+}
@@ -22,4 +22,6 @@ func Execute() {
 
 func init() {
 	rootCmd.AddCommand(restCmd)
+	rootCmd.AddCommand(importCmd)
+	rootCmd.AddCommand(exportCmd)
 }
@@ -0,0 +1,81 @@
+package comment
+
+import (
+	"time"
+
+	"postal/domain"
+)
+
+type CreateCommentRequest struct {
+	ParentID *uint  `json:"parent_id"`
+	Body     string `json:"body" validate:"required,min=1,max=5000"`
+}
+
+type UpdateCommentRequest struct {
+	Body string `json:"body" validate:"required,min=1,max=5000"`
+}
+
+type ModerateCommentRequest struct {
+	Status domain.CommentStatus `json:"status" validate:"required,oneof=approved rejected spam"`
+}
+
+type UpdateSettingsRequest struct {
+	Enabled         *bool `json:"enabled"`
+	RequireApproval *bool `json:"require_approval"`
+}
+
+// Author is the authenticated user acting on a comment
+type Author struct {
+	ID       uint
+	Username string
+	IsEditor bool
+}
+
+// ModerationFilter selects comments of the moderation queue, pending by default
+type ModerationFilter struct {
+	Status domain.CommentStatus
+	PostID *uint
+	Limit  int
+	Offset int
+}
+
+type CommentResponse struct {
+	ID          uint                 `json:"id"`
+	PostID      uint                 `json:"post_id"`
+	ParentID    *uint                `json:"parent_id,omitempty"`
+	Depth       int                  `json:"depth"`
+	AuthorID    uint                 `json:"author_id"`
+	AuthorName  string               `json:"author_name"`
+	Body        string               `json:"body"`
+	Status      domain.CommentStatus `json:"status"`
+	IsDeleted   bool                 `json:"is_deleted"`
+	EditedAt    *time.Time           `json:"edited_at,omitempty"`
+	ModeratedBy uint                 `json:"moderated_by,omitempty"`
+	ModeratedAt *time.Time           `json:"moderated_at,omitempty"`
+	CreatedAt   time.Time            `json:"created_at"`
+	Replies     []*CommentResponse   `json:"replies,omitempty"`
+}
+
+func ToCommentResponse(comment *domain.Comment) *CommentResponse {
+	response := &CommentResponse{
+		ID:          comment.ID,
+		PostID:      comment.PostID,
+		ParentID:    comment.ParentID,
+		Depth:       comment.Depth,
+		AuthorID:    comment.AuthorID,
+		AuthorName:  comment.AuthorName,
+		Body:        comment.Body,
+		Status:      comment.Status,
+		IsDeleted:   comment.IsDeleted,
+		EditedAt:    comment.EditedAt,
+		ModeratedBy: comment.ModeratedBy,
+		ModeratedAt: comment.ModeratedAt,
+		CreatedAt:   comment.CreatedAt,
+	}
+	if comment.IsDeleted {
+		response.AuthorID = 0
+		response.AuthorName = ""
+		response.Body = ""
+	}
+	return response
+}
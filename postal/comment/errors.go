@@ -0,0 +1,12 @@
+package comment
+
+import "errors"
+
+var (
+	ErrCommentNotFound  = errors.New("comment not found")
+	ErrPostNotFound     = errors.New("post not found")
+	ErrCommentsDisabled = errors.New("comments are disabled")
+	ErrNotAuthor        = errors.New("only the author can change this comment")
+	ErrInvalidParent    = errors.New("parent comment does not belong to this post")
+	ErrThreadTooDeep    = errors.New("replies cannot be nested any deeper")
+)
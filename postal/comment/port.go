@@ -0,0 +1,35 @@
+package comment
+
+import (
+	"context"
+
+	"postal/domain"
+)
+
+// Service defines the business logic interface for comments
+type Service interface {
+	CreateComment(ctx context.Context, tenant string, postID uint, req CreateCommentRequest, author Author) (*CommentResponse, error)
+	UpdateComment(ctx context.Context, tenant string, id uint, req UpdateCommentRequest, author Author) (*CommentResponse, error)
+	DeleteComment(ctx context.Context, id uint, author Author) error
+	ListPostComments(ctx context.Context, tenant string, postID uint, limit, offset int) ([]*CommentResponse, int64, error)
+	CountPostComments(ctx context.Context, postIDs []uint) (map[uint]int64, error)
+	ListModerationQueue(ctx context.Context, filter ModerationFilter) ([]*CommentResponse, int64, error)
+	ModerateComment(ctx context.Context, id uint, status domain.CommentStatus, moderatorID uint) (*CommentResponse, error)
+	GetSettings(ctx context.Context, tenant string) (*domain.CommentSettings, error)
+	UpdateSettings(ctx context.Context, tenant string, req UpdateSettingsRequest, userID uint) (*domain.CommentSettings, error)
+}
+
+// Repository defines the interface for comment persistence
+type Repository interface {
+	Create(ctx context.Context, comment *domain.Comment) error
+	GetByID(ctx context.Context, id uint) (*domain.Comment, error)
+	Update(ctx context.Context, comment *domain.Comment) error
+	Delete(ctx context.Context, id uint) error
+	HasReplies(ctx context.Context, id uint) (bool, error)
+	ListRoots(ctx context.Context, postID uint, limit, offset int) ([]*domain.Comment, int64, error)
+	ListThreadReplies(ctx context.Context, rootIDs []uint) ([]*domain.Comment, error)
+	CountApprovedByPostIDs(ctx context.Context, postIDs []uint) (map[uint]int64, error)
+	ListByStatus(ctx context.Context, filter ModerationFilter) ([]*domain.Comment, int64, error)
+	GetSettings(ctx context.Context, tenant string) (*domain.CommentSettings, error)
+	UpsertSettings(ctx context.Context, settings *domain.CommentSettings) error
+}
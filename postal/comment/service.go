@@ -0,0 +1,285 @@
+package comment
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"postal/domain"
+	"postal/post"
+)
+
+// MaxDepth is how deep replies can be nested, a root comment has depth 0
+const MaxDepth = 4
+
+type service struct {
+	repo     Repository
+	postRepo post.Repository
+}
+
+func (s *service) CreateComment(ctx context.Context, tenant string, postID uint, req CreateCommentRequest, author Author) (*CommentResponse, error) {
+	settings, err := s.GetSettings(ctx, tenant)
+	if err != nil {
+		return nil, err
+	}
+	if !settings.Enabled {
+		return nil, ErrCommentsDisabled
+	}
+
+	p, err := s.postRepo.GetByID(ctx, postID)
+	if err != nil || p.Status != domain.StatusPublished {
+		return nil, ErrPostNotFound
+	}
+
+	comment := &domain.Comment{
+		PostID:     postID,
+		AuthorID:   author.ID,
+		AuthorName: author.Username,
+		Body:       req.Body,
+		Status:     domain.CommentApproved,
+	}
+	if settings.RequireApproval && !author.IsEditor {
+		comment.Status = domain.CommentPending
+	}
+
+	if req.ParentID != nil {
+		parent, err := s.repo.GetByID(ctx, *req.ParentID)
+		if err != nil || parent.PostID != postID || parent.Status != domain.CommentApproved {
+			return nil, ErrInvalidParent
+		}
+		if parent.Depth >= MaxDepth {
+			return nil, ErrThreadTooDeep
+		}
+
+		rootID := parent.ID
+		if parent.RootID != nil {
+			rootID = *parent.RootID
+		}
+		comment.ParentID = &parent.ID
+		comment.RootID = &rootID
+		comment.Depth = parent.Depth + 1
+	}
+
+	if err := s.repo.Create(ctx, comment); err != nil {
+		return nil, fmt.Errorf("failed to create comment: %w", err)
+	}
+
+	return ToCommentResponse(comment), nil
+}
+
+// UpdateComment lets authors edit their comment. With approval required the
+// edit goes back to the moderation queue.
+func (s *service) UpdateComment(ctx context.Context, tenant string, id uint, req UpdateCommentRequest, author Author) (*CommentResponse, error) {
+	comment, err := s.repo.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if comment.IsDeleted {
+		return nil, ErrCommentNotFound
+	}
+	if comment.AuthorID != author.ID {
+		return nil, ErrNotAuthor
+	}
+
+	settings, err := s.GetSettings(ctx, tenant)
+	if err != nil {
+		return nil, err
+	}
+	if !settings.Enabled {
+		return nil, ErrCommentsDisabled
+	}
+
+	now := time.Now()
+	comment.Body = req.Body
+	comment.EditedAt = &now
+	if settings.RequireApproval && !author.IsEditor && comment.Status == domain.CommentApproved {
+		comment.Status = domain.CommentPending
+	}
+
+	if err := s.repo.Update(ctx, comment); err != nil {
+		return nil, fmt.Errorf("failed to update comment: %w", err)
+	}
+
+	return ToCommentResponse(comment), nil
+}
+
+// DeleteComment removes a comment for its author or an editor. A comment with
+// replies is blanked instead so the thread below it survives.
+func (s *service) DeleteComment(ctx context.Context, id uint, author Author) error {
+	comment, err := s.repo.GetByID(ctx, id)
+	if err != nil {
+		return err
+	}
+	if comment.IsDeleted {
+		return ErrCommentNotFound
+	}
+	if comment.AuthorID != author.ID && !author.IsEditor {
+		return ErrNotAuthor
+	}
+
+	hasReplies, err := s.repo.HasReplies(ctx, id)
+	if err != nil {
+		return fmt.Errorf("failed to check comment replies: %w", err)
+	}
+	if !hasReplies {
+		return s.repo.Delete(ctx, id)
+	}
+
+	comment.IsDeleted = true
+	comment.Body = ""
+	if err := s.repo.Update(ctx, comment); err != nil {
+		return fmt.Errorf("failed to delete comment: %w", err)
+	}
+	return nil
+}
+
+// ListPostComments returns a page of approved threads of a post, oldest first,
+// each with its approved replies nested below it
+func (s *service) ListPostComments(ctx context.Context, tenant string, postID uint, limit, offset int) ([]*CommentResponse, int64, error) {
+	settings, err := s.GetSettings(ctx, tenant)
+	if err != nil {
+		return nil, 0, err
+	}
+	if !settings.Enabled {
+		return []*CommentResponse{}, 0, nil
+	}
+
+	roots, total, err := s.repo.ListRoots(ctx, postID, limit, offset)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to list comments: %w", err)
+	}
+	if len(roots) == 0 {
+		return []*CommentResponse{}, total, nil
+	}
+
+	rootIDs := make([]uint, len(roots))
+	for i, root := range roots {
+		rootIDs[i] = root.ID
+	}
+	replies, err := s.repo.ListThreadReplies(ctx, rootIDs)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to list comment replies: %w", err)
+	}
+
+	return buildThreads(roots, replies), total, nil
+}
+
+// buildThreads nests replies under their parents. Replies are ordered by
+// creation so a parent is always placed before its replies.
+func buildThreads(roots, replies []*domain.Comment) []*CommentResponse {
+	byID := make(map[uint]*CommentResponse, len(roots)+len(replies))
+
+	threads := make([]*CommentResponse, len(roots))
+	for i, root := range roots {
+		threads[i] = ToCommentResponse(root)
+		byID[root.ID] = threads[i]
+	}
+
+	for _, reply := range replies {
+		parent, ok := byID[*reply.ParentID]
+		if !ok {
+			// The parent is hidden, so is the reply
+			continue
+		}
+		response := ToCommentResponse(reply)
+		parent.Replies = append(parent.Replies, response)
+		byID[reply.ID] = response
+	}
+
+	return threads
+}
+
+func (s *service) CountPostComments(ctx context.Context, postIDs []uint) (map[uint]int64, error) {
+	counts, err := s.repo.CountApprovedByPostIDs(ctx, postIDs)
+	if err != nil {
+		return nil, fmt.Errorf("failed to count comments: %w", err)
+	}
+	for _, id := range postIDs {
+		if _, ok := counts[id]; !ok {
+			counts[id] = 0
+		}
+	}
+	return counts, nil
+}
+
+func (s *service) ListModerationQueue(ctx context.Context, filter ModerationFilter) ([]*CommentResponse, int64, error) {
+	if filter.Status == "" {
+		filter.Status = domain.CommentPending
+	}
+
+	comments, total, err := s.repo.ListByStatus(ctx, filter)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to list moderation queue: %w", err)
+	}
+
+	responses := make([]*CommentResponse, len(comments))
+	for i, comment := range comments {
+		responses[i] = ToCommentResponse(comment)
+	}
+	return responses, total, nil
+}
+
+func (s *service) ModerateComment(ctx context.Context, id uint, status domain.CommentStatus, moderatorID uint) (*CommentResponse, error) {
+	switch status {
+	case domain.CommentApproved, domain.CommentRejected, domain.CommentSpam:
+	default:
+		return nil, fmt.Errorf("invalid moderation status: %s", status)
+	}
+
+	comment, err := s.repo.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if comment.IsDeleted {
+		return nil, ErrCommentNotFound
+	}
+
+	now := time.Now()
+	comment.Status = status
+	comment.ModeratedBy = moderatorID
+	comment.ModeratedAt = &now
+
+	if err := s.repo.Update(ctx, comment); err != nil {
+		return nil, fmt.Errorf("failed to moderate comment: %w", err)
+	}
+
+	log.Printf("🛡️ Comment %d moderated as %s by user %d", comment.ID, status, moderatorID)
+	return ToCommentResponse(comment), nil
+}
+
+// GetSettings returns the comment settings of a tenant, comments are enabled
+// without approval for tenants that never changed them
+func (s *service) GetSettings(ctx context.Context, tenant string) (*domain.CommentSettings, error) {
+	settings, err := s.repo.GetSettings(ctx, tenant)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load comment settings: %w", err)
+	}
+	if settings == nil {
+		settings = &domain.CommentSettings{
+			Tenant:  tenant,
+			Enabled: true,
+		}
+	}
+	return settings, nil
+}
+
+func (s *service) UpdateSettings(ctx context.Context, tenant string, req UpdateSettingsRequest, userID uint) (*domain.CommentSettings, error) {
+	settings, err := s.GetSettings(ctx, tenant)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.Enabled != nil {
+		settings.Enabled = *req.Enabled
+	}
+	if req.RequireApproval != nil {
+		settings.RequireApproval = *req.RequireApproval
+	}
+	settings.UpdatedBy = userID
+
+	if err := s.repo.UpsertSettings(ctx, settings); err != nil {
+		return nil, fmt.Errorf("failed to save comment settings: %w", err)
+	}
+	return settings, nil
+}
@@ -0,0 +1,13 @@
+package comment
+
+import (
+	"postal/post"
+)
+
+// NewService creates a new comment service with injected dependencies
+func NewService(repo Repository, postRepo post.Repository) Service {
+	return &service{
+		repo:     repo,
+		postRepo: postRepo,
+	}
+}
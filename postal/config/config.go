@@ -17,7 +17,40 @@ type Config struct {
 
 	JWTSecret string
 
-	MaxCSVUploadSizeMB int64
+	// PublicBaseURLs are the sites postal serves, feeds and sitemaps link to the one
+	// the request was made to and to the first one for any other host
+	PublicBaseURLs []string
+
+	// TrustedProxies are the addresses or CIDR ranges of the reverse proxies whose
+	// X-Tenant, X-Visitor-ID and X-Forwarded-* headers are honoured, other requests lose them
+	TrustedProxies []string
+
+	// PreviewSecret signs draft preview links, PreviewLinkTTL is their default lifetime.
+	// It is required in release mode, in debug mode a key is derived from JWTSecret.
+	PreviewSecret  string
+	PreviewLinkTTL time.Duration
+
+	// CortexURL is the cortex API the sitemap loads the approved categories from
+	// and imports resolve category slugs against
+	CortexURL string
+	// CortexToken is the JWT of the cortex user `postal import docs` creates missing categories as
+	CortexToken string
+
+	MaxCSVUploadSizeMB    int64
+	MaxImportUploadSizeMB int64
+	// ImportJobInterval is how often queued CSV uploads are picked up
+	ImportJobInterval time.Duration
+
+	// MediaDir holds uploaded files served below MediaBaseURL, a quota of 0 is unlimited
+	MediaDir             string
+	MediaBaseURL         string
+	MaxMediaUploadSizeMB int64
+	MediaTenantQuotaMB   int64
+	MediaGCInterval      time.Duration
+
+	SchedulerInterval time.Duration
+	ViewFlushInterval time.Duration
+	RelatedInterval   time.Duration
 
 	APMServiceName string
 	APMServerURL   string
@@ -4,6 +4,7 @@ import (
 	"log"
 	"os"
 	"strconv"
+	"strings"
 	"time"
 
 	"github.com/joho/godotenv"
@@ -18,16 +19,56 @@ func LoadConfig() *Config {
 	rmqReconnectDelay, _ := strconv.Atoi(getEnv("RMQ_RECONNECT_DELAY", "5"))
 	rmqRetryInterval, _ := strconv.Atoi(getEnv("RMQ_RETRY_INTERVAL", "600"))
 	maxCSVUploadSizeMB, _ := strconv.ParseInt(getEnv("MAX_CSV_UPLOAD_SIZE_MB", "20"), 10, 64)
+	maxImportUploadSizeMB, _ := strconv.ParseInt(getEnv("MAX_IMPORT_UPLOAD_SIZE_MB", "50"), 10, 64)
+	importJobInterval, _ := strconv.Atoi(getEnv("IMPORT_JOB_INTERVAL", "5"))
+	maxMediaUploadSizeMB, _ := strconv.ParseInt(getEnv("MAX_MEDIA_UPLOAD_SIZE_MB", "10"), 10, 64)
+	mediaTenantQuotaMB, _ := strconv.ParseInt(getEnv("MEDIA_TENANT_QUOTA_MB", "0"), 10, 64)
+	mediaGCInterval, _ := strconv.Atoi(getEnv("MEDIA_GC_INTERVAL", "3600"))
+	schedulerInterval, _ := strconv.Atoi(getEnv("SCHEDULER_INTERVAL", "30"))
+	viewFlushInterval, _ := strconv.Atoi(getEnv("VIEW_FLUSH_INTERVAL", "10"))
+	relatedInterval, _ := strconv.Atoi(getEnv("RELATED_POSTS_INTERVAL", "300"))
+	previewLinkTTL, _ := strconv.Atoi(getEnv("PREVIEW_LINK_TTL_HOURS", "72"))
+	jwtSecret := getEnv("JWT_SECRET", "your-secret-key")
+	httpPort := getEnv("HTTP_PORT", "8081")
+
+	var publicBaseURLs []string
+	for _, baseURL := range strings.Split(getEnv("PUBLIC_BASE_URLS", "http://localhost:"+httpPort), ",") {
+		if baseURL = strings.TrimRight(strings.TrimSpace(baseURL), "/"); baseURL != "" {
+			publicBaseURLs = append(publicBaseURLs, baseURL)
+		}
+	}
 
 	config := &Config{
 		Version:     getEnv("VERSION", "1.0.0"),
 		Mode:        getEnv("MODE", "debug"),
 		ServiceName: getEnv("SERVICE_NAME", "postal"),
-		HTTPPort:    getEnv("HTTP_PORT", "8081"),
+		HTTPPort:    httpPort,
+
+		JWTSecret: jwtSecret,
+
+		PublicBaseURLs: publicBaseURLs,
+
+		TrustedProxies: strings.Split(getEnv("TRUSTED_PROXIES", ""), ","),
+
+		PreviewSecret:  getEnv("PREVIEW_SECRET", ""),
+		PreviewLinkTTL: time.Duration(previewLinkTTL) * time.Hour,
+
+		CortexURL:   getEnv("CORTEX_URL", "http://localhost:8080"),
+		CortexToken: getEnv("CORTEX_TOKEN", ""),
+
+		MaxCSVUploadSizeMB:    maxCSVUploadSizeMB,
+		MaxImportUploadSizeMB: maxImportUploadSizeMB,
+		ImportJobInterval:     time.Duration(importJobInterval) * time.Second,
 
-		JWTSecret: getEnv("JWT_SECRET", "your-secret-key"),
+		MediaDir:             getEnv("MEDIA_DIR", "./uploads"),
+		MediaBaseURL:         getEnv("MEDIA_BASE_URL", "/media"),
+		MaxMediaUploadSizeMB: maxMediaUploadSizeMB,
+		MediaTenantQuotaMB:   mediaTenantQuotaMB,
+		MediaGCInterval:      time.Duration(mediaGCInterval) * time.Second,
 
-		MaxCSVUploadSizeMB: maxCSVUploadSizeMB,
+		SchedulerInterval: time.Duration(schedulerInterval) * time.Second,
+		ViewFlushInterval: time.Duration(viewFlushInterval) * time.Second,
+		RelatedInterval:   time.Duration(relatedInterval) * time.Second,
 
 		APMServiceName: getEnv("APM_SERVICE_NAME", ""),
 		APMServerURL:   getEnv("APM_SERVER_URL", ""),
@@ -0,0 +1,70 @@
+package domain
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// Asset is an uploaded file of the media library, images also carry resized variants
+type Asset struct {
+	ID        uint      `gorm:"primarykey" json:"id"`
+	UUID      string    `gorm:"type:uuid;uniqueIndex;not null" json:"uuid"`
+	CreatedAt time.Time `gorm:"index" json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+
+	// Tenant the asset counts against, uploads of the same file are shared per tenant
+	Tenant   string `gorm:"type:varchar(255);not null;index;index:idx_assets_tenant_checksum" json:"tenant"`
+	Checksum string `gorm:"type:varchar(64);not null;index:idx_assets_tenant_checksum" json:"checksum"`
+
+	Filename    string `gorm:"type:varchar(255)" json:"filename"`
+	ContentType string `gorm:"type:varchar(100);not null" json:"content_type"`
+	StorageKey  string `gorm:"type:varchar(500);not null" json:"storage_key"`
+	Size        int64  `gorm:"not null" json:"size"`
+	Width       int    `json:"width,omitempty"`
+	Height      int    `json:"height,omitempty"`
+
+	Variants []AssetVariant `gorm:"type:jsonb;serializer:json" json:"variants,omitempty"`
+
+	// TotalSize is the original plus its variants, what the tenant is charged for
+	TotalSize int64 `gorm:"not null" json:"total_size"`
+
+	UploadedBy uint `gorm:"not null" json:"uploaded_by"`
+}
+
+// AssetVariant is a resized copy of an image asset
+type AssetVariant struct {
+	Name        string `json:"name"`
+	StorageKey  string `json:"storage_key"`
+	ContentType string `json:"content_type"`
+	Size        int64  `json:"size"`
+	Width       int    `json:"width"`
+	Height      int    `json:"height"`
+}
+
+// BeforeCreate hook to generate UUID
+func (a *Asset) BeforeCreate(tx *gorm.DB) error {
+	if a.UUID == "" {
+		a.UUID = uuid.New().String()
+	}
+	return nil
+}
+
+// TableName specifies the table name
+func (Asset) TableName() string {
+	return "assets"
+}
+
+// PostAsset records that a post uses an asset, assets no post uses are
+// garbage-collected
+type PostAsset struct {
+	PostID    uint      `gorm:"primaryKey;autoIncrement:false" json:"post_id"`
+	AssetID   uint      `gorm:"primaryKey;autoIncrement:false;index" json:"asset_id"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+// TableName specifies the table name
+func (PostAsset) TableName() string {
+	return "post_assets"
+}
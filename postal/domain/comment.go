@@ -0,0 +1,65 @@
+package domain
+
+import (
+	"time"
+
+	"gorm.io/gorm"
+)
+
+type CommentStatus string
+
+const (
+	CommentPending  CommentStatus = "pending"
+	CommentApproved CommentStatus = "approved"
+	CommentRejected CommentStatus = "rejected"
+	CommentSpam     CommentStatus = "spam"
+)
+
+// Comment is a reader comment on a post. Replies point to their parent and to
+// the root comment of their thread so a whole thread loads in one query.
+type Comment struct {
+	ID        uint           `gorm:"primarykey" json:"id"`
+	CreatedAt time.Time      `json:"created_at"`
+	UpdatedAt time.Time      `json:"updated_at"`
+	DeletedAt gorm.DeletedAt `gorm:"index" json:"-"`
+
+	PostID   uint  `gorm:"not null;index:idx_comments_post_status" json:"post_id"`
+	ParentID *uint `gorm:"index" json:"parent_id,omitempty"`
+	RootID   *uint `gorm:"index" json:"root_id,omitempty"`
+	Depth    int   `gorm:"not null;default:0" json:"depth"`
+
+	AuthorID   uint   `gorm:"not null;index" json:"author_id"`
+	AuthorName string `gorm:"type:varchar(255)" json:"author_name"`
+	Body       string `gorm:"type:text;not null" json:"body"`
+
+	Status   CommentStatus `gorm:"type:varchar(20);not null;default:'pending';index:idx_comments_post_status;index" json:"status"`
+	EditedAt *time.Time    `json:"edited_at,omitempty"`
+
+	// A deleted comment with replies stays as a placeholder to keep its thread intact
+	IsDeleted bool `gorm:"default:false" json:"is_deleted"`
+
+	ModeratedBy uint       `json:"moderated_by,omitempty"`
+	ModeratedAt *time.Time `json:"moderated_at,omitempty"`
+}
+
+func (Comment) TableName() string {
+	return "comments"
+}
+
+// CommentSettings configures comments for a tenant, identified by its slug or domain
+type CommentSettings struct {
+	ID        uint      `gorm:"primarykey" json:"id"`
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+
+	Tenant string `gorm:"type:varchar(255);uniqueIndex;not null" json:"tenant"`
+
+	// No column defaults, GORM would otherwise replace a false value by the default
+	Enabled         bool `gorm:"not null" json:"enabled"`
+	RequireApproval bool `gorm:"not null" json:"require_approval"`
+	UpdatedBy       uint `json:"updated_by,omitempty"`
+}
+
+func (CommentSettings) TableName() string {
+	return "comment_settings"
+}
@@ -0,0 +1,103 @@
+package domain
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+type ImportJobStatus string
+
+const (
+	ImportJobPending   ImportJobStatus = "pending"
+	ImportJobRunning   ImportJobStatus = "running"
+	ImportJobCompleted ImportJobStatus = "completed"
+	ImportJobFailed    ImportJobStatus = "failed"
+)
+
+// ImportPolicy decides what happens to the valid rows of an upload with bad ones
+type ImportPolicy string
+
+const (
+	// ImportAllOrNothing creates no post unless every row is valid
+	ImportAllOrNothing ImportPolicy = "all_or_nothing"
+	// ImportBestEffort creates the valid rows and reports the others
+	ImportBestEffort ImportPolicy = "best_effort"
+)
+
+// ImportRowOutcome is what became of a row of an import
+type ImportRowOutcome string
+
+const (
+	ImportRowCreated ImportRowOutcome = "created"
+	// ImportRowValid is a row a dry run or a failed all-or-nothing import would have created
+	ImportRowValid   ImportRowOutcome = "valid"
+	ImportRowSkipped ImportRowOutcome = "skipped"
+	ImportRowInvalid ImportRowOutcome = "invalid"
+	ImportRowFailed  ImportRowOutcome = "failed"
+)
+
+// ImportJob is a CSV batch upload processed in the background. The file is kept
+// until the job finishes, the outcome of every row is an ImportJobRow.
+type ImportJob struct {
+	ID        uint      `gorm:"primarykey" json:"id"`
+	UUID      string    `gorm:"type:uuid;uniqueIndex;not null" json:"uuid"`
+	CreatedAt time.Time `gorm:"index" json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+
+	Status   ImportJobStatus `gorm:"type:varchar(20);not null;default:'pending';index" json:"status"`
+	Policy   ImportPolicy    `gorm:"type:varchar(20);not null" json:"policy"`
+	DryRun   bool            `gorm:"not null;default:false" json:"dry_run"`
+	Filename string          `gorm:"type:varchar(255)" json:"filename"`
+	Data     []byte          `gorm:"type:bytea" json:"-"`
+
+	// Progress, TotalRows is known once the file is parsed. Valid counts the
+	// rows a dry run or a failed all-or-nothing job would have created.
+	TotalRows     int `gorm:"not null;default:0" json:"total_rows"`
+	ProcessedRows int `gorm:"not null;default:0" json:"processed_rows"`
+	Created       int `gorm:"not null;default:0" json:"created"`
+	Valid         int `gorm:"not null;default:0" json:"valid"`
+	Skipped       int `gorm:"not null;default:0" json:"skipped"`
+	Invalid       int `gorm:"not null;default:0" json:"invalid"`
+	Failed        int `gorm:"not null;default:0" json:"failed"`
+
+	// Error is why a job failed as a whole
+	Error string `gorm:"type:text" json:"error,omitempty"`
+
+	CreatedBy  uint       `gorm:"not null;index" json:"created_by"`
+	StartedAt  *time.Time `json:"started_at,omitempty"`
+	FinishedAt *time.Time `json:"finished_at,omitempty"`
+}
+
+// BeforeCreate hook to generate UUID
+func (j *ImportJob) BeforeCreate(tx *gorm.DB) error {
+	if j.UUID == "" {
+		j.UUID = uuid.New().String()
+	}
+	return nil
+}
+
+// TableName specifies the table name
+func (ImportJob) TableName() string {
+	return "import_jobs"
+}
+
+// ImportJobRow is the outcome of a row of an import job. Column names the CSV
+// column of a validation error, PostID the post a created row became.
+type ImportJobRow struct {
+	ID    uint `gorm:"primarykey" json:"-"`
+	JobID uint `gorm:"not null;index:idx_import_job_rows_job_row" json:"-"`
+	Row   int  `gorm:"column:row_no;not null;index:idx_import_job_rows_job_row" json:"row"`
+
+	Outcome ImportRowOutcome `gorm:"type:varchar(20);not null" json:"outcome"`
+	Slug    string           `gorm:"type:varchar(500)" json:"slug,omitempty"`
+	Column  string           `gorm:"type:varchar(50)" json:"column,omitempty"`
+	Message string           `gorm:"type:text" json:"message,omitempty"`
+	PostID  *uint            `json:"post_id,omitempty"`
+}
+
+// TableName specifies the table name
+func (ImportJobRow) TableName() string {
+	return "import_job_rows"
+}
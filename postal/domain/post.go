@@ -5,12 +5,14 @@ import (
 
 	"github.com/google/uuid"
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 )
 
 type PostStatus string
 
 const (
 	StatusDraft     PostStatus = "draft"
+	StatusScheduled PostStatus = "scheduled"
 	StatusPublished PostStatus = "published"
 	StatusArchived  PostStatus = "archived"
 	StatusDeleted   PostStatus = "deleted"
@@ -42,6 +44,9 @@ type Post struct {
 	Keywords        string `gorm:"type:text" json:"keywords,omitempty"`
 	OGImage         string `gorm:"type:varchar(500)" json:"og_image,omitempty"`
 
+	// Tags, normalized by slug
+	Tags []Tag `gorm:"many2many:post_tags;" json:"tags,omitempty"`
+
 	// Status & Visibility
 	Status     PostStatus `gorm:"type:varchar(20);not null;default:'draft';index" json:"status"`
 	IsPublic   bool       `gorm:"default:true" json:"is_public"`
@@ -52,6 +57,10 @@ type Post struct {
 	PublishedAt *time.Time `json:"published_at,omitempty"`
 	ArchivedAt  *time.Time `json:"archived_at,omitempty"`
 
+	// Scheduling, picked up by the background scheduler
+	PublishAt   *time.Time `gorm:"index" json:"publish_at,omitempty"`
+	UnpublishAt *time.Time `gorm:"index" json:"unpublish_at,omitempty"`
+
 	// Audit
 	CreatedBy uint `gorm:"not null" json:"created_by"`
 	UpdatedBy uint `json:"updated_by,omitempty"`
@@ -74,6 +83,17 @@ func (p *Post) BeforeCreate(tx *gorm.DB) error {
 	return nil
 }
 
+// AfterCreate credits the creator as the first author, so every create path
+// (single, batch or import) leaves the post editable by whoever made it
+func (p *Post) AfterCreate(tx *gorm.DB) error {
+	if p.CreatedBy == 0 {
+		return nil
+	}
+	return tx.Session(&gorm.Session{NewDB: true}).
+		Clauses(clause.OnConflict{DoNothing: true}).
+		Create(&PostAuthor{PostID: p.ID, UserID: p.CreatedBy, Role: AuthorRoleAuthor, AddedBy: p.CreatedBy}).Error
+}
+
 // TableName specifies the table name
 func (Post) TableName() string {
 	return "posts"
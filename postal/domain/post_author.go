@@ -0,0 +1,44 @@
+package domain
+
+import "time"
+
+type AuthorRole string
+
+const (
+	AuthorRoleAuthor   AuthorRole = "author"
+	AuthorRoleEditor   AuthorRole = "editor"
+	AuthorRoleReviewer AuthorRole = "reviewer"
+)
+
+// AuthorRoles lists every role a user can be credited with on a post
+var AuthorRoles = []AuthorRole{AuthorRoleAuthor, AuthorRoleEditor, AuthorRoleReviewer}
+
+// IsValidAuthorRole reports whether r is a known author role
+func IsValidAuthorRole(r AuthorRole) bool {
+	for _, role := range AuthorRoles {
+		if r == role {
+			return true
+		}
+	}
+	return false
+}
+
+// CanEdit reports whether the role may change the post, reviewers only read it
+func (r AuthorRole) CanEdit() bool {
+	return r == AuthorRoleAuthor || r == AuthorRoleEditor
+}
+
+// PostAuthor credits a user on a post, the creator of a post is its first author
+type PostAuthor struct {
+	PostID    uint       `gorm:"primaryKey;autoIncrement:false" json:"post_id"`
+	UserID    uint       `gorm:"primaryKey;autoIncrement:false;index" json:"user_id"`
+	Role      AuthorRole `gorm:"type:varchar(20);not null" json:"role"`
+	AddedBy   uint       `json:"added_by,omitempty"`
+	CreatedAt time.Time  `json:"created_at"`
+	UpdatedAt time.Time  `json:"updated_at"`
+}
+
+// TableName specifies the table name
+func (PostAuthor) TableName() string {
+	return "post_authors"
+}
@@ -0,0 +1,26 @@
+package domain
+
+import "time"
+
+// PostPreviewLink lets someone without an account read an unpublished post until
+// ExpiresAt. The token handed out is signed over UUID, a link stops working once
+// it expires or is revoked.
+type PostPreviewLink struct {
+	ID        uint      `gorm:"primarykey" json:"id"`
+	UUID      string    `gorm:"type:uuid;uniqueIndex;not null" json:"uuid"`
+	CreatedAt time.Time `json:"created_at"`
+
+	PostID uint `gorm:"not null;index" json:"post_id"`
+	// Version pins the preview to a saved version, nil follows the current draft
+	Version *int `json:"version,omitempty"`
+
+	ExpiresAt time.Time  `gorm:"not null;index" json:"expires_at"`
+	CreatedBy uint       `gorm:"not null" json:"created_by"`
+	RevokedAt *time.Time `json:"revoked_at,omitempty"`
+	RevokedBy uint       `json:"revoked_by,omitempty"`
+}
+
+// TableName specifies the table name
+func (PostPreviewLink) TableName() string {
+	return "post_preview_links"
+}
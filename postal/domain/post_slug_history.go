@@ -0,0 +1,19 @@
+package domain
+
+import (
+	"time"
+)
+
+// PostSlugHistory keeps previous slugs of a post so old links can be redirected
+type PostSlugHistory struct {
+	ID        uint      `gorm:"primarykey" json:"id"`
+	CreatedAt time.Time `json:"created_at"`
+
+	PostID    uint   `gorm:"not null;index" json:"post_id"`
+	Slug      string `gorm:"type:varchar(500);uniqueIndex;not null" json:"slug"`
+	ChangedBy uint   `json:"changed_by,omitempty"`
+}
+
+func (PostSlugHistory) TableName() string {
+	return "post_slug_histories"
+}
@@ -0,0 +1,23 @@
+package domain
+
+import "time"
+
+// PostSource ties a post to the file it was imported from, so importing the
+// file again updates the post instead of creating another one
+type PostSource struct {
+	ID        uint      `gorm:"primarykey" json:"id"`
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+
+	// Source is the path of the file relative to the parent of the imported tree
+	Source string `gorm:"type:varchar(500);not null;uniqueIndex" json:"source"`
+	PostID uint   `gorm:"not null;index" json:"post_id"`
+
+	// ContentHash is the sha256 of the file when it was last imported
+	ContentHash string `gorm:"type:varchar(64);not null" json:"content_hash"`
+}
+
+// TableName specifies the table name
+func (PostSource) TableName() string {
+	return "post_sources"
+}
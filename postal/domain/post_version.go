@@ -11,13 +11,24 @@ type PostVersion struct {
 	CreatedAt time.Time      `json:"created_at"`
 	DeletedAt gorm.DeletedAt `gorm:"index" json:"-"`
 
-	PostID     uint   `gorm:"not null;index" json:"post_id"`
-	VersionNo  int    `gorm:"not null" json:"version_no"`
+	PostID     uint   `gorm:"not null;index;index:idx_post_versions_post_version" json:"post_id"`
+	VersionNo  int    `gorm:"not null;index:idx_post_versions_post_version" json:"version_no"`
 	Title      string `gorm:"type:varchar(500);not null" json:"title"`
 	Content    string `gorm:"type:text;not null" json:"content"`
 	Summary    string `gorm:"type:text" json:"summary"`
+	Thumbnail  string `gorm:"type:varchar(500)" json:"thumbnail_url,omitempty"`
 	EditedBy   uint   `gorm:"not null" json:"edited_by"`
 	ChangeNote string `gorm:"type:text" json:"change_note"`
+
+	// Categorization snapshot
+	CategoryID    uint  `json:"category_id"`
+	SubCategoryID *uint `json:"sub_category_id,omitempty"`
+
+	// SEO snapshot
+	MetaTitle       string `gorm:"type:varchar(500)" json:"meta_title,omitempty"`
+	MetaDescription string `gorm:"type:text" json:"meta_description,omitempty"`
+	Keywords        string `gorm:"type:text" json:"keywords,omitempty"`
+	OGImage         string `gorm:"type:varchar(500)" json:"og_image,omitempty"`
 }
 
 func (PostVersion) TableName() string {
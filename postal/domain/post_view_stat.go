@@ -0,0 +1,19 @@
+package domain
+
+import "time"
+
+// PostViewStat counts the views of a post on a single day.
+// Cortex rebuilds its per-category "top posts" leaderboards from these rows.
+type PostViewStat struct {
+	ID            uint      `gorm:"primarykey" json:"-"`
+	PostID        uint      `gorm:"not null;uniqueIndex:idx_post_view_stats_post_day" json:"post_id"`
+	CategoryID    uint      `gorm:"not null;index" json:"category_id"`
+	SubCategoryID *uint     `gorm:"index" json:"sub_category_id,omitempty"`
+	Day           time.Time `gorm:"type:date;not null;uniqueIndex:idx_post_view_stats_post_day;index" json:"day"`
+	Count         int       `gorm:"not null;default:0" json:"count"`
+}
+
+// TableName specifies the table name
+func (PostViewStat) TableName() string {
+	return "post_view_stats"
+}
@@ -0,0 +1,49 @@
+package domain
+
+import "time"
+
+type ReactionType string
+
+const (
+	ReactionLike       ReactionType = "like"
+	ReactionClap       ReactionType = "clap"
+	ReactionInsightful ReactionType = "insightful"
+)
+
+// ReactionTypes lists every reaction a reader can leave on a post
+var ReactionTypes = []ReactionType{ReactionLike, ReactionClap, ReactionInsightful}
+
+// IsValidReaction reports whether t is a known reaction type
+func IsValidReaction(t ReactionType) bool {
+	for _, reaction := range ReactionTypes {
+		if t == reaction {
+			return true
+		}
+	}
+	return false
+}
+
+// PostReaction is a reaction of a user on a post, a user leaves each type at most once
+type PostReaction struct {
+	ID        uint         `gorm:"primarykey" json:"id"`
+	CreatedAt time.Time    `json:"created_at"`
+	PostID    uint         `gorm:"not null;uniqueIndex:idx_post_reactions_unique;index" json:"post_id"`
+	UserID    uint         `gorm:"not null;uniqueIndex:idx_post_reactions_unique" json:"user_id"`
+	Type      ReactionType `gorm:"type:varchar(20);not null;uniqueIndex:idx_post_reactions_unique" json:"type"`
+}
+
+func (PostReaction) TableName() string {
+	return "post_reactions"
+}
+
+// PostBookmark is a post saved by a user to read later
+type PostBookmark struct {
+	ID        uint      `gorm:"primarykey" json:"id"`
+	CreatedAt time.Time `gorm:"index:idx_post_bookmarks_user_created" json:"created_at"`
+	UserID    uint      `gorm:"not null;uniqueIndex:idx_post_bookmarks_unique;index:idx_post_bookmarks_user_created" json:"user_id"`
+	PostID    uint      `gorm:"not null;uniqueIndex:idx_post_bookmarks_unique;index" json:"post_id"`
+}
+
+func (PostBookmark) TableName() string {
+	return "post_bookmarks"
+}
@@ -0,0 +1,30 @@
+package domain
+
+import "time"
+
+// PostTermVector holds the term frequencies of a post's content. It is
+// recomputed by the related posts worker whenever the post version changes.
+type PostTermVector struct {
+	PostID    uint               `gorm:"primarykey" json:"post_id"`
+	Version   int                `gorm:"not null" json:"version"`
+	Terms     map[string]float64 `gorm:"type:jsonb;serializer:json;not null" json:"terms"`
+	UpdatedAt time.Time          `json:"updated_at"`
+}
+
+// TableName specifies the table name
+func (PostTermVector) TableName() string {
+	return "post_term_vectors"
+}
+
+// PostRelated is a precomputed recommendation of RelatedID for readers of PostID
+type PostRelated struct {
+	PostID     uint      `gorm:"primaryKey;autoIncrement:false" json:"post_id"`
+	RelatedID  uint      `gorm:"primaryKey;autoIncrement:false" json:"related_id"`
+	Score      float64   `gorm:"not null" json:"score"`
+	ComputedAt time.Time `gorm:"not null" json:"computed_at"`
+}
+
+// TableName specifies the table name
+func (PostRelated) TableName() string {
+	return "post_related"
+}
@@ -0,0 +1,37 @@
+package domain
+
+import "time"
+
+// Series groups posts that are meant to be read in order, like the parts of a course
+type Series struct {
+	ID        uint      `gorm:"primarykey" json:"id"`
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+
+	Title       string `gorm:"type:varchar(500);not null" json:"title"`
+	Slug        string `gorm:"type:varchar(500);uniqueIndex;not null" json:"slug"`
+	Description string `gorm:"type:text" json:"description,omitempty"`
+
+	CreatedBy uint `gorm:"not null" json:"created_by"`
+	UpdatedBy uint `json:"updated_by,omitempty"`
+
+	Parts []SeriesPost `gorm:"foreignKey:SeriesID;constraint:OnDelete:CASCADE" json:"parts,omitempty"`
+}
+
+// TableName specifies the table name
+func (Series) TableName() string {
+	return "series"
+}
+
+// SeriesPost places a post in a series, a post belongs to at most one series.
+// Position is 1-based and contiguous within the series.
+type SeriesPost struct {
+	PostID   uint `gorm:"primaryKey;autoIncrement:false" json:"post_id"`
+	SeriesID uint `gorm:"not null;index:idx_series_posts_series_position" json:"series_id"`
+	Position int  `gorm:"not null;index:idx_series_posts_series_position" json:"position"`
+}
+
+// TableName specifies the table name
+func (SeriesPost) TableName() string {
+	return "series_posts"
+}
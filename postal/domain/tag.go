@@ -0,0 +1,29 @@
+package domain
+
+import "time"
+
+// Tag labels posts, the slug is the normalized identity of a tag
+type Tag struct {
+	ID        uint      `gorm:"primarykey" json:"id"`
+	Name      string    `gorm:"type:varchar(100);not null" json:"name"`
+	Slug      string    `gorm:"type:varchar(120);uniqueIndex;not null" json:"slug"`
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+// TableName specifies the table name
+func (Tag) TableName() string {
+	return "tags"
+}
+
+// PostTag is the join table between posts and tags
+type PostTag struct {
+	PostID    uint      `gorm:"primaryKey" json:"post_id"`
+	TagID     uint      `gorm:"primaryKey;index" json:"tag_id"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+// TableName specifies the table name
+func (PostTag) TableName() string {
+	return "post_tags"
+}
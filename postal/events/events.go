@@ -0,0 +1,43 @@
+package events
+
+import (
+	"context"
+	"time"
+)
+
+const (
+	// Exchange is the topic exchange postal publishes its events to
+	Exchange = "postal"
+
+	PostViewedRoutingKey      = "post.viewed"
+	PostPublishedRoutingKey   = "post.published"
+	PostUnpublishedRoutingKey = "post.unpublished"
+)
+
+// Publisher publishes events to the message broker
+type Publisher interface {
+	Publish(ctx context.Context, routingKey string, payload any) error
+	Close() error
+}
+
+// PostViewed is published every time a published post is read
+type PostViewed struct {
+	PostID        uint      `json:"post_id"`
+	PostUUID      string    `json:"post_uuid"`
+	CategoryID    uint      `json:"category_id"`
+	SubCategoryID *uint     `json:"sub_category_id,omitempty"`
+	ViewedAt      time.Time `json:"viewed_at"`
+}
+
+// PostStatusChanged is published when a post is published or unpublished,
+// either by an editor or by the scheduler
+type PostStatusChanged struct {
+	PostID        uint      `json:"post_id"`
+	PostUUID      string    `json:"post_uuid"`
+	Slug          string    `json:"slug"`
+	CategoryID    uint      `json:"category_id"`
+	SubCategoryID *uint     `json:"sub_category_id,omitempty"`
+	Status        string    `json:"status"`
+	Scheduled     bool      `json:"scheduled"`
+	ChangedAt     time.Time `json:"changed_at"`
+}
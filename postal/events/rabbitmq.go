@@ -0,0 +1,96 @@
+package events
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"sync"
+	"time"
+
+	amqp "github.com/rabbitmq/amqp091-go"
+)
+
+type rabbitMQPublisher struct {
+	url            string
+	reconnectDelay time.Duration
+
+	mu          sync.Mutex
+	conn        *amqp.Connection
+	channel     *amqp.Channel
+	lastAttempt time.Time
+}
+
+// NewRabbitMQPublisher connects to RabbitMQ and declares the postal exchange.
+// A lost connection is re-established on the next publish, at most once per reconnectDelay.
+func NewRabbitMQPublisher(url string, reconnectDelay time.Duration) (Publisher, error) {
+	p := &rabbitMQPublisher{
+		url:            url,
+		reconnectDelay: reconnectDelay,
+	}
+	if err := p.connect(); err != nil {
+		return nil, err
+	}
+	return p, nil
+}
+
+func (p *rabbitMQPublisher) connect() error {
+	p.lastAttempt = time.Now()
+
+	conn, err := amqp.Dial(p.url)
+	if err != nil {
+		return fmt.Errorf("failed to connect to rabbitmq: %w", err)
+	}
+
+	channel, err := conn.Channel()
+	if err != nil {
+		conn.Close()
+		return fmt.Errorf("failed to open rabbitmq channel: %w", err)
+	}
+
+	if err := channel.ExchangeDeclare(Exchange, "topic", true, false, false, false, nil); err != nil {
+		conn.Close()
+		return fmt.Errorf("failed to declare exchange %s: %w", Exchange, err)
+	}
+
+	p.conn = conn
+	p.channel = channel
+	return nil
+}
+
+func (p *rabbitMQPublisher) Publish(ctx context.Context, routingKey string, payload any) error {
+	body, err := json.Marshal(payload)
+	if err != nil {
+		return fmt.Errorf("failed to marshal event: %w", err)
+	}
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if p.conn == nil || p.conn.IsClosed() {
+		if time.Since(p.lastAttempt) < p.reconnectDelay {
+			return fmt.Errorf("rabbitmq connection is closed")
+		}
+		log.Printf("🔄 Reconnecting to RabbitMQ...")
+		if err := p.connect(); err != nil {
+			return err
+		}
+	}
+
+	return p.channel.PublishWithContext(ctx, Exchange, routingKey, false, false, amqp.Publishing{
+		ContentType:  "application/json",
+		DeliveryMode: amqp.Persistent,
+		Timestamp:    time.Now(),
+		Body:         body,
+	})
+}
+
+func (p *rabbitMQPublisher) Close() error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if p.conn == nil || p.conn.IsClosed() {
+		return nil
+	}
+	return p.conn.Close()
+}
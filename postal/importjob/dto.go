@@ -0,0 +1,66 @@
+package importjob
+
+import (
+	"time"
+
+	"postal/domain"
+)
+
+// CreateJobRequest queues a CSV batch upload. Policy defaults to all-or-nothing,
+// a dry run only validates the rows.
+type CreateJobRequest struct {
+	UserID   uint
+	Filename string
+	Data     []byte
+	Policy   domain.ImportPolicy
+	DryRun   bool
+}
+
+// RowFilter pages the row outcomes of a job, optionally of one outcome only
+type RowFilter struct {
+	Outcome *domain.ImportRowOutcome
+	Limit   int
+	Offset  int
+}
+
+type JobResponse struct {
+	UUID          string                 `json:"uuid"`
+	Status        domain.ImportJobStatus `json:"status"`
+	Policy        domain.ImportPolicy    `json:"policy"`
+	DryRun        bool                   `json:"dry_run"`
+	Filename      string                 `json:"filename,omitempty"`
+	TotalRows     int                    `json:"total_rows"`
+	ProcessedRows int                    `json:"processed_rows"`
+	Created       int                    `json:"created"`
+	Valid         int                    `json:"valid"`
+	Skipped       int                    `json:"skipped"`
+	Invalid       int                    `json:"invalid"`
+	Failed        int                    `json:"failed"`
+	Error         string                 `json:"error,omitempty"`
+	CreatedBy     uint                   `json:"created_by"`
+	CreatedAt     time.Time              `json:"created_at"`
+	StartedAt     *time.Time             `json:"started_at,omitempty"`
+	FinishedAt    *time.Time             `json:"finished_at,omitempty"`
+}
+
+func ToJobResponse(job *domain.ImportJob) *JobResponse {
+	return &JobResponse{
+		UUID:          job.UUID,
+		Status:        job.Status,
+		Policy:        job.Policy,
+		DryRun:        job.DryRun,
+		Filename:      job.Filename,
+		TotalRows:     job.TotalRows,
+		ProcessedRows: job.ProcessedRows,
+		Created:       job.Created,
+		Valid:         job.Valid,
+		Skipped:       job.Skipped,
+		Invalid:       job.Invalid,
+		Failed:        job.Failed,
+		Error:         job.Error,
+		CreatedBy:     job.CreatedBy,
+		CreatedAt:     job.CreatedAt,
+		StartedAt:     job.StartedAt,
+		FinishedAt:    job.FinishedAt,
+	}
+}
@@ -0,0 +1,11 @@
+package importjob
+
+import "errors"
+
+var (
+	ErrJobNotFound    = errors.New("import job not found")
+	ErrNotJobOwner    = errors.New("only the uploader can see this import job")
+	ErrInvalidPolicy  = errors.New("policy must be all_or_nothing or best_effort")
+	ErrEmptyUpload    = errors.New("uploaded file is empty")
+	ErrInvalidOutcome = errors.New("outcome must be created, valid, skipped, invalid or failed")
+)
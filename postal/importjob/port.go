@@ -0,0 +1,30 @@
+package importjob
+
+import (
+	"context"
+	"time"
+
+	"postal/domain"
+	"postal/post"
+)
+
+// Service defines the business logic interface for background CSV import jobs
+type Service interface {
+	CreateJob(ctx context.Context, req CreateJobRequest) (*JobResponse, error)
+	GetJob(ctx context.Context, uuid string, actor post.Actor) (*JobResponse, error)
+	ListJobRows(ctx context.Context, uuid string, filter RowFilter, actor post.Actor) ([]*domain.ImportJobRow, int64, error)
+	RunPendingJobs(ctx context.Context, now time.Time) (int, error)
+}
+
+// Repository defines the interface for import job persistence
+type Repository interface {
+	Create(ctx context.Context, job *domain.ImportJob) error
+	GetByUUID(ctx context.Context, uuid string) (*domain.ImportJob, error)
+	ClaimNext(ctx context.Context, staleBefore, now time.Time) (*domain.ImportJob, error)
+	SaveProgress(ctx context.Context, job *domain.ImportJob) error
+	Heartbeat(ctx context.Context, jobID uint) error
+	SaveRows(ctx context.Context, jobID uint, rows []domain.ImportJobRow) error
+	ListCreatedRows(ctx context.Context, jobID uint) ([]domain.ImportJobRow, error)
+	Finish(ctx context.Context, job *domain.ImportJob, rows []domain.ImportJobRow) error
+	ListRows(ctx context.Context, jobID uint, filter RowFilter) ([]*domain.ImportJobRow, int64, error)
+}
@@ -0,0 +1,400 @@
+package importjob
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"time"
+
+	"postal/domain"
+	"postal/post"
+	"postal/util"
+)
+
+const (
+	// importBatchSize is the number of posts a best-effort job creates per transaction
+	importBatchSize = 100
+	// slugLookupSize bounds the slugs checked against the database in one query
+	slugLookupSize = 1000
+	// StaleAfter is how long a running job may go without progress before
+	// another worker takes it over, its process is assumed gone
+	StaleAfter = 10 * time.Minute
+	// heartbeatInterval keeps a job that is busy for long from going stale
+	heartbeatInterval = StaleAfter / 4
+)
+
+// service implements the Service interface
+type service struct {
+	repo  Repository
+	posts post.Service
+}
+
+// CreateJob stores an upload as a pending job, a worker picks it up
+func (s *service) CreateJob(ctx context.Context, req CreateJobRequest) (*JobResponse, error) {
+	switch req.Policy {
+	case "":
+		req.Policy = domain.ImportAllOrNothing
+	case domain.ImportAllOrNothing, domain.ImportBestEffort:
+	default:
+		return nil, ErrInvalidPolicy
+	}
+	if len(bytes.TrimSpace(req.Data)) == 0 {
+		return nil, ErrEmptyUpload
+	}
+
+	job := &domain.ImportJob{
+		Status:    domain.ImportJobPending,
+		Policy:    req.Policy,
+		DryRun:    req.DryRun,
+		Filename:  req.Filename,
+		Data:      req.Data,
+		CreatedBy: req.UserID,
+	}
+	if err := s.repo.Create(ctx, job); err != nil {
+		return nil, fmt.Errorf("failed to create import job: %w", err)
+	}
+	return ToJobResponse(job), nil
+}
+
+func (s *service) GetJob(ctx context.Context, uuid string, actor post.Actor) (*JobResponse, error) {
+	job, err := s.getOwnJob(ctx, uuid, actor)
+	if err != nil {
+		return nil, err
+	}
+	return ToJobResponse(job), nil
+}
+
+// ListJobRows pages the row outcomes of a job in row order, they are there once the job finished
+func (s *service) ListJobRows(ctx context.Context, uuid string, filter RowFilter, actor post.Actor) ([]*domain.ImportJobRow, int64, error) {
+	if filter.Outcome != nil && !isValidOutcome(*filter.Outcome) {
+		return nil, 0, ErrInvalidOutcome
+	}
+	job, err := s.getOwnJob(ctx, uuid, actor)
+	if err != nil {
+		return nil, 0, err
+	}
+	return s.repo.ListRows(ctx, job.ID, filter)
+}
+
+func (s *service) getOwnJob(ctx context.Context, uuid string, actor post.Actor) (*domain.ImportJob, error) {
+	job, err := s.repo.GetByUUID(ctx, uuid)
+	if err != nil {
+		return nil, err
+	}
+	if job.CreatedBy != actor.UserID && !actor.IsStaff {
+		return nil, ErrNotJobOwner
+	}
+	return job, nil
+}
+
+// RunPendingJobs processes queued jobs until there are none left, it returns the
+// number of jobs run. Jobs are claimed one at a time, so workers share the queue.
+// Once ctx is cancelled no job is claimed and a running one stops after its
+// current batch, another worker takes it over when it went stale.
+func (s *service) RunPendingJobs(ctx context.Context, now time.Time) (int, error) {
+	ran := 0
+	for ctx.Err() == nil {
+		job, err := s.repo.ClaimNext(ctx, now.Add(-StaleAfter), time.Now())
+		if err != nil {
+			return ran, fmt.Errorf("failed to claim import job: %w", err)
+		}
+		if job == nil {
+			return ran, nil
+		}
+
+		stop := s.heartbeat(ctx, job)
+		err = s.runJob(ctx, job)
+		stop()
+		if err != nil {
+			return ran, fmt.Errorf("failed to finish import job %s: %w", job.UUID, err)
+		}
+		ran++
+	}
+	return ran, ctx.Err()
+}
+
+// heartbeat keeps the job from being taken over as stale until stop is called
+func (s *service) heartbeat(ctx context.Context, job *domain.ImportJob) (stop func()) {
+	done := make(chan struct{})
+	stopped := make(chan struct{})
+	go func() {
+		defer close(stopped)
+		ticker := time.NewTicker(heartbeatInterval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-done:
+				return
+			case <-ticker.C:
+				if err := s.repo.Heartbeat(context.WithoutCancel(ctx), job.ID); err != nil {
+					log.Printf("⚠️ Failed to refresh import job (job=%s): %v", job.UUID, err)
+				}
+			}
+		}
+	}()
+	return func() {
+		close(done)
+		<-stopped
+	}
+}
+
+// runJob validates every row, then creates the posts the policy allows. Rows an
+// earlier run of a job taken over already created keep their outcome, and a job
+// whose created rows cannot be saved stops there. An error is only returned when
+// the outcome could not be stored or ctx was cancelled between batches. A batch
+// started is finished, posts are not left half-reported.
+func (s *service) runJob(ctx context.Context, job *domain.ImportJob) error {
+	work := context.WithoutCancel(ctx)
+
+	rows, err := util.ParseCSVRows(bytes.NewReader(job.Data), job.CreatedBy)
+	if err != nil {
+		job.Status = domain.ImportJobFailed
+		job.Error = fmt.Sprintf("failed to parse CSV: %v", err)
+		return s.finish(work, job, nil)
+	}
+
+	created, err := s.repo.ListCreatedRows(work, job.ID)
+	if err != nil {
+		return fmt.Errorf("failed to load earlier outcomes: %w", err)
+	}
+	createdRows := make(map[int]domain.ImportJobRow, len(created))
+	for _, row := range created {
+		row.ID = 0
+		createdRows[row.Row] = row
+	}
+
+	job.TotalRows = len(rows)
+	results := make([]domain.ImportJobRow, len(rows))
+	var candidates []int
+
+	firstRow := make(map[string]int, len(rows))
+	for i, row := range rows {
+		results[i] = domain.ImportJobRow{JobID: job.ID, Row: row.Row}
+		if earlier, ok := createdRows[row.Row]; ok {
+			results[i] = earlier
+			firstRow[earlier.Slug] = row.Row
+			continue
+		}
+		if row.Err != nil {
+			results[i].Outcome = domain.ImportRowInvalid
+			results[i].Column = row.Column
+			results[i].Message = row.Err.Error()
+			continue
+		}
+
+		results[i].Slug = row.Post.Slug
+		if other, ok := firstRow[row.Post.Slug]; ok {
+			results[i].Outcome = domain.ImportRowSkipped
+			results[i].Column = "slug"
+			results[i].Message = fmt.Sprintf("duplicate slug '%s', also in row %d", row.Post.Slug, other)
+			continue
+		}
+		firstRow[row.Post.Slug] = row.Row
+		candidates = append(candidates, i)
+	}
+
+	candidates, err = s.skipExistingSlugs(work, rows, results, candidates)
+	if err != nil {
+		job.Status = domain.ImportJobFailed
+		job.Error = err.Error()
+		return s.finish(work, job, results)
+	}
+
+	s.countOutcomes(job, results)
+	s.saveProgress(work, job)
+
+	switch {
+	case job.DryRun:
+		s.markValid(results, candidates, "")
+	case job.Policy == domain.ImportAllOrNothing && job.Invalid > 0:
+		s.markValid(results, candidates, "not created, the upload has invalid rows")
+		job.Status = domain.ImportJobFailed
+		job.Error = fmt.Sprintf("%d rows are invalid, no posts were created", job.Invalid)
+		return s.finish(work, job, results)
+	case job.Policy == domain.ImportAllOrNothing:
+		if err := s.createRows(work, rows, results, candidates); err != nil {
+			s.markFailed(results, candidates, err)
+			job.Status = domain.ImportJobFailed
+			job.Error = err.Error()
+			return s.finish(work, job, results)
+		}
+		if err := s.saveCreatedRows(work, job, results, candidates); err != nil {
+			return s.stopUnsaved(work, job, results, nil, err)
+		}
+	default:
+		for start := 0; start < len(candidates); start += importBatchSize {
+			if err := ctx.Err(); err != nil {
+				return err
+			}
+
+			end := min(start+importBatchSize, len(candidates))
+			batch := candidates[start:end]
+			if err := s.createRows(work, rows, results, batch); err != nil {
+				// Find the rows at fault by creating the batch a post at a time
+				for _, i := range batch {
+					if err := s.createRows(work, rows, results, []int{i}); err != nil {
+						s.markFailed(results, []int{i}, err)
+					}
+				}
+			}
+			if err := s.saveCreatedRows(work, job, results, batch); err != nil {
+				return s.stopUnsaved(work, job, results, candidates[end:], err)
+			}
+			s.countOutcomes(job, results)
+			s.saveProgress(work, job)
+		}
+	}
+
+	job.Status = domain.ImportJobCompleted
+	return s.finish(work, job, results)
+}
+
+// skipExistingSlugs marks the candidates whose slug a post already has as
+// skipped and returns the others
+func (s *service) skipExistingSlugs(ctx context.Context, rows []util.CSVRow, results []domain.ImportJobRow, candidates []int) ([]int, error) {
+	existing := make(map[string]bool)
+	for start := 0; start < len(candidates); start += slugLookupSize {
+		end := min(start+slugLookupSize, len(candidates))
+		slugs := make([]string, 0, end-start)
+		for _, i := range candidates[start:end] {
+			slugs = append(slugs, rows[i].Post.Slug)
+		}
+		found, err := s.posts.ExistingSlugs(ctx, slugs)
+		if err != nil {
+			return nil, fmt.Errorf("failed to check slugs: %w", err)
+		}
+		for slug := range found {
+			existing[slug] = true
+		}
+	}
+
+	remaining := candidates[:0]
+	for _, i := range candidates {
+		if existing[rows[i].Post.Slug] {
+			results[i].Outcome = domain.ImportRowSkipped
+			results[i].Column = "slug"
+			results[i].Message = fmt.Sprintf("slug '%s' already exists", rows[i].Post.Slug)
+			continue
+		}
+		remaining = append(remaining, i)
+	}
+	return remaining, nil
+}
+
+// createRows creates the posts of the rows in one transaction
+func (s *service) createRows(ctx context.Context, rows []util.CSVRow, results []domain.ImportJobRow, indexes []int) error {
+	if len(indexes) == 0 {
+		return nil
+	}
+
+	posts := make([]domain.Post, len(indexes))
+	slugRows := make([]util.SlugRow, len(indexes))
+	for n, i := range indexes {
+		posts[n] = *rows[i].Post
+		slugRows[n] = util.SlugRow{Slug: rows[i].Post.Slug, Row: rows[i].Row}
+	}
+
+	if err := s.posts.ImportPosts(ctx, &posts, &slugRows); err != nil {
+		return err
+	}
+
+	for n, i := range indexes {
+		postID := posts[n].ID
+		results[i].Outcome = domain.ImportRowCreated
+		results[i].PostID = &postID
+	}
+	return nil
+}
+
+func (s *service) markValid(results []domain.ImportJobRow, indexes []int, message string) {
+	for _, i := range indexes {
+		results[i].Outcome = domain.ImportRowValid
+		results[i].Message = message
+	}
+}
+
+func (s *service) markFailed(results []domain.ImportJobRow, indexes []int, err error) {
+	for _, i := range indexes {
+		results[i].Outcome = domain.ImportRowFailed
+		results[i].Message = err.Error()
+	}
+}
+
+// countOutcomes sets the counters of a job from the outcomes of its rows, rows
+// without an outcome yet are not processed
+func (s *service) countOutcomes(job *domain.ImportJob, results []domain.ImportJobRow) {
+	job.ProcessedRows, job.Created, job.Valid, job.Skipped, job.Invalid, job.Failed = 0, 0, 0, 0, 0, 0
+	for _, result := range results {
+		switch result.Outcome {
+		case domain.ImportRowCreated:
+			job.Created++
+		case domain.ImportRowValid:
+			job.Valid++
+		case domain.ImportRowSkipped:
+			job.Skipped++
+		case domain.ImportRowInvalid:
+			job.Invalid++
+		case domain.ImportRowFailed:
+			job.Failed++
+		default:
+			continue
+		}
+		job.ProcessedRows++
+	}
+}
+
+// saveProgress stores the counters of a running job, a failure only delays what
+// the status endpoint shows
+func (s *service) saveProgress(ctx context.Context, job *domain.ImportJob) {
+	if err := s.repo.SaveProgress(ctx, job); err != nil {
+		log.Printf("⚠️ Failed to save import job progress (job=%s): %v", job.UUID, err)
+	}
+}
+
+// saveCreatedRows stores the outcomes of the rows that became posts right away,
+// a worker taking the job over reports them as created instead of as skipped
+// for their now existing slugs
+func (s *service) saveCreatedRows(ctx context.Context, job *domain.ImportJob, results []domain.ImportJobRow, indexes []int) error {
+	var created []domain.ImportJobRow
+	for _, i := range indexes {
+		if results[i].Outcome == domain.ImportRowCreated {
+			created = append(created, results[i])
+		}
+	}
+	if err := s.repo.SaveRows(ctx, job.ID, created); err != nil {
+		return fmt.Errorf("failed to save created rows: %w", err)
+	}
+	return nil
+}
+
+// stopUnsaved fails a job whose created rows could not be saved. Creating more
+// posts would risk more rows a takeover cannot report, so the rows not reached
+// yet fail and every outcome so far is stored with the job instead.
+func (s *service) stopUnsaved(ctx context.Context, job *domain.ImportJob, results []domain.ImportJobRow, remaining []int, err error) error {
+	log.Printf("❌ Stopping import job (job=%s): %v", job.UUID, err)
+	s.markFailed(results, remaining, errors.New("not created, the import stopped early"))
+	job.Status = domain.ImportJobFailed
+	job.Error = err.Error()
+	return s.finish(ctx, job, results)
+}
+
+func (s *service) finish(ctx context.Context, job *domain.ImportJob, results []domain.ImportJobRow) error {
+	s.countOutcomes(job, results)
+	finishedAt := time.Now()
+	job.FinishedAt = &finishedAt
+	// The outcome of every row is kept, the upload itself is not needed anymore
+	job.Data = nil
+
+	// The posts may already be created, the outcome must not be lost to a cancelled worker
+	return s.repo.Finish(context.WithoutCancel(ctx), job, results)
+}
+
+func isValidOutcome(outcome domain.ImportRowOutcome) bool {
+	switch outcome {
+	case domain.ImportRowCreated, domain.ImportRowValid, domain.ImportRowSkipped, domain.ImportRowInvalid, domain.ImportRowFailed:
+		return true
+	}
+	return false
+}
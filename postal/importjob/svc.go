@@ -0,0 +1,11 @@
+package importjob
+
+import "postal/post"
+
+// NewService creates a new import job service, posts are created through the post service
+func NewService(repo Repository, posts post.Service) Service {
+	return &service{
+		repo:  repo,
+		posts: posts,
+	}
+}
@@ -0,0 +1,83 @@
+package media
+
+import (
+	"io"
+	"time"
+
+	"postal/domain"
+)
+
+// UploadRequest is a file uploaded to the media library of a tenant
+type UploadRequest struct {
+	Tenant   string
+	Filename string
+	File     io.Reader
+	UserID   uint
+}
+
+type AssetFilter struct {
+	Tenant     string
+	UploadedBy *uint
+	Limit      int
+	Offset     int
+}
+
+type AssetResponse struct {
+	ID          uint              `json:"id"`
+	UUID        string            `json:"uuid"`
+	Filename    string            `json:"filename"`
+	ContentType string            `json:"content_type"`
+	URL         string            `json:"url"`
+	Size        int64             `json:"size"`
+	Width       int               `json:"width,omitempty"`
+	Height      int               `json:"height,omitempty"`
+	Variants    []VariantResponse `json:"variants,omitempty"`
+	TotalSize   int64             `json:"total_size"`
+	UploadedBy  uint              `json:"uploaded_by"`
+	CreatedAt   time.Time         `json:"created_at"`
+}
+
+type VariantResponse struct {
+	Name        string `json:"name"`
+	URL         string `json:"url"`
+	ContentType string `json:"content_type"`
+	Size        int64  `json:"size"`
+	Width       int    `json:"width"`
+	Height      int    `json:"height"`
+}
+
+// UsageResponse is what a tenant stores in the media library, Quota is 0 when unlimited
+type UsageResponse struct {
+	Tenant string `json:"tenant"`
+	Assets int64  `json:"assets"`
+	Bytes  int64  `json:"bytes"`
+	Quota  int64  `json:"quota,omitempty"`
+}
+
+// ToAssetResponse converts an asset, keys become URLs below baseURL
+func ToAssetResponse(asset *domain.Asset, baseURL string) *AssetResponse {
+	response := &AssetResponse{
+		ID:          asset.ID,
+		UUID:        asset.UUID,
+		Filename:    asset.Filename,
+		ContentType: asset.ContentType,
+		URL:         baseURL + "/" + asset.StorageKey,
+		Size:        asset.Size,
+		Width:       asset.Width,
+		Height:      asset.Height,
+		TotalSize:   asset.TotalSize,
+		UploadedBy:  asset.UploadedBy,
+		CreatedAt:   asset.CreatedAt,
+	}
+	for _, variant := range asset.Variants {
+		response.Variants = append(response.Variants, VariantResponse{
+			Name:        variant.Name,
+			URL:         baseURL + "/" + variant.StorageKey,
+			ContentType: variant.ContentType,
+			Size:        variant.Size,
+			Width:       variant.Width,
+			Height:      variant.Height,
+		})
+	}
+	return response
+}
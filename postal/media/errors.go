@@ -0,0 +1,13 @@
+package media
+
+import "errors"
+
+var (
+	ErrAssetNotFound   = errors.New("asset not found")
+	ErrFileTooLarge    = errors.New("file is larger than the upload limit")
+	ErrUnsupportedType = errors.New("file type is not supported, upload a JPEG, PNG, GIF, WebP or PDF file")
+	ErrImageTooLarge   = errors.New("image dimensions are too large")
+	ErrQuotaExceeded   = errors.New("media storage quota of the tenant is exhausted")
+	ErrAssetInUse      = errors.New("asset is used by a post")
+	ErrNotUploader     = errors.New("only the uploader can delete this asset")
+)
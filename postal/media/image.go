@@ -0,0 +1,108 @@
+package media
+
+import (
+	"bytes"
+	"fmt"
+	"image"
+	_ "image/gif"
+	"image/jpeg"
+	"image/png"
+
+	"golang.org/x/image/draw"
+	_ "golang.org/x/image/webp"
+)
+
+const (
+	// maxImagePixels guards against decompression bombs, a small file can declare a huge canvas
+	maxImagePixels = 50_000_000
+
+	jpegQuality = 82
+)
+
+// variantWidths are the widths images are resized to, in ascending order.
+// A variant is only generated when the original is wider.
+var variantWidths = []struct {
+	Name  string
+	Width int
+}{
+	{"thumb", 320},
+	{"medium", 768},
+	{"large", 1600},
+}
+
+// imageContentTypes are the sniffed types that get dimensions and variants
+var imageContentTypes = map[string]bool{
+	"image/jpeg": true,
+	"image/png":  true,
+	"image/gif":  true,
+	"image/webp": true,
+}
+
+type encodedVariant struct {
+	Name        string
+	Width       int
+	Height      int
+	ContentType string
+	Data        []byte
+}
+
+// imageConfig reads the dimensions of an image without decoding its pixels
+func imageConfig(data []byte) (int, int, error) {
+	cfg, _, err := image.DecodeConfig(bytes.NewReader(data))
+	if err != nil {
+		return 0, 0, fmt.Errorf("failed to read image: %w", err)
+	}
+	if cfg.Width*cfg.Height > maxImagePixels {
+		return 0, 0, ErrImageTooLarge
+	}
+	return cfg.Width, cfg.Height, nil
+}
+
+// resizeImage makes the variants of an image that are narrower than it. PNG and
+// GIF sources stay PNG to keep transparency, everything else becomes JPEG.
+func resizeImage(data []byte, contentType string) ([]encodedVariant, error) {
+	src, _, err := image.Decode(bytes.NewReader(data))
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode image: %w", err)
+	}
+	bounds := src.Bounds()
+
+	outputType := "image/jpeg"
+	if contentType == "image/png" || contentType == "image/gif" {
+		outputType = "image/png"
+	}
+
+	var variants []encodedVariant
+	for _, size := range variantWidths {
+		if bounds.Dx() <= size.Width {
+			break
+		}
+
+		height := max(1, bounds.Dy()*size.Width/bounds.Dx())
+		dst := image.NewRGBA(image.Rect(0, 0, size.Width, height))
+		if outputType == "image/jpeg" {
+			// JPEG has no alpha, transparent pixels would turn black
+			draw.Draw(dst, dst.Bounds(), image.White, image.Point{}, draw.Src)
+		}
+		draw.CatmullRom.Scale(dst, dst.Bounds(), src, bounds, draw.Over, nil)
+
+		var buf bytes.Buffer
+		if outputType == "image/png" {
+			err = png.Encode(&buf, dst)
+		} else {
+			err = jpeg.Encode(&buf, dst, &jpeg.Options{Quality: jpegQuality})
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to encode %s variant: %w", size.Name, err)
+		}
+
+		variants = append(variants, encodedVariant{
+			Name:        size.Name,
+			Width:       size.Width,
+			Height:      height,
+			ContentType: outputType,
+			Data:        buf.Bytes(),
+		})
+	}
+	return variants, nil
+}
@@ -0,0 +1,39 @@
+package media
+
+import (
+	"context"
+	"io"
+	"time"
+
+	"postal/domain"
+	"postal/post"
+)
+
+// Service defines the business logic interface for the media library
+type Service interface {
+	Upload(ctx context.Context, req UploadRequest) (*AssetResponse, error)
+	GetAsset(ctx context.Context, id uint, tenant string) (*AssetResponse, error)
+	ListAssets(ctx context.Context, filter AssetFilter) ([]*AssetResponse, int64, error)
+	DeleteAsset(ctx context.Context, id uint, tenant string, actor post.Actor) error
+	ListPostAssets(ctx context.Context, postID uint) ([]*AssetResponse, error)
+	SyncPostAssets(ctx context.Context, postID uint, refs ...string) error
+	GetUsage(ctx context.Context, tenant string) (*UsageResponse, error)
+	Open(ctx context.Context, key string) (io.ReadSeekCloser, error)
+	CollectGarbage(ctx context.Context, now time.Time) (int, error)
+}
+
+// Repository defines the interface for asset persistence
+type Repository interface {
+	Create(ctx context.Context, asset *domain.Asset) error
+	GetByID(ctx context.Context, id uint) (*domain.Asset, error)
+	FindByChecksum(ctx context.Context, tenant, checksum string) (*domain.Asset, error)
+	Touch(ctx context.Context, id uint) (bool, error)
+	List(ctx context.Context, filter AssetFilter) ([]*domain.Asset, int64, error)
+	ListByPost(ctx context.Context, postID uint) ([]*domain.Asset, error)
+	IsLinked(ctx context.Context, id uint) (bool, error)
+	ReplacePostAssets(ctx context.Context, postID uint, uuids []string) error
+	Usage(ctx context.Context, tenant string) (*UsageResponse, error)
+	ListOrphans(ctx context.Context, createdBefore time.Time, limit int) ([]*domain.Asset, error)
+	DeleteIfOrphan(ctx context.Context, id uint, createdBefore time.Time) (bool, error)
+	Delete(ctx context.Context, id uint) error
+}
@@ -0,0 +1,326 @@
+package media
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"log"
+	"mime"
+	"net/http"
+	"path"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+
+	"postal/cache"
+	"postal/domain"
+	"postal/post"
+)
+
+const (
+	// GCGracePeriod keeps fresh uploads alive until the post using them is saved
+	GCGracePeriod = 24 * time.Hour
+
+	gcBatchSize = 100
+	gcLockKey   = "media:gc:lock"
+	gcLockTTL   = 30 * time.Minute
+)
+
+// extensions of the accepted types, anything else is rejected on upload
+var extensions = map[string]string{
+	"image/jpeg":      ".jpg",
+	"image/png":       ".png",
+	"image/gif":       ".gif",
+	"image/webp":      ".webp",
+	"application/pdf": ".pdf",
+}
+
+// assetRefPattern finds the "yyyy/mm/<uuid>/" directory of storage keys in post
+// URLs and content, whatever MEDIA_BASE_URL they are served from
+var assetRefPattern = regexp.MustCompile(`[0-9]{4}/[0-9]{2}/([0-9a-f]{8}-[0-9a-f]{4}-[0-9a-f]{4}-[0-9a-f]{4}-[0-9a-f]{12})/`)
+
+// service implements the Service interface
+type service struct {
+	repo    Repository
+	storage Storage
+	cache   cache.Cache
+	cfg     Config
+}
+
+// Upload stores a file, images get their dimensions recorded and resized variants.
+// A file the tenant already uploaded is returned instead of being stored twice,
+// its grace period restarts so the garbage collector can't take it away before
+// the post using it is saved.
+func (s *service) Upload(ctx context.Context, req UploadRequest) (*AssetResponse, error) {
+	reader := req.File
+	if s.cfg.MaxUploadSize > 0 {
+		reader = io.LimitReader(reader, s.cfg.MaxUploadSize+1)
+	}
+	data, err := io.ReadAll(reader)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read upload: %w", err)
+	}
+	if s.cfg.MaxUploadSize > 0 && int64(len(data)) > s.cfg.MaxUploadSize {
+		return nil, ErrFileTooLarge
+	}
+
+	// The declared type is ignored, what is served must match what was sniffed
+	contentType, _, _ := mime.ParseMediaType(http.DetectContentType(data))
+	ext, ok := extensions[contentType]
+	if !ok {
+		return nil, ErrUnsupportedType
+	}
+
+	sum := sha256.Sum256(data)
+	checksum := hex.EncodeToString(sum[:])
+	existing, err := s.repo.FindByChecksum(ctx, req.Tenant, checksum)
+	if err != nil {
+		return nil, fmt.Errorf("failed to look up asset: %w", err)
+	}
+	if existing != nil {
+		touched, err := s.repo.Touch(ctx, existing.ID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to refresh asset: %w", err)
+		}
+		// Otherwise the garbage collector deleted it in between, store it again
+		if touched {
+			return ToAssetResponse(existing, s.cfg.BaseURL), nil
+		}
+	}
+
+	asset := &domain.Asset{
+		UUID:        uuid.New().String(),
+		Tenant:      req.Tenant,
+		Checksum:    checksum,
+		Filename:    cleanFilename(req.Filename),
+		ContentType: contentType,
+		Size:        int64(len(data)),
+		UploadedBy:  req.UserID,
+	}
+	dir := path.Join(time.Now().UTC().Format("2006/01"), asset.UUID)
+	asset.StorageKey = path.Join(dir, "original"+ext)
+	asset.TotalSize = asset.Size
+
+	var variants []encodedVariant
+	if imageContentTypes[contentType] {
+		if asset.Width, asset.Height, err = imageConfig(data); err != nil {
+			return nil, err
+		}
+		if variants, err = resizeImage(data, contentType); err != nil {
+			return nil, err
+		}
+		for _, variant := range variants {
+			asset.Variants = append(asset.Variants, domain.AssetVariant{
+				Name:        variant.Name,
+				StorageKey:  path.Join(dir, variant.Name+extensions[variant.ContentType]),
+				ContentType: variant.ContentType,
+				Size:        int64(len(variant.Data)),
+				Width:       variant.Width,
+				Height:      variant.Height,
+			})
+			asset.TotalSize += int64(len(variant.Data))
+		}
+	}
+
+	if s.cfg.Quota > 0 {
+		usage, err := s.repo.Usage(ctx, req.Tenant)
+		if err != nil {
+			return nil, fmt.Errorf("failed to load media usage: %w", err)
+		}
+		if usage.Bytes+asset.TotalSize > s.cfg.Quota {
+			return nil, ErrQuotaExceeded
+		}
+	}
+
+	if err := s.storage.Put(ctx, asset.StorageKey, bytes.NewReader(data), contentType); err != nil {
+		return nil, fmt.Errorf("failed to store asset: %w", err)
+	}
+	for i, variant := range variants {
+		if err := s.storage.Put(ctx, asset.Variants[i].StorageKey, bytes.NewReader(variant.Data), variant.ContentType); err != nil {
+			s.removeObjects(ctx, asset)
+			return nil, fmt.Errorf("failed to store %s variant: %w", variant.Name, err)
+		}
+	}
+
+	if err := s.repo.Create(ctx, asset); err != nil {
+		s.removeObjects(ctx, asset)
+		return nil, fmt.Errorf("failed to create asset: %w", err)
+	}
+	return ToAssetResponse(asset, s.cfg.BaseURL), nil
+}
+
+// GetAsset returns an asset of the tenant, other tenants' assets are not found
+func (s *service) GetAsset(ctx context.Context, id uint, tenant string) (*AssetResponse, error) {
+	asset, err := s.getTenantAsset(ctx, id, tenant)
+	if err != nil {
+		return nil, err
+	}
+	return ToAssetResponse(asset, s.cfg.BaseURL), nil
+}
+
+func (s *service) ListAssets(ctx context.Context, filter AssetFilter) ([]*AssetResponse, int64, error) {
+	assets, total, err := s.repo.List(ctx, filter)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to list assets: %w", err)
+	}
+	return s.toResponses(assets), total, nil
+}
+
+// DeleteAsset removes an asset no post uses, only its uploader or staff may delete it
+func (s *service) DeleteAsset(ctx context.Context, id uint, tenant string, actor post.Actor) error {
+	asset, err := s.getTenantAsset(ctx, id, tenant)
+	if err != nil {
+		return err
+	}
+	if !actor.IsStaff && asset.UploadedBy != actor.UserID {
+		return ErrNotUploader
+	}
+
+	linked, err := s.repo.IsLinked(ctx, id)
+	if err != nil {
+		return fmt.Errorf("failed to check asset usage: %w", err)
+	}
+	if linked {
+		return ErrAssetInUse
+	}
+
+	if err := s.repo.Delete(ctx, id); err != nil {
+		return fmt.Errorf("failed to delete asset: %w", err)
+	}
+	s.removeObjects(ctx, asset)
+	return nil
+}
+
+func (s *service) ListPostAssets(ctx context.Context, postID uint) ([]*AssetResponse, error) {
+	assets, err := s.repo.ListByPost(ctx, postID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list post assets: %w", err)
+	}
+	return s.toResponses(assets), nil
+}
+
+// SyncPostAssets links a post to the assets referenced by its fields, replacing
+// the previous links. Unknown UUIDs are ignored.
+func (s *service) SyncPostAssets(ctx context.Context, postID uint, refs ...string) error {
+	seen := make(map[string]bool)
+	var uuids []string
+	for _, ref := range refs {
+		for _, match := range assetRefPattern.FindAllStringSubmatch(ref, -1) {
+			if !seen[match[1]] {
+				seen[match[1]] = true
+				uuids = append(uuids, match[1])
+			}
+		}
+	}
+
+	if err := s.repo.ReplacePostAssets(ctx, postID, uuids); err != nil {
+		return fmt.Errorf("failed to link post assets: %w", err)
+	}
+	return nil
+}
+
+func (s *service) GetUsage(ctx context.Context, tenant string) (*UsageResponse, error) {
+	usage, err := s.repo.Usage(ctx, tenant)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load media usage: %w", err)
+	}
+	usage.Quota = s.cfg.Quota
+	return usage, nil
+}
+
+func (s *service) Open(ctx context.Context, key string) (io.ReadSeekCloser, error) {
+	return s.storage.Open(ctx, key)
+}
+
+// CollectGarbage deletes assets older than the grace period that no post uses,
+// it returns the number of assets removed
+func (s *service) CollectGarbage(ctx context.Context, now time.Time) (int, error) {
+	if s.cache != nil {
+		unlock, acquired, err := cache.TryLock(ctx, s.cache, gcLockKey, gcLockTTL)
+		if err != nil {
+			log.Printf("⚠️ Failed to acquire media GC lock, running unlocked: %v", err)
+		} else if !acquired {
+			return 0, nil
+		}
+		defer unlock()
+	}
+
+	removed := 0
+	cutoff := now.Add(-GCGracePeriod)
+	for {
+		orphans, err := s.repo.ListOrphans(ctx, cutoff, gcBatchSize)
+		if err != nil {
+			return removed, fmt.Errorf("failed to list orphaned assets: %w", err)
+		}
+
+		progressed := false
+		for _, asset := range orphans {
+			// A post may have picked the asset up or an upload of the same file
+			// restarted its grace period since it was listed
+			deleted, err := s.repo.DeleteIfOrphan(ctx, asset.ID, cutoff)
+			if err != nil {
+				return removed, fmt.Errorf("failed to delete asset %d: %w", asset.ID, err)
+			}
+			if deleted {
+				s.removeObjects(ctx, asset)
+				removed++
+				progressed = true
+			}
+		}
+
+		if len(orphans) < gcBatchSize || !progressed {
+			return removed, nil
+		}
+	}
+}
+
+// getTenantAsset loads an asset, one belonging to another tenant is reported as
+// not found so ids can't be probed across tenants
+func (s *service) getTenantAsset(ctx context.Context, id uint, tenant string) (*domain.Asset, error) {
+	asset, err := s.repo.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if asset.Tenant != tenant {
+		return nil, ErrAssetNotFound
+	}
+	return asset, nil
+}
+
+// removeObjects deletes the stored files of an asset, failures only leave files behind
+func (s *service) removeObjects(ctx context.Context, asset *domain.Asset) {
+	keys := []string{asset.StorageKey}
+	for _, variant := range asset.Variants {
+		keys = append(keys, variant.StorageKey)
+	}
+	for _, key := range keys {
+		if err := s.storage.Delete(ctx, key); err != nil {
+			log.Printf("⚠️ Failed to delete media object (key=%s): %v", key, err)
+		}
+	}
+}
+
+func (s *service) toResponses(assets []*domain.Asset) []*AssetResponse {
+	responses := make([]*AssetResponse, len(assets))
+	for i, asset := range assets {
+		responses[i] = ToAssetResponse(asset, s.cfg.BaseURL)
+	}
+	return responses
+}
+
+// cleanFilename keeps the base name of an upload for display
+func cleanFilename(name string) string {
+	name = path.Base(strings.ReplaceAll(name, "\\", "/"))
+	if name == "." || name == "/" {
+		return ""
+	}
+	if len(name) > 255 {
+		name = name[:255]
+	}
+	return name
+}
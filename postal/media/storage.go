@@ -0,0 +1,19 @@
+package media
+
+import (
+	"context"
+	"errors"
+	"io"
+)
+
+// ErrObjectNotFound is returned by a Storage for a key it does not hold
+var ErrObjectNotFound = errors.New("media object not found")
+
+// Storage keeps the bytes of assets under slash separated keys. The local
+// filesystem backend is built in, an S3 compatible one only has to satisfy
+// the same interface.
+type Storage interface {
+	Put(ctx context.Context, key string, r io.Reader, contentType string) error
+	Open(ctx context.Context, key string) (io.ReadSeekCloser, error)
+	Delete(ctx context.Context, key string) error
+}
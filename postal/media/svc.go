@@ -0,0 +1,26 @@
+package media
+
+import (
+	"strings"
+
+	"postal/cache"
+)
+
+// Config sizes the media library, BaseURL is where Storage keys are served from
+// and a Quota of 0 leaves tenants unlimited
+type Config struct {
+	BaseURL       string
+	MaxUploadSize int64
+	Quota         int64
+}
+
+// NewService creates a new media service with injected dependencies
+func NewService(repo Repository, storage Storage, cache cache.Cache, cfg Config) Service {
+	cfg.BaseURL = strings.TrimSuffix(cfg.BaseURL, "/")
+	return &service{
+		repo:    repo,
+		storage: storage,
+		cache:   cache,
+		cfg:     cfg,
+	}
+}
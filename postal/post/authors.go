@@ -0,0 +1,238 @@
+package post
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log"
+	"time"
+
+	"postal/domain"
+)
+
+var (
+	ErrNotPostAuthor = errors.New("only the authors and editors of the post may change it")
+	ErrNotPostOwner  = errors.New("only the authors of the post may change its credits")
+	ErrLastAuthor    = errors.New("a post must keep at least one author")
+
+	ErrInvalidAuthorRole  = errors.New("invalid author role")
+	ErrPostAuthorNotFound = errors.New("post author not found")
+)
+
+func creditsCacheKey(postID uint) string {
+	return fmt.Sprintf("post:credits:%d", postID)
+}
+
+// AuthorizePostEdit checks that the actor may change the post, a post the actor
+// is not credited on is reported as not found when it does not exist
+func (s *service) AuthorizePostEdit(ctx context.Context, postID uint, actor Actor) error {
+	if actor.IsStaff {
+		return nil
+	}
+
+	author, err := s.repo.GetPostAuthor(ctx, postID, actor.UserID)
+	if err != nil {
+		return fmt.Errorf("failed to load post author: %w", err)
+	}
+	if author != nil && author.Role.CanEdit() {
+		return nil
+	}
+
+	if _, err := s.repo.GetByID(ctx, postID); err != nil {
+		return err
+	}
+	return ErrNotPostAuthor
+}
+
+// GetPostCredits returns the authors of a post and the users who saved its versions
+func (s *service) GetPostCredits(ctx context.Context, postID uint) (*PostCreditsResponse, error) {
+	if _, err := s.repo.GetByID(ctx, postID); err != nil {
+		return nil, err
+	}
+	return s.postCredits(ctx, postID)
+}
+
+func (s *service) postCredits(ctx context.Context, postID uint) (*PostCreditsResponse, error) {
+	cacheKey := creditsCacheKey(postID)
+	if s.cache != nil {
+		cached, err := s.cache.Get(ctx, cacheKey)
+		if err == nil && cached != "" {
+			var credits PostCreditsResponse
+			if err := json.Unmarshal([]byte(cached), &credits); err == nil {
+				return &credits, nil
+			}
+		}
+	}
+
+	authors, err := s.repo.ListPostAuthors(ctx, postID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list post authors: %w", err)
+	}
+	contributors, err := s.versionRepo.ListContributors(ctx, postID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list post contributors: %w", err)
+	}
+
+	credits := &PostCreditsResponse{
+		Authors:      make([]PostAuthorResponse, len(authors)),
+		Contributors: make([]ContributorResponse, len(contributors)),
+	}
+	roles := make(map[uint]domain.AuthorRole, len(authors))
+	for i, author := range authors {
+		roles[author.UserID] = author.Role
+		credits.Authors[i] = PostAuthorResponse{
+			UserID:    author.UserID,
+			Role:      author.Role,
+			AddedBy:   author.AddedBy,
+			CreatedAt: author.CreatedAt,
+		}
+	}
+	for i, contributor := range contributors {
+		credits.Contributors[i] = ContributorResponse{
+			UserID:      contributor.UserID,
+			Role:        roles[contributor.UserID],
+			Edits:       contributor.Edits,
+			FirstEditAt: contributor.FirstEditAt,
+			LastEditAt:  contributor.LastEditAt,
+		}
+	}
+
+	if s.cache != nil {
+		data, err := json.Marshal(credits)
+		if err == nil {
+			if err := s.cache.Set(ctx, cacheKey, data, 24*time.Hour); err != nil {
+				log.Printf("Failed to cache post credits: %v", err)
+			}
+		}
+	}
+
+	return credits, nil
+}
+
+// SetPostAuthor credits a user on a post with a role, only staff and the authors
+// of the post may do so
+func (s *service) SetPostAuthor(ctx context.Context, postID, userID uint, req SetPostAuthorRequest, actor Actor) (*PostCreditsResponse, error) {
+	if !domain.IsValidAuthorRole(req.Role) {
+		return nil, fmt.Errorf("%w %q", ErrInvalidAuthorRole, req.Role)
+	}
+	if err := s.authorizeCredits(ctx, postID, actor); err != nil {
+		return nil, err
+	}
+
+	if err := s.repo.WithTransaction(ctx, func(txRepo Repository) error {
+		if err := txRepo.SetPostAuthor(ctx, &domain.PostAuthor{
+			PostID:  postID,
+			UserID:  userID,
+			Role:    req.Role,
+			AddedBy: actor.UserID,
+		}); err != nil {
+			return err
+		}
+		return requireAuthor(ctx, txRepo, postID)
+	}); err != nil {
+		if errors.Is(err, ErrLastAuthor) {
+			return nil, err
+		}
+		return nil, fmt.Errorf("failed to set post author: %w", err)
+	}
+
+	s.invalidateCredits(ctx, postID)
+	return s.postCredits(ctx, postID)
+}
+
+// RemovePostAuthor drops the credit of a user on a post. Staff and the authors of
+// the post may remove anyone, other credited users may remove themselves.
+func (s *service) RemovePostAuthor(ctx context.Context, postID, userID uint, actor Actor) (*PostCreditsResponse, error) {
+	if actor.UserID != userID {
+		if err := s.authorizeCredits(ctx, postID, actor); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := s.repo.WithTransaction(ctx, func(txRepo Repository) error {
+		removed, err := txRepo.RemovePostAuthor(ctx, postID, userID)
+		if err != nil {
+			return err
+		}
+		if !removed {
+			return ErrPostAuthorNotFound
+		}
+		return requireAuthor(ctx, txRepo, postID)
+	}); err != nil {
+		if errors.Is(err, ErrLastAuthor) {
+			return nil, err
+		}
+		return nil, fmt.Errorf("failed to remove post author: %w", err)
+	}
+
+	s.invalidateCredits(ctx, postID)
+	return s.postCredits(ctx, postID)
+}
+
+// authorizeCredits checks that the actor may change who is credited on the post
+func (s *service) authorizeCredits(ctx context.Context, postID uint, actor Actor) error {
+	if _, err := s.repo.GetByID(ctx, postID); err != nil {
+		return err
+	}
+	if actor.IsStaff {
+		return nil
+	}
+
+	author, err := s.repo.GetPostAuthor(ctx, postID, actor.UserID)
+	if err != nil {
+		return fmt.Errorf("failed to load post author: %w", err)
+	}
+	if author == nil || author.Role != domain.AuthorRoleAuthor {
+		return ErrNotPostOwner
+	}
+	return nil
+}
+
+// creditCreator makes the creator of a new post its first author, in the
+// transaction that creates the post
+func creditCreator(ctx context.Context, repo Repository, post *domain.Post) error {
+	if post.CreatedBy == 0 {
+		return nil
+	}
+	return repo.SetPostAuthor(ctx, &domain.PostAuthor{
+		PostID:  post.ID,
+		UserID:  post.CreatedBy,
+		Role:    domain.AuthorRoleAuthor,
+		AddedBy: post.CreatedBy,
+	})
+}
+
+// requireAuthor fails when a change left the post without an author
+func requireAuthor(ctx context.Context, repo Repository, postID uint) error {
+	authors, err := repo.ListPostAuthors(ctx, postID)
+	if err != nil {
+		return err
+	}
+	for _, author := range authors {
+		if author.Role == domain.AuthorRoleAuthor {
+			return nil
+		}
+	}
+	return ErrLastAuthor
+}
+
+// attachCredits adds the authors and contributors to a post response
+func (s *service) attachCredits(ctx context.Context, post *PostResponse) {
+	credits, err := s.postCredits(ctx, post.ID)
+	if err != nil {
+		log.Printf("⚠️ Failed to load post credits (post_id=%d): %v", post.ID, err)
+		return
+	}
+	post.Authors = credits.Authors
+	post.Contributors = credits.Contributors
+}
+
+func (s *service) invalidateCredits(ctx context.Context, postID uint) {
+	if s.cache == nil {
+		return
+	}
+	if err := s.cache.Del(ctx, creditsCacheKey(postID)); err != nil {
+		log.Printf("Failed to invalidate post credits cache: %v", err)
+	}
+}
@@ -6,6 +6,7 @@ import (
 	"log"
 
 	"postal/domain"
+	"postal/sitemap"
 )
 
 // invalidatePostCache removes cached entries for a specific post
@@ -28,9 +29,73 @@ func (s *service) invalidatePostCache(ctx context.Context, post *domain.Post) {
 		}
 	}
 
+	// Invalidate engagement counters
+	if err := s.cache.Del(ctx, engagementKey(post.ID)); err != nil {
+		log.Printf("Failed to invalidate post engagement counters: %v", err)
+	}
+
+	// Invalidate rendered content of every version
+	renderPattern := fmt.Sprintf("post:render:%d:*", post.ID)
+	if err := s.cache.DelPattern(ctx, renderPattern); err != nil {
+		log.Printf("Failed to invalidate rendered post cache: %v", err)
+	}
+
+	s.invalidatePublicIndexes(ctx, post)
+
 	log.Printf("Invalidated cache for post ID=%d, slug=%s", post.ID, post.Slug)
 }
 
+// invalidatePublicIndexes is called when a post is published, unpublished or edited.
+// It drops the sitemap page of the post, the next sitemap request regenerates only
+// that page, marks the related posts recommendations for recompute and drops the
+// series navigation that may link to the post.
+func (s *service) invalidatePublicIndexes(ctx context.Context, post *domain.Post) {
+	if s.cache == nil || post == nil {
+		return
+	}
+
+	if err := s.cache.Del(ctx, sitemap.PostCacheKeys(post.ID)...); err != nil {
+		log.Printf("Failed to invalidate sitemap cache: %v", err)
+	}
+	s.markRelatedDirty(ctx)
+	s.invalidateSeriesCaches(ctx)
+}
+
+// invalidateImportedIndexes drops the sitemap pages of imported published posts
+// at once and marks the related posts for recompute
+func (s *service) invalidateImportedIndexes(ctx context.Context, posts []domain.Post) {
+	if s.cache == nil {
+		return
+	}
+
+	var keys []string
+	for i := range posts {
+		if posts[i].Status == domain.StatusPublished {
+			keys = append(keys, sitemap.PostCacheKeys(posts[i].ID)...)
+		}
+	}
+	if len(keys) == 0 {
+		return
+	}
+
+	if err := s.cache.Del(ctx, keys...); err != nil {
+		log.Printf("Failed to invalidate sitemap cache: %v", err)
+	}
+	s.markRelatedDirty(ctx)
+}
+
+// invalidateSlugCache removes the entry cached under a slug the post no longer uses
+func (s *service) invalidateSlugCache(ctx context.Context, slug string) {
+	if s.cache == nil || slug == "" {
+		return
+	}
+
+	slugKey := fmt.Sprintf("post:slug:%s", slug)
+	if err := s.cache.Del(ctx, slugKey); err != nil {
+		log.Printf("Failed to invalidate post cache by old slug: %v", err)
+	}
+}
+
 // invalidateListCaches removes all cached list queries
 func (s *service) invalidateListCaches(ctx context.Context) {
 	if s.cache == nil {
@@ -0,0 +1,151 @@
+package post
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+)
+
+var (
+	// ErrCategoryNotFound is returned for a slug cortex does not know
+	ErrCategoryNotFound = errors.New("category not found")
+
+	// ErrCortexTokenRequired is returned when creating a category without a cortex token
+	ErrCortexTokenRequired = errors.New("a cortex token is required to create categories")
+)
+
+var cortexHTTPClient = &http.Client{Timeout: 10 * time.Second}
+
+type cortexCategories struct {
+	baseURL string
+	token   string
+}
+
+// NewCortexCategories resolves category slugs with the cortex API, an empty
+// baseURL leaves imports without categories. Creating categories needs the
+// JWT of a cortex user as token.
+func NewCortexCategories(baseURL, token string) CategoryStore {
+	baseURL = strings.TrimRight(baseURL, "/")
+	if baseURL == "" {
+		return nil
+	}
+	return &cortexCategories{baseURL: baseURL, token: token}
+}
+
+// ResolveCategory looks a category or sub-category up by slug. Cortex redirects
+// previous slugs of renamed categories, the client follows to the current one.
+func (c *cortexCategories) ResolveCategory(ctx context.Context, slug string) (*Category, error) {
+	endpoint := c.baseURL + "/api/v1/categories/slug/" + url.PathEscape(slug)
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, endpoint, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := cortexHTTPClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("cortex: category request failed: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusNotFound {
+		return nil, ErrCategoryNotFound
+	}
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("cortex: category request failed with status %d", resp.StatusCode)
+	}
+
+	var body struct {
+		Data Category `json:"data"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&body); err != nil {
+		return nil, fmt.Errorf("cortex: failed to decode category: %w", err)
+	}
+	if body.Data.ID == 0 {
+		return nil, ErrCategoryNotFound
+	}
+
+	return &body.Data, nil
+}
+
+// GetCategory looks a top-level category up by its ID, deleted categories are not found
+func (c *cortexCategories) GetCategory(ctx context.Context, id uint) (*Category, error) {
+	endpoint := c.baseURL + "/api/v1/categories?id=" + strconv.FormatUint(uint64(id), 10)
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, endpoint, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := cortexHTTPClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("cortex: category request failed: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("cortex: category request failed with status %d", resp.StatusCode)
+	}
+
+	var body struct {
+		Data []Category `json:"data"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&body); err != nil {
+		return nil, fmt.Errorf("cortex: failed to decode categories: %w", err)
+	}
+	for _, category := range body.Data {
+		if category.ID == id && category.Status != "deleted" {
+			return &category, nil
+		}
+	}
+
+	return nil, ErrCategoryNotFound
+}
+
+// CreateCategory creates a category, or a sub-category under ParentID. Cortex does
+// not return what it created, so the category is looked up by its slug after.
+func (c *cortexCategories) CreateCategory(ctx context.Context, req CreateCategoryRequest) (*Category, error) {
+	if c.token == "" {
+		return nil, ErrCortexTokenRequired
+	}
+
+	endpoint := c.baseURL + "/api/v1/categories"
+	payload := map[string]any{
+		"slug":        req.Slug,
+		"label":       req.Label,
+		"description": req.Description,
+	}
+	if req.ParentID != 0 {
+		endpoint = c.baseURL + "/api/v1/sub-categories"
+		payload["parent_id"] = req.ParentID
+	}
+
+	body, err := json.Marshal(payload)
+	if err != nil {
+		return nil, err
+	}
+	httpReq, err := http.NewRequestWithContext(ctx, http.MethodPost, endpoint, bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+	httpReq.Header.Set("Content-Type", "application/json")
+	httpReq.Header.Set("Authorization", "Bearer "+c.token)
+
+	resp, err := cortexHTTPClient.Do(httpReq)
+	if err != nil {
+		return nil, fmt.Errorf("cortex: create category request failed: %w", err)
+	}
+	defer resp.Body.Close()
+
+	// A conflict means the slug exists already, which is what the caller wanted
+	if resp.StatusCode != http.StatusOK && resp.StatusCode != http.StatusCreated && resp.StatusCode != http.StatusConflict {
+		return nil, fmt.Errorf("cortex: create category request failed with status %d", resp.StatusCode)
+	}
+
+	return c.ResolveCategory(ctx, req.Slug)
+}
@@ -0,0 +1,199 @@
+package post
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"postal/domain"
+	"postal/util"
+)
+
+// ImportDocs imports a tree of Markdown notes. The tree becomes a cortex category
+// and its sections sub-categories, both created when missing. Every note is
+// remembered by its path, so importing the tree again only updates the notes whose
+// content changed, each update a new version of its post. New notes get their
+// initial version in the transaction that creates them.
+func (s *service) ImportDocs(ctx context.Context, root string, opts DocsImportOptions) (*DocsImportReport, error) {
+	if s.categories == nil {
+		return nil, fmt.Errorf("category lookup is not configured, set CORTEX_URL")
+	}
+	switch opts.Status {
+	case "":
+		opts.Status = domain.StatusPublished
+	case domain.StatusDraft, domain.StatusPublished:
+	default:
+		return nil, fmt.Errorf("status must be draft or published, got '%s'", opts.Status)
+	}
+
+	tree, err := util.ParseDocsTree(root)
+	if err != nil {
+		return nil, err
+	}
+	if len(tree.Notes) == 0 {
+		return nil, fmt.Errorf("%s contains no Markdown notes", tree.Name)
+	}
+
+	report := &DocsImportReport{}
+
+	categorySlug := util.SanitizeSlug(opts.Category)
+	if categorySlug == "" {
+		categorySlug = util.GenerateSlug(tree.Name)
+	}
+	category, err := s.ensureCategory(ctx, CreateCategoryRequest{
+		Slug:  categorySlug,
+		Label: util.HumanizeName(tree.Name),
+	}, opts.DryRun, report)
+	if err != nil {
+		return nil, err
+	}
+
+	subCategories := make(map[string]*Category, len(tree.Sections))
+	for _, section := range tree.Sections {
+		subCategory, err := s.ensureCategory(ctx, CreateCategoryRequest{
+			Slug:     categorySlug + "-" + util.GenerateSlug(section),
+			Label:    util.HumanizeName(section),
+			ParentID: category.ID,
+		}, opts.DryRun, report)
+		if err != nil {
+			return nil, err
+		}
+		subCategories[section] = subCategory
+	}
+
+	var (
+		newPosts []domain.Post
+		newNotes []util.DocNote
+		slugRows []util.SlugRow
+		slugSet  = make(map[string]string)
+		now      = time.Now().UTC()
+	)
+
+	for _, note := range tree.Notes {
+		source, err := s.repo.GetPostSource(ctx, note.Source)
+		if err != nil {
+			return nil, fmt.Errorf("%s: failed to look up import: %w", note.Source, err)
+		}
+
+		var subCategoryID *uint
+		if subCategory, ok := subCategories[note.Section]; ok {
+			id := subCategory.ID
+			subCategoryID = &id
+		}
+		keywords := strings.Join(note.Tags, ", ")
+
+		if source != nil {
+			if source.ContentHash == note.Hash {
+				report.Unchanged = append(report.Unchanged, note.Source)
+				continue
+			}
+			if !opts.DryRun {
+				if _, err := s.UpdatePost(ctx, source.PostID, UpdatePostRequest{
+					Title:         &note.Title,
+					Content:       &note.Content,
+					Keywords:      &keywords,
+					CategoryID:    &category.ID,
+					SubCategoryID: subCategoryID,
+				}, opts.UserID); err != nil {
+					return report, fmt.Errorf("%s: %w", note.Source, err)
+				}
+				source.ContentHash = note.Hash
+				if err := s.repo.SavePostSource(ctx, source); err != nil {
+					return report, fmt.Errorf("%s: failed to record import: %w", note.Source, err)
+				}
+			}
+			report.Updated = append(report.Updated, note.Source)
+			continue
+		}
+
+		// Notes at the root of the tree are named after it, like the sections' notes are after their section
+		prefix := note.Section
+		if prefix == "" {
+			prefix = tree.Name
+		}
+		slug := util.GenerateSlug(prefix + "-" + note.Name)
+		if other, ok := slugSet[slug]; ok {
+			return nil, fmt.Errorf("%s: duplicate slug '%s', also used by %s", note.Source, slug, other)
+		}
+		slugSet[slug] = note.Source
+
+		post := domain.Post{
+			Title:         note.Title,
+			Slug:          slug,
+			Content:       note.Content,
+			Keywords:      keywords,
+			Status:        opts.Status,
+			IsPublic:      true,
+			CategoryID:    category.ID,
+			SubCategoryID: subCategoryID,
+			CreatedBy:     opts.UserID,
+			Version:       1,
+		}
+		if opts.Status == domain.StatusPublished {
+			published := now
+			if note.Date != nil {
+				published = note.Date.UTC()
+			}
+			post.PublishedAt = &published
+		}
+
+		newPosts = append(newPosts, post)
+		newNotes = append(newNotes, note)
+		slugRows = append(slugRows, util.SlugRow{Slug: slug, File: note.Source})
+		report.Created = append(report.Created, note.Source)
+	}
+
+	if opts.DryRun || len(newPosts) == 0 {
+		return report, nil
+	}
+
+	// New notes are created in tree order, which numbers them after the existing posts
+	if err := s.createImportedPosts(ctx, &newPosts, &slugRows, func(txRepo Repository) error {
+		for i, note := range newNotes {
+			if err := txRepo.SavePostSource(ctx, &domain.PostSource{
+				Source:      note.Source,
+				PostID:      newPosts[i].ID,
+				ContentHash: note.Hash,
+			}); err != nil {
+				return fmt.Errorf("%s: failed to record import: %w", note.Source, err)
+			}
+		}
+		return nil
+	}); err != nil {
+		report.Created = nil
+		return report, err
+	}
+
+	return report, nil
+}
+
+// ensureCategory resolves a category by slug and creates it when cortex does not
+// know it. A dry run only reports the category it would create.
+func (s *service) ensureCategory(ctx context.Context, req CreateCategoryRequest, dryRun bool, report *DocsImportReport) (*Category, error) {
+	category, err := s.categories.ResolveCategory(ctx, req.Slug)
+	if err == nil {
+		if category.Status == "rejected" || category.Status == "deleted" {
+			return nil, fmt.Errorf("category '%s' is %s", req.Slug, category.Status)
+		}
+		if category.ParentID != req.ParentID {
+			return nil, fmt.Errorf("category '%s' exists under another parent", req.Slug)
+		}
+		return category, nil
+	}
+	if !errors.Is(err, ErrCategoryNotFound) {
+		return nil, fmt.Errorf("category '%s': %w", req.Slug, err)
+	}
+
+	report.Categories = append(report.Categories, req.Slug)
+	if dryRun {
+		return &Category{Slug: req.Slug, ParentID: req.ParentID}, nil
+	}
+
+	category, err = s.categories.CreateCategory(ctx, req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create category '%s': %w", req.Slug, err)
+	}
+	return category, nil
+}
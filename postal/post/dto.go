@@ -4,42 +4,66 @@ import (
 	"time"
 
 	"postal/domain"
+	"postal/render"
+	"postal/util"
 )
 
 type CreatePostRequest struct {
-	Title           string `json:"title" validate:"required,min=3,max=500"`
-	Slug            string `json:"slug" validate:"required,min=3,max=500"`
-	Summary         string `json:"summary" validate:"max=1000"`
-	Content         string `json:"content" validate:"required"`
-	Thumbnail       string `json:"thumbnail"`
-	CategoryID      uint   `json:"category_id" validate:"required,min=1"`
-	SubCategoryID   *uint  `json:"sub_category_id"`
-	MetaTitle       string `json:"meta_title" validate:"max=500"`
-	MetaDescription string `json:"meta_description" validate:"max=1000"`
-	Keywords        string `json:"keywords"`
-	OGImage         string `json:"og_image"`
-	IsPublic        *bool  `json:"is_public"`
-	IsFeatured      *bool  `json:"is_featured"`
-	IsPinned        *bool  `json:"is_pinned"`
+	Title           string   `json:"title" validate:"required,min=3,max=500"`
+	Slug            string   `json:"slug" validate:"required,min=3,max=500"`
+	Summary         string   `json:"summary" validate:"max=1000"`
+	Content         string   `json:"content" validate:"required"`
+	Thumbnail       string   `json:"thumbnail"`
+	CategoryID      uint     `json:"category_id" validate:"required,min=1"`
+	SubCategoryID   *uint    `json:"sub_category_id"`
+	MetaTitle       string   `json:"meta_title" validate:"max=500"`
+	MetaDescription string   `json:"meta_description" validate:"max=1000"`
+	Keywords        string   `json:"keywords"`
+	Tags            []string `json:"tags" validate:"omitempty,max=20,dive,max=100"`
+	OGImage         string   `json:"og_image"`
+	IsPublic        *bool    `json:"is_public"`
+	IsFeatured      *bool    `json:"is_featured"`
+	IsPinned        *bool    `json:"is_pinned"`
 }
 
 type UpdatePostRequest struct {
-	Title           *string `json:"title" validate:"omitempty,min=3,max=500"`
-	Slug            *string `json:"slug" validate:"omitempty,min=3,max=500"`
-	Summary         *string `json:"summary" validate:"omitempty,max=1000"`
-	Content         *string `json:"content"`
-	Thumbnail       *string `json:"thumbnail"`
-	CategoryID      *uint   `json:"category_id" validate:"omitempty,min=1"`
-	SubCategoryID   *uint   `json:"sub_category_id"`
-	MetaTitle       *string `json:"meta_title" validate:"omitempty,max=500"`
-	MetaDescription *string `json:"meta_description" validate:"omitempty,max=1000"`
-	Keywords        *string `json:"keywords"`
-	OGImage         *string `json:"og_image"`
-	IsPublic        *bool   `json:"is_public"`
-	IsFeatured      *bool   `json:"is_featured"`
-	IsPinned        *bool   `json:"is_pinned"`
+	Title           *string   `json:"title" validate:"omitempty,min=3,max=500"`
+	Slug            *string   `json:"slug" validate:"omitempty,min=3,max=500"`
+	Summary         *string   `json:"summary" validate:"omitempty,max=1000"`
+	Content         *string   `json:"content"`
+	Thumbnail       *string   `json:"thumbnail"`
+	CategoryID      *uint     `json:"category_id" validate:"omitempty,min=1"`
+	SubCategoryID   *uint     `json:"sub_category_id"`
+	MetaTitle       *string   `json:"meta_title" validate:"omitempty,max=500"`
+	MetaDescription *string   `json:"meta_description" validate:"omitempty,max=1000"`
+	Keywords        *string   `json:"keywords"`
+	Tags            *[]string `json:"tags" validate:"omitempty,max=20,dive,max=100"`
+	OGImage         *string   `json:"og_image"`
+	IsPublic        *bool     `json:"is_public"`
+	IsFeatured      *bool     `json:"is_featured"`
+	IsPinned        *bool     `json:"is_pinned"`
+
+	// Version the edit is based on, the update fails with a VersionConflictError
+	// when the post has moved on since
+	Version *int `json:"version" validate:"omitempty,min=1"`
+
+	// IfMatch lists the versions of an If-Match header, the update fails with a
+	// VersionConflictError unless the post is at one of them
+	IfMatch []int `json:"-"`
+}
+
+// SchedulePostRequest sets when a post goes live and, optionally, when it is taken down.
+// PublishAt may be omitted for an already published post to only schedule its unpublishing.
+type SchedulePostRequest struct {
+	PublishAt   *time.Time `json:"publish_at"`
+	UnpublishAt *time.Time `json:"unpublish_at"`
 }
 
+const (
+	TagMatchAny = "any"
+	TagMatchAll = "all"
+)
+
 type PostFilter struct {
 	Status        *domain.PostStatus
 	CategoryID    *uint
@@ -48,38 +72,104 @@ type PostFilter struct {
 	IsPinned      *bool
 	IsPublic      *bool
 	Search        *string
+	Tags          []string // tag slugs
+	TagMatch      string   // TagMatchAny or TagMatchAll
+	AuthorID      *uint    // posts the user is credited on
+	AuthorRole    *domain.AuthorRole
 	Limit         int
 	Offset        int
 	SortBy        string
 	SortOrder     string
 }
 
+// SearchFilter is a full-text search over published posts. Terms are parsed
+// from Query by the service.
+type SearchFilter struct {
+	Query         string
+	Terms         []util.SearchTerm
+	CategoryID    *uint
+	SubCategoryID *uint
+	Limit         int
+	Offset        int
+}
+
+// TagFilter lists tags by name prefix, sorted by post count or name
+type TagFilter struct {
+	Search string
+	SortBy string
+	Limit  int
+	Offset int
+}
+
+// FeedFilter selects the posts of a feed. BaseURL is the site the post links
+// point to and FeedPath the path of the feed without its format extension.
+type FeedFilter struct {
+	BaseURL     string
+	FeedPath    string
+	CategoryID  *uint
+	TagSlug     string
+	FullContent bool
+}
+
+// ViewStatFilter selects daily view counts of the posts in a category.
+// CategoryID matches both the category and the subcategory of a post.
+type ViewStatFilter struct {
+	CategoryID *uint
+	Since      time.Time
+}
+
+// RelatedCandidate is a published post as the related posts worker scores it
+type RelatedCandidate struct {
+	ID            uint
+	CategoryID    uint
+	SubCategoryID *uint
+	TagIDs        []uint
+	Terms         map[string]float64
+}
+
+// ViewIncrement is a number of views of a post on a single day
+type ViewIncrement struct {
+	PostID uint
+	Day    time.Time
+	Count  int64
+}
+
 type PostResponse struct {
-	ID              uint              `json:"id"`
-	UUID            string            `json:"uuid"`
-	Title           string            `json:"title"`
-	Slug            string            `json:"slug"`
-	Summary         string            `json:"summary"`
-	Content         string            `json:"content"`
-	Thumbnail       string            `json:"thumbnail,omitempty"`
-	CategoryID      uint              `json:"category_id"`
-	SubCategoryID   *uint             `json:"sub_category_id,omitempty"`
-	MetaTitle       string            `json:"meta_title,omitempty"`
-	MetaDescription string            `json:"meta_description,omitempty"`
-	Keywords        string            `json:"keywords,omitempty"`
-	OGImage         string            `json:"og_image,omitempty"`
-	Status          domain.PostStatus `json:"status"`
-	IsPublic        bool              `json:"is_public"`
-	IsFeatured      bool              `json:"is_featured"`
-	IsPinned        bool              `json:"is_pinned"`
-	PublishedAt     *time.Time        `json:"published_at,omitempty"`
-	ArchivedAt      *time.Time        `json:"archived_at,omitempty"`
-	CreatedBy       uint              `json:"created_by"`
-	UpdatedBy       uint              `json:"updated_by,omitempty"`
-	ViewCount       int               `json:"view_count"`
-	Version         int               `json:"version"`
-	CreatedAt       time.Time         `json:"created_at"`
-	UpdatedAt       time.Time         `json:"updated_at"`
+	ID              uint                  `json:"id"`
+	UUID            string                `json:"uuid"`
+	Title           string                `json:"title"`
+	Slug            string                `json:"slug"`
+	Summary         string                `json:"summary"`
+	Content         string                `json:"content"`
+	ContentHTML     string                `json:"content_html,omitempty"`
+	TOC             []*render.TOCEntry    `json:"toc,omitempty"`
+	Thumbnail       string                `json:"thumbnail,omitempty"`
+	CategoryID      uint                  `json:"category_id"`
+	SubCategoryID   *uint                 `json:"sub_category_id,omitempty"`
+	MetaTitle       string                `json:"meta_title,omitempty"`
+	MetaDescription string                `json:"meta_description,omitempty"`
+	Keywords        string                `json:"keywords,omitempty"`
+	OGImage         string                `json:"og_image,omitempty"`
+	Tags            []TagResponse         `json:"tags"`
+	Status          domain.PostStatus     `json:"status"`
+	IsPublic        bool                  `json:"is_public"`
+	IsFeatured      bool                  `json:"is_featured"`
+	IsPinned        bool                  `json:"is_pinned"`
+	PublishedAt     *time.Time            `json:"published_at,omitempty"`
+	ArchivedAt      *time.Time            `json:"archived_at,omitempty"`
+	PublishAt       *time.Time            `json:"publish_at,omitempty"`
+	UnpublishAt     *time.Time            `json:"unpublish_at,omitempty"`
+	CreatedBy       uint                  `json:"created_by"`
+	UpdatedBy       uint                  `json:"updated_by,omitempty"`
+	ViewCount       int                   `json:"view_count"`
+	Reactions       map[string]int64      `json:"reactions"`
+	BookmarkCount   int64                 `json:"bookmark_count"`
+	Series          *PostSeriesInfo       `json:"series,omitempty"`
+	Authors         []PostAuthorResponse  `json:"authors,omitempty"`
+	Contributors    []ContributorResponse `json:"contributors,omitempty"`
+	Version         int                   `json:"version"`
+	CreatedAt       time.Time             `json:"created_at"`
+	UpdatedAt       time.Time             `json:"updated_at"`
 }
 
 // PostListItemResponse is a lighter response for list endpoints
@@ -90,6 +180,7 @@ type PostListItemResponse struct {
 	Summary         string            `json:"summary"`
 	MetaDescription string            `json:"meta_description,omitempty"`
 	Keywords        string            `json:"keywords,omitempty"`
+	Tags            []TagResponse     `json:"tags"`
 	CategoryID      uint              `json:"category_id"`
 	Status          domain.PostStatus `json:"status"`
 	SubCategoryID   *uint             `json:"sub_category_id,omitempty"`
@@ -97,10 +188,230 @@ type PostListItemResponse struct {
 	IsPinned        bool              `json:"is_pinned"`
 	CreatedBy       uint              `json:"created_by"`
 	ViewCount       int               `json:"view_count"`
+	Reactions       map[string]int64  `json:"reactions"`
+	BookmarkCount   int64             `json:"bookmark_count"`
 	ContentLength   int               `json:"content_length"` // For read time calculation
 	CreatedAt       time.Time         `json:"created_at"`
 }
 
+// PostSearchResult is a post matching a search, ranked by relevance.
+// TitleHighlight and Snippet are HTML escaped with matches wrapped in <mark>.
+type PostSearchResult struct {
+	ID             uint              `json:"id"`
+	UUID           string            `json:"uuid"`
+	Slug           string            `json:"slug"`
+	Title          string            `json:"title"`
+	Summary        string            `json:"summary"`
+	CategoryID     uint              `json:"category_id"`
+	SubCategoryID  *uint             `json:"sub_category_id,omitempty"`
+	Status         domain.PostStatus `json:"status"`
+	PublishedAt    *time.Time        `json:"published_at,omitempty"`
+	ViewCount      int               `json:"view_count"`
+	CreatedAt      time.Time         `json:"created_at"`
+	Rank           float64           `json:"rank"`
+	TitleHighlight string            `json:"title_highlight"`
+	Snippet        string            `json:"snippet"`
+}
+
+// Category is a cortex category, ParentID is set on sub-categories
+type Category struct {
+	ID       uint   `json:"id"`
+	ParentID uint   `json:"parent_id"`
+	Slug     string `json:"slug"`
+	Label    string `json:"label"`
+	Status   string `json:"status"`
+}
+
+// CreateCategoryRequest creates a cortex category, or a sub-category when ParentID is set
+type CreateCategoryRequest struct {
+	Slug        string
+	Label       string
+	Description string
+	ParentID    uint
+}
+
+// DocsImportOptions controls an import of a docs tree. Status is what new posts
+// get, DryRun reports the changes without making them.
+type DocsImportOptions struct {
+	UserID   uint
+	Category string
+	Status   domain.PostStatus
+	DryRun   bool
+}
+
+// DocsImportReport lists what an import of a docs tree did by source file
+type DocsImportReport struct {
+	Categories []string `json:"categories,omitempty"`
+	Created    []string `json:"created,omitempty"`
+	Updated    []string `json:"updated,omitempty"`
+	Unchanged  []string `json:"unchanged,omitempty"`
+}
+
+// ExportFormat is how exported posts are written into the zip
+type ExportFormat string
+
+const (
+	// ExportMarkdown writes a Markdown file with front matter per post
+	ExportMarkdown ExportFormat = "markdown"
+	// ExportJSONL writes a posts.jsonl with a post per line
+	ExportJSONL ExportFormat = "jsonl"
+	// ExportCSV writes a posts.csv in the layout of the CSV batch upload
+	ExportCSV ExportFormat = "csv"
+)
+
+// ExportOptions selects the format of an export and whether it includes the
+// version history of the posts
+type ExportOptions struct {
+	Format       ExportFormat
+	WithVersions bool
+}
+
+// ImportedPostResponse is a post created from a file of an imported archive
+type ImportedPostResponse struct {
+	File   string            `json:"file"`
+	ID     uint              `json:"id"`
+	UUID   string            `json:"uuid"`
+	Slug   string            `json:"slug"`
+	Status domain.PostStatus `json:"status"`
+}
+
+// ScheduledPostResponse is an upcoming scheduled transition of a post
+type ScheduledPostResponse struct {
+	ID            uint              `json:"id"`
+	UUID          string            `json:"uuid"`
+	Title         string            `json:"title"`
+	Slug          string            `json:"slug"`
+	CategoryID    uint              `json:"category_id"`
+	SubCategoryID *uint             `json:"sub_category_id,omitempty"`
+	Status        domain.PostStatus `json:"status"`
+	PublishAt     *time.Time        `json:"publish_at,omitempty"`
+	UnpublishAt   *time.Time        `json:"unpublish_at,omitempty"`
+	PublishedAt   *time.Time        `json:"published_at,omitempty"`
+	UpdatedBy     uint              `json:"updated_by,omitempty"`
+}
+
+// Engagement is the reaction and bookmark counts of a post
+type Engagement struct {
+	Reactions map[domain.ReactionType]int64
+	Bookmarks int64
+}
+
+// NewEngagement returns zero counts for every reaction type
+func NewEngagement() *Engagement {
+	engagement := &Engagement{Reactions: make(map[domain.ReactionType]int64, len(domain.ReactionTypes))}
+	for _, reaction := range domain.ReactionTypes {
+		engagement.Reactions[reaction] = 0
+	}
+	return engagement
+}
+
+// reactionCounts converts engagement reactions for JSON responses
+func (e *Engagement) reactionCounts() map[string]int64 {
+	counts := make(map[string]int64, len(e.Reactions))
+	for reaction, count := range e.Reactions {
+		counts[string(reaction)] = count
+	}
+	return counts
+}
+
+// EngagementResponse is the engagement of a post together with what the user did
+type EngagementResponse struct {
+	PostID        uint                  `json:"post_id"`
+	Reactions     map[string]int64      `json:"reactions"`
+	BookmarkCount int64                 `json:"bookmark_count"`
+	UserReactions []domain.ReactionType `json:"user_reactions"`
+	Bookmarked    bool                  `json:"bookmarked"`
+}
+
+// TagResponse is a tag, PostCount is only set by tag listings
+type TagResponse struct {
+	ID        uint   `json:"id"`
+	Name      string `json:"name"`
+	Slug      string `json:"slug"`
+	PostCount int64  `json:"post_count,omitempty"`
+}
+
+// CreateSeriesRequest creates a series, PostIDs lists its parts in reading order
+type CreateSeriesRequest struct {
+	Title       string `json:"title" validate:"required,min=3,max=500"`
+	Slug        string `json:"slug" validate:"omitempty,min=3,max=500"`
+	Description string `json:"description" validate:"max=5000"`
+	PostIDs     []uint `json:"post_ids" validate:"max=500,dive,min=1"`
+}
+
+type UpdateSeriesRequest struct {
+	Title       *string `json:"title" validate:"omitempty,min=3,max=500"`
+	Slug        *string `json:"slug" validate:"omitempty,min=3,max=500"`
+	Description *string `json:"description" validate:"omitempty,max=5000"`
+}
+
+// SeriesPostsRequest replaces the parts of a series, their order is the reading order
+type SeriesPostsRequest struct {
+	PostIDs []uint `json:"post_ids" validate:"max=500,dive,min=1"`
+}
+
+// SeriesResponse is a series with its parts in reading order
+type SeriesResponse struct {
+	ID          uint                    `json:"id"`
+	Title       string                  `json:"title"`
+	Slug        string                  `json:"slug"`
+	Description string                  `json:"description,omitempty"`
+	PostCount   int                     `json:"post_count"`
+	Posts       []*PostListItemResponse `json:"posts"`
+	CreatedBy   uint                    `json:"created_by"`
+	UpdatedBy   uint                    `json:"updated_by,omitempty"`
+	CreatedAt   time.Time               `json:"created_at"`
+	UpdatedAt   time.Time               `json:"updated_at"`
+}
+
+// SeriesListItem is a series without its parts, PostCount counts the published ones
+type SeriesListItem struct {
+	ID          uint      `json:"id"`
+	Title       string    `json:"title"`
+	Slug        string    `json:"slug"`
+	Description string    `json:"description,omitempty"`
+	PostCount   int64     `json:"post_count"`
+	CreatedAt   time.Time `json:"created_at"`
+	UpdatedAt   time.Time `json:"updated_at"`
+}
+
+// PostSeriesInfo places a post within its series, Position and Total count
+// the published parts and Previous/Next link the neighbouring ones
+type PostSeriesInfo struct {
+	ID       uint            `json:"id"`
+	Title    string          `json:"title"`
+	Slug     string          `json:"slug"`
+	Position int             `json:"position"`
+	Total    int             `json:"total"`
+	Previous *SeriesPostLink `json:"previous,omitempty"`
+	Next     *SeriesPostLink `json:"next,omitempty"`
+}
+
+type SeriesPostLink struct {
+	ID    uint   `json:"id"`
+	Title string `json:"title"`
+	Slug  string `json:"slug"`
+}
+
+func ToSeriesResponse(series *domain.Series, posts []*domain.Post) *SeriesResponse {
+	response := &SeriesResponse{
+		ID:          series.ID,
+		Title:       series.Title,
+		Slug:        series.Slug,
+		Description: series.Description,
+		PostCount:   len(posts),
+		Posts:       make([]*PostListItemResponse, len(posts)),
+		CreatedBy:   series.CreatedBy,
+		UpdatedBy:   series.UpdatedBy,
+		CreatedAt:   series.CreatedAt,
+		UpdatedAt:   series.UpdatedAt,
+	}
+	for i, post := range posts {
+		response.Posts[i] = ToPostListItemResponse(post)
+	}
+	return response
+}
+
 type BatchDeleteRequest struct {
 	UUIDs []string `json:"uuids" validate:"required,min=1,dive,required,uuid"`
 }
@@ -120,12 +431,15 @@ func ToPostResponse(post *domain.Post) *PostResponse {
 		MetaDescription: post.MetaDescription,
 		Keywords:        post.Keywords,
 		OGImage:         post.OGImage,
+		Tags:            ToTagResponses(post.Tags),
 		Status:          post.Status,
 		IsPublic:        post.IsPublic,
 		IsFeatured:      post.IsFeatured,
 		IsPinned:        post.IsPinned,
 		PublishedAt:     post.PublishedAt,
 		ArchivedAt:      post.ArchivedAt,
+		PublishAt:       post.PublishAt,
+		UnpublishAt:     post.UnpublishAt,
 		CreatedBy:       post.CreatedBy,
 		UpdatedBy:       post.UpdatedBy,
 		ViewCount:       post.ViewCount,
@@ -143,6 +457,7 @@ func ToPostListItemResponse(post *domain.Post) *PostListItemResponse {
 		Summary:         post.Summary,
 		MetaDescription: post.MetaDescription,
 		Keywords:        post.Keywords,
+		Tags:            ToTagResponses(post.Tags),
 		CategoryID:      post.CategoryID,
 		Status:          post.Status,
 		SubCategoryID:   post.SubCategoryID,
@@ -154,3 +469,108 @@ func ToPostListItemResponse(post *domain.Post) *PostListItemResponse {
 		CreatedAt:       post.CreatedAt,
 	}
 }
+
+func ToTagResponses(tags []domain.Tag) []TagResponse {
+	responses := make([]TagResponse, len(tags))
+	for i, tag := range tags {
+		responses[i] = TagResponse{
+			ID:   tag.ID,
+			Name: tag.Name,
+			Slug: tag.Slug,
+		}
+	}
+	return responses
+}
+
+func ToScheduledPostResponse(post *domain.Post) *ScheduledPostResponse {
+	return &ScheduledPostResponse{
+		ID:            post.ID,
+		UUID:          post.UUID,
+		Title:         post.Title,
+		Slug:          post.Slug,
+		CategoryID:    post.CategoryID,
+		SubCategoryID: post.SubCategoryID,
+		Status:        post.Status,
+		PublishAt:     post.PublishAt,
+		UnpublishAt:   post.UnpublishAt,
+		PublishedAt:   post.PublishedAt,
+		UpdatedBy:     post.UpdatedBy,
+	}
+}
+
+// PostVersionListItem is a version without its content, for version listings
+type PostVersionListItem struct {
+	ID         uint      `json:"id"`
+	PostID     uint      `json:"post_id"`
+	VersionNo  int       `json:"version_no"`
+	Title      string    `json:"title"`
+	EditedBy   uint      `json:"edited_by"`
+	ChangeNote string    `json:"change_note"`
+	CreatedAt  time.Time `json:"created_at"`
+}
+
+// FieldChange is the before and after value of a field that differs between two versions
+type FieldChange struct {
+	From any `json:"from"`
+	To   any `json:"to"`
+}
+
+// PostVersionDiff compares two versions of a post. Title, summary and content are
+// diffed line or word wise, every other changed field is listed in Fields.
+type PostVersionDiff struct {
+	PostID  uint                   `json:"post_id"`
+	From    int                    `json:"from"`
+	To      int                    `json:"to"`
+	Mode    string                 `json:"mode"`
+	Title   []util.DiffOp          `json:"title"`
+	Summary []util.DiffOp          `json:"summary"`
+	Content []util.DiffOp          `json:"content"`
+	Fields  map[string]FieldChange `json:"fields,omitempty"`
+}
+
+func ToPostVersionListItem(version *domain.PostVersion) *PostVersionListItem {
+	return &PostVersionListItem{
+		ID:         version.ID,
+		PostID:     version.PostID,
+		VersionNo:  version.VersionNo,
+		Title:      version.Title,
+		EditedBy:   version.EditedBy,
+		ChangeNote: version.ChangeNote,
+		CreatedAt:  version.CreatedAt,
+	}
+}
+
+// Actor is the user acting on a post. Staff (admins and site editors) may edit
+// every post, other users only the posts they are credited on as author or editor.
+type Actor struct {
+	UserID  uint
+	IsStaff bool
+}
+
+// SetPostAuthorRequest credits a user on a post or changes their role
+type SetPostAuthorRequest struct {
+	Role domain.AuthorRole `json:"role" validate:"required,oneof=author editor reviewer"`
+}
+
+type PostAuthorResponse struct {
+	UserID    uint              `json:"user_id"`
+	Role      domain.AuthorRole `json:"role"`
+	AddedBy   uint              `json:"added_by,omitempty"`
+	CreatedAt time.Time         `json:"created_at"`
+}
+
+// ContributorResponse credits a user who saved versions of a post, Role is set
+// when the user is also one of its authors
+type ContributorResponse struct {
+	UserID      uint              `json:"user_id"`
+	Role        domain.AuthorRole `json:"role,omitempty"`
+	Edits       int               `json:"edits"`
+	FirstEditAt time.Time         `json:"first_edit_at"`
+	LastEditAt  time.Time         `json:"last_edit_at"`
+}
+
+// PostCreditsResponse lists the credited authors of a post and everyone who edited it
+type PostCreditsResponse struct {
+	Authors      []PostAuthorResponse  `json:"authors"`
+	Contributors []ContributorResponse `json:"contributors"`
+}
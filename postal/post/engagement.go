@@ -0,0 +1,253 @@
+package post
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"strconv"
+	"time"
+
+	"postal/domain"
+)
+
+// engagementTTL bounds how long Redis counters can drift from the database,
+// an expired hash is rebuilt from the reaction and bookmark tables
+const engagementTTL = 24 * time.Hour
+
+const bookmarksField = "bookmarks"
+
+var ErrInvalidReaction = errors.New("invalid reaction type")
+
+func engagementKey(postID uint) string {
+	return fmt.Sprintf("post:engagement:%d", postID)
+}
+
+// ReactToPost adds a reaction of the user, reacting twice has no further effect
+func (s *service) ReactToPost(ctx context.Context, postID, userID uint, reaction domain.ReactionType) (*EngagementResponse, error) {
+	if !domain.IsValidReaction(reaction) {
+		return nil, ErrInvalidReaction
+	}
+	if err := s.requirePublishedPost(ctx, postID); err != nil {
+		return nil, err
+	}
+
+	added, err := s.repo.AddReaction(ctx, &domain.PostReaction{PostID: postID, UserID: userID, Type: reaction})
+	if err != nil {
+		return nil, fmt.Errorf("failed to add reaction: %w", err)
+	}
+	if added {
+		s.incrementEngagement(ctx, postID, string(reaction), 1)
+	}
+
+	return s.GetEngagement(ctx, postID, userID)
+}
+
+// UnreactToPost removes a reaction of the user, removing a missing reaction has no effect
+func (s *service) UnreactToPost(ctx context.Context, postID, userID uint, reaction domain.ReactionType) (*EngagementResponse, error) {
+	if !domain.IsValidReaction(reaction) {
+		return nil, ErrInvalidReaction
+	}
+
+	removed, err := s.repo.RemoveReaction(ctx, postID, userID, reaction)
+	if err != nil {
+		return nil, fmt.Errorf("failed to remove reaction: %w", err)
+	}
+	if removed {
+		s.incrementEngagement(ctx, postID, string(reaction), -1)
+	}
+
+	return s.GetEngagement(ctx, postID, userID)
+}
+
+// BookmarkPost saves a post for the user, bookmarking twice has no further effect
+func (s *service) BookmarkPost(ctx context.Context, postID, userID uint) (*EngagementResponse, error) {
+	if err := s.requirePublishedPost(ctx, postID); err != nil {
+		return nil, err
+	}
+
+	added, err := s.repo.AddBookmark(ctx, &domain.PostBookmark{PostID: postID, UserID: userID})
+	if err != nil {
+		return nil, fmt.Errorf("failed to add bookmark: %w", err)
+	}
+	if added {
+		s.incrementEngagement(ctx, postID, bookmarksField, 1)
+	}
+
+	return s.GetEngagement(ctx, postID, userID)
+}
+
+// UnbookmarkPost removes a saved post, removing a missing bookmark has no effect
+func (s *service) UnbookmarkPost(ctx context.Context, postID, userID uint) (*EngagementResponse, error) {
+	removed, err := s.repo.RemoveBookmark(ctx, postID, userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to remove bookmark: %w", err)
+	}
+	if removed {
+		s.incrementEngagement(ctx, postID, bookmarksField, -1)
+	}
+
+	return s.GetEngagement(ctx, postID, userID)
+}
+
+// GetEngagement returns the counts of a post and, for a signed in user, their reactions and bookmark
+func (s *service) GetEngagement(ctx context.Context, postID, userID uint) (*EngagementResponse, error) {
+	engagement := s.loadEngagement(ctx, []uint{postID})[postID]
+
+	response := &EngagementResponse{
+		PostID:        postID,
+		Reactions:     engagement.reactionCounts(),
+		BookmarkCount: engagement.Bookmarks,
+		UserReactions: []domain.ReactionType{},
+	}
+	if userID == 0 {
+		return response, nil
+	}
+
+	reactions, err := s.repo.ListUserReactions(ctx, postID, userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load user reactions: %w", err)
+	}
+	if reactions != nil {
+		response.UserReactions = reactions
+	}
+
+	response.Bookmarked, err = s.repo.IsBookmarked(ctx, postID, userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load bookmark: %w", err)
+	}
+
+	return response, nil
+}
+
+// ListBookmarks returns the published posts a user bookmarked, latest first
+func (s *service) ListBookmarks(ctx context.Context, userID uint, limit, offset int) ([]*PostListItemResponse, int64, error) {
+	posts, total, err := s.repo.ListBookmarkedPosts(ctx, userID, limit, offset)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to list bookmarks: %w", err)
+	}
+
+	responses := make([]*PostListItemResponse, len(posts))
+	for i, post := range posts {
+		responses[i] = ToPostListItemResponse(post)
+	}
+	s.attachListEngagement(ctx, responses)
+
+	return responses, total, nil
+}
+
+func (s *service) requirePublishedPost(ctx context.Context, postID uint) error {
+	post, err := s.repo.GetByID(ctx, postID)
+	if err != nil {
+		return err
+	}
+	if post.Status != domain.StatusPublished {
+		return ErrPostNotFound
+	}
+	return nil
+}
+
+// incrementEngagement keeps the Redis counters in step with a database write
+func (s *service) incrementEngagement(ctx context.Context, postID uint, field string, incr int64) {
+	if s.cache == nil {
+		return
+	}
+	if err := s.cache.HIncrByIfExists(ctx, engagementKey(postID), field, incr); err != nil {
+		log.Printf("Failed to update engagement counter (post_id=%d): %v", postID, err)
+	}
+}
+
+// loadEngagement reads the counters of the posts from Redis, seeding the ones
+// that are missing from the database. Every post gets an entry.
+func (s *service) loadEngagement(ctx context.Context, postIDs []uint) map[uint]*Engagement {
+	result := make(map[uint]*Engagement, len(postIDs))
+	missing := postIDs
+
+	if s.cache != nil && len(postIDs) > 0 {
+		keys := make([]string, len(postIDs))
+		for i, id := range postIDs {
+			keys[i] = engagementKey(id)
+		}
+
+		hashes, err := s.cache.HGetAll(ctx, keys...)
+		if err != nil {
+			log.Printf("Failed to load engagement counters: %v", err)
+		} else {
+			missing = nil
+			for i, id := range postIDs {
+				if hashes[i] == nil {
+					missing = append(missing, id)
+					continue
+				}
+				result[id] = parseEngagement(hashes[i])
+			}
+		}
+	}
+
+	if len(missing) > 0 {
+		counts, err := s.repo.CountEngagement(ctx, missing)
+		if err != nil {
+			log.Printf("Failed to count engagement: %v", err)
+			counts = map[uint]*Engagement{}
+		}
+		for _, id := range missing {
+			engagement, ok := counts[id]
+			if !ok {
+				engagement = NewEngagement()
+			}
+			result[id] = engagement
+			if err == nil {
+				s.seedEngagement(ctx, id, engagement)
+			}
+		}
+	}
+
+	return result
+}
+
+func (s *service) seedEngagement(ctx context.Context, postID uint, engagement *Engagement) {
+	if s.cache == nil {
+		return
+	}
+
+	fields := map[string]any{bookmarksField: engagement.Bookmarks}
+	for reaction, count := range engagement.Reactions {
+		fields[string(reaction)] = count
+	}
+	// Another request may have seeded and incremented the hash since the counts
+	// were read, it is left as is rather than reset
+	if _, err := s.cache.HSetIfNotExists(ctx, engagementKey(postID), fields, engagementTTL); err != nil {
+		log.Printf("Failed to seed engagement counters (post_id=%d): %v", postID, err)
+	}
+}
+
+func parseEngagement(fields map[string]string) *Engagement {
+	engagement := NewEngagement()
+	for _, reaction := range domain.ReactionTypes {
+		engagement.Reactions[reaction], _ = strconv.ParseInt(fields[string(reaction)], 10, 64)
+	}
+	engagement.Bookmarks, _ = strconv.ParseInt(fields[bookmarksField], 10, 64)
+	return engagement
+}
+
+// attachEngagement fills the reaction and bookmark counts of a post
+func (s *service) attachEngagement(ctx context.Context, post *PostResponse) {
+	engagement := s.loadEngagement(ctx, []uint{post.ID})[post.ID]
+	post.Reactions = engagement.reactionCounts()
+	post.BookmarkCount = engagement.Bookmarks
+}
+
+// attachListEngagement fills the counts of list items, they are not part of the
+// cached list so they stay current
+func (s *service) attachListEngagement(ctx context.Context, posts []*PostListItemResponse) {
+	ids := make([]uint, len(posts))
+	for i, post := range posts {
+		ids[i] = post.ID
+	}
+
+	engagement := s.loadEngagement(ctx, ids)
+	for _, post := range posts {
+		post.Reactions = engagement[post.ID].reactionCounts()
+		post.BookmarkCount = engagement[post.ID].Bookmarks
+	}
+}
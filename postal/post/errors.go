@@ -0,0 +1,9 @@
+package post
+
+import "errors"
+
+var (
+	ErrPostNotFound    = errors.New("post not found")
+	ErrVersionNotFound = errors.New("version not found")
+	ErrTagNotFound     = errors.New("tag not found")
+)
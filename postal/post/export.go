@@ -0,0 +1,205 @@
+package post
+
+import (
+	"archive/zip"
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+
+	"postal/domain"
+	"postal/util"
+)
+
+// exportBatchSize is the number of posts read from the database at a time, an
+// export never holds more of them in memory
+const exportBatchSize = 100
+
+// ErrInvalidExportFormat is returned for a format other than markdown, jsonl or csv
+var ErrInvalidExportFormat = errors.New("export format must be markdown, jsonl or csv")
+
+// IsValidExportFormat reports whether posts can be exported in the format
+func IsValidExportFormat(format ExportFormat) bool {
+	switch format {
+	case ExportMarkdown, ExportJSONL, ExportCSV:
+		return true
+	}
+	return false
+}
+
+// exportedPost is a line of posts.jsonl, the versions are only set when asked for
+type exportedPost struct {
+	*domain.Post
+	Versions []*domain.PostVersion `json:"versions,omitempty"`
+}
+
+// ExportPosts writes the posts matching the filter as a zip to w, oldest first.
+// Posts are read in batches and written as they are read, so the size of an
+// export is not bound by memory. The filter's paging and sorting are ignored.
+//
+// Markdown exports have a posts/<slug>.md per post and versions/<slug>/<n>.md
+// per version, JSON lines a posts.jsonl with the versions inlined, and CSV a
+// posts.csv the batch upload accepts plus a versions.csv.
+func (s *service) ExportPosts(ctx context.Context, w io.Writer, filter PostFilter, opts ExportOptions) error {
+	if !IsValidExportFormat(opts.Format) {
+		return ErrInvalidExportFormat
+	}
+
+	archive := zip.NewWriter(w)
+
+	var err error
+	switch opts.Format {
+	case ExportMarkdown:
+		err = s.exportMarkdown(ctx, archive, filter, opts.WithVersions)
+	case ExportJSONL:
+		err = s.exportJSONL(ctx, archive, filter, opts.WithVersions)
+	case ExportCSV:
+		err = s.exportCSV(ctx, archive, filter, opts.WithVersions)
+	}
+	if err != nil {
+		return err
+	}
+
+	return archive.Close()
+}
+
+func (s *service) exportMarkdown(ctx context.Context, archive *zip.Writer, filter PostFilter, withVersions bool) error {
+	return s.eachExportedPost(ctx, filter, func(post *domain.Post) error {
+		data, err := util.MarkdownFromPost(post)
+		if err != nil {
+			return fmt.Errorf("failed to write post '%s': %w", post.Slug, err)
+		}
+		if err := writeZipFile(archive, "posts/"+post.Slug+".md", post, data); err != nil {
+			return err
+		}
+		if !withVersions {
+			return nil
+		}
+
+		versions, err := s.versionRepo.GetByPostID(ctx, post.ID)
+		if err != nil {
+			return fmt.Errorf("failed to load versions of post '%s': %w", post.Slug, err)
+		}
+		for _, version := range versions {
+			data, err := util.MarkdownFromPostVersion(version)
+			if err != nil {
+				return fmt.Errorf("failed to write version %d of post '%s': %w", version.VersionNo, post.Slug, err)
+			}
+			name := fmt.Sprintf("versions/%s/%d.md", post.Slug, version.VersionNo)
+			if err := writeZipFile(archive, name, post, data); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+func (s *service) exportJSONL(ctx context.Context, archive *zip.Writer, filter PostFilter, withVersions bool) error {
+	file, err := archive.Create("posts.jsonl")
+	if err != nil {
+		return err
+	}
+	encoder := json.NewEncoder(file)
+
+	return s.eachExportedPost(ctx, filter, func(post *domain.Post) error {
+		line := exportedPost{Post: post}
+		if withVersions {
+			versions, err := s.versionRepo.GetByPostID(ctx, post.ID)
+			if err != nil {
+				return fmt.Errorf("failed to load versions of post '%s': %w", post.Slug, err)
+			}
+			line.Versions = versions
+		}
+		return encoder.Encode(line)
+	})
+}
+
+func (s *service) exportCSV(ctx context.Context, archive *zip.Writer, filter PostFilter, withVersions bool) error {
+	file, err := archive.Create("posts.csv")
+	if err != nil {
+		return err
+	}
+	writer := csv.NewWriter(file)
+	if err := writer.Write(util.PostCSVHeader); err != nil {
+		return err
+	}
+	if err := s.eachExportedPost(ctx, filter, func(post *domain.Post) error {
+		return writer.Write(util.PostCSVRecord(post))
+	}); err != nil {
+		return err
+	}
+	writer.Flush()
+	if err := writer.Error(); err != nil {
+		return err
+	}
+	if !withVersions {
+		return nil
+	}
+
+	// A zip is written one file at a time, so the versions take a second pass
+	file, err = archive.Create("versions.csv")
+	if err != nil {
+		return err
+	}
+	writer = csv.NewWriter(file)
+	if err := writer.Write(util.PostVersionCSVHeader); err != nil {
+		return err
+	}
+	if err := s.eachExportedPost(ctx, filter, func(post *domain.Post) error {
+		versions, err := s.versionRepo.GetByPostID(ctx, post.ID)
+		if err != nil {
+			return fmt.Errorf("failed to load versions of post '%s': %w", post.Slug, err)
+		}
+		for _, version := range versions {
+			if err := writer.Write(util.PostVersionCSVRecord(post.Slug, version)); err != nil {
+				return err
+			}
+		}
+		return nil
+	}); err != nil {
+		return err
+	}
+	writer.Flush()
+	return writer.Error()
+}
+
+// eachExportedPost calls fn for every post matching the filter, a batch at a time.
+// Batches continue after the last ID read, so each costs the same however deep
+// into the table it is and posts created meanwhile don't shift the pages.
+func (s *service) eachExportedPost(ctx context.Context, filter PostFilter, fn func(post *domain.Post) error) error {
+	var lastID uint
+	for {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+
+		posts, err := s.repo.ListAfter(ctx, filter, lastID, exportBatchSize)
+		if err != nil {
+			return fmt.Errorf("failed to load posts: %w", err)
+		}
+		for _, post := range posts {
+			if err := fn(post); err != nil {
+				return err
+			}
+			lastID = post.ID
+		}
+		if len(posts) < exportBatchSize {
+			return nil
+		}
+	}
+}
+
+func writeZipFile(archive *zip.Writer, name string, post *domain.Post, data []byte) error {
+	file, err := archive.CreateHeader(&zip.FileHeader{
+		Name:     name,
+		Method:   zip.Deflate,
+		Modified: post.UpdatedAt,
+	})
+	if err != nil {
+		return err
+	}
+	_, err = file.Write(data)
+	return err
+}
@@ -0,0 +1,162 @@
+package post
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"log"
+	"strconv"
+	"strings"
+	"time"
+
+	"postal/feed"
+)
+
+// feedItemLimit is the number of latest posts a feed carries
+const feedItemLimit = 50
+
+// feedModifiedTTL bounds how long the change stamp of an unchanged feed is kept
+const feedModifiedTTL = 30 * 24 * time.Hour
+
+// GetFeed builds the feed of the latest published posts, optionally of a category or tag.
+// Feeds are cached under the list prefix so every post write invalidates them.
+func (s *service) GetFeed(ctx context.Context, filter FeedFilter) (*feed.Feed, error) {
+	baseURL := strings.TrimSuffix(filter.BaseURL, "/")
+
+	categoryID := uint(0)
+	if filter.CategoryID != nil {
+		categoryID = *filter.CategoryID
+	}
+	cacheKey := fmt.Sprintf("post:list:feed:base:%s:category:%d:tag:%s:full:%t",
+		baseURL, categoryID, filter.TagSlug, filter.FullContent)
+
+	if s.cache != nil {
+		cached, err := s.cache.Get(ctx, cacheKey)
+		if err == nil && cached != "" {
+			var result feed.Feed
+			if err := json.Unmarshal([]byte(cached), &result); err == nil {
+				return &result, nil
+			}
+		}
+	}
+
+	result := &feed.Feed{
+		ID:          baseURL + filter.FeedPath,
+		Title:       "Latest posts",
+		Description: "The latest published posts",
+		Link:        baseURL + "/",
+		Items:       []*feed.Item{},
+	}
+	switch {
+	case filter.TagSlug != "":
+		tag, err := s.GetTagBySlug(ctx, filter.TagSlug)
+		if err != nil {
+			return nil, err
+		}
+		filter.TagSlug = tag.Slug
+		result.Title = "Posts tagged " + tag.Name
+		result.Description = fmt.Sprintf("The latest published posts tagged %s", tag.Name)
+		result.Link = baseURL + "/tags/" + tag.Slug
+	case filter.CategoryID != nil:
+		// Without cortex the category cannot be checked, its feed is named by ID
+		name := fmt.Sprintf("category %d", categoryID)
+		if s.categories != nil {
+			category, err := s.categories.GetCategory(ctx, categoryID)
+			if err != nil {
+				return nil, err
+			}
+			name = category.Label
+		}
+		result.Title = "Posts in " + name
+		result.Description = "The latest published posts in " + name
+		result.Link = fmt.Sprintf("%s/categories/%d", baseURL, categoryID)
+	}
+
+	posts, err := s.repo.ListFeedPosts(ctx, filter, feedItemLimit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list feed posts: %w", err)
+	}
+
+	for _, post := range posts {
+		item := &feed.Item{
+			ID:        "urn:uuid:" + post.UUID,
+			Title:     post.Title,
+			Link:      baseURL + "/posts/" + post.Slug,
+			Summary:   post.Summary,
+			Image:     post.Thumbnail,
+			Published: post.CreatedAt,
+			Updated:   post.UpdatedAt,
+		}
+		if post.PublishedAt != nil {
+			item.Published = *post.PublishedAt
+		}
+		if item.Summary == "" {
+			item.Summary = post.MetaDescription
+		}
+		for _, tag := range post.Tags {
+			item.Categories = append(item.Categories, tag.Name)
+		}
+		if filter.FullContent {
+			response := ToPostResponse(post)
+			s.renderContent(ctx, response)
+			item.ContentHTML = response.ContentHTML
+		}
+
+		if item.Updated.After(result.Updated) {
+			result.Updated = item.Updated
+		}
+		result.Items = append(result.Items, item)
+	}
+	result.Updated = s.feedModifiedAt(ctx, cacheKey, result)
+
+	if s.cache != nil {
+		data, err := json.Marshal(result)
+		if err != nil {
+			log.Printf("Failed to marshal feed for cache: %v", err)
+		} else if err := s.cache.Set(ctx, cacheKey, data, time.Hour); err != nil {
+			log.Printf("Failed to cache feed: %v", err)
+		}
+	}
+
+	return result, nil
+}
+
+// feedModifiedAt returns when the feed last changed, served as Last-Modified. The
+// newest item date goes backwards when that post is unpublished, so a changed feed
+// is stamped with the current time instead, and the stamp is kept in the cache
+// outside the list prefix until the feed changes again.
+func (s *service) feedModifiedAt(ctx context.Context, cacheKey string, result *feed.Feed) time.Time {
+	fallback := result.Updated
+	if fallback.IsZero() {
+		fallback = time.Unix(0, 0).UTC()
+	}
+	if s.cache == nil {
+		return fallback
+	}
+
+	data, err := json.Marshal(result)
+	if err != nil {
+		return fallback
+	}
+	sum := sha256.Sum256(data)
+	fingerprint := hex.EncodeToString(sum[:16])
+
+	key := "post:feed-modified:" + strings.TrimPrefix(cacheKey, "post:list:feed:")
+	if stored, err := s.cache.Get(ctx, key); err == nil {
+		hash, unix, _ := strings.Cut(stored, ":")
+		if seconds, err := strconv.ParseInt(unix, 10, 64); err == nil && hash == fingerprint {
+			return time.Unix(seconds, 0).UTC()
+		}
+	}
+
+	modified := time.Now().UTC().Truncate(time.Second)
+	if fallback.After(modified) {
+		modified = fallback
+	}
+	if err := s.cache.Set(ctx, key, fingerprint+":"+strconv.FormatInt(modified.Unix(), 10), feedModifiedTTL); err != nil {
+		log.Printf("Failed to store feed modification time: %v", err)
+	}
+	return modified
+}
@@ -0,0 +1,90 @@
+package post
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+
+	"postal/domain"
+	"postal/util"
+)
+
+// ErrInvalidImport wraps the import errors caused by the uploaded files rather
+// than by the server
+var ErrInvalidImport = errors.New("invalid import")
+
+// ImportMarkdownPosts creates a post from every Markdown file of a zip or tar
+// archive. Category slugs of the front matter are resolved with cortex, and
+// like the CSV import either every file becomes a post or none does.
+func (s *service) ImportMarkdownPosts(ctx context.Context, userID uint, file io.ReaderAt, size int64) ([]*ImportedPostResponse, error) {
+	if s.categories == nil {
+		return nil, fmt.Errorf("category lookup is not configured, set CORTEX_URL")
+	}
+
+	files, slugRows, err := util.ParseMarkdownArchive(file, size, userID)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrInvalidImport, err)
+	}
+
+	resolved := make(map[string]*Category)
+	resolve := func(name, slug string) (*Category, error) {
+		if category, ok := resolved[slug]; ok {
+			return category, nil
+		}
+		category, err := s.categories.ResolveCategory(ctx, slug)
+		if err != nil {
+			if errors.Is(err, ErrCategoryNotFound) {
+				return nil, fmt.Errorf("%w: %s: category '%s' not found", ErrInvalidImport, name, slug)
+			}
+			return nil, fmt.Errorf("%s: %w", name, err)
+		}
+		if category.Status == "rejected" || category.Status == "deleted" {
+			return nil, fmt.Errorf("%w: %s: category '%s' is %s", ErrInvalidImport, name, slug, category.Status)
+		}
+		resolved[slug] = category
+		return category, nil
+	}
+
+	posts := make([]domain.Post, len(*files))
+	for i, f := range *files {
+		category, err := resolve(f.File, f.CategorySlug)
+		if err != nil {
+			return nil, err
+		}
+		if category.ParentID != 0 {
+			return nil, fmt.Errorf("%w: %s: '%s' is a sub-category, set it as sub_category", ErrInvalidImport, f.File, f.CategorySlug)
+		}
+		f.Post.CategoryID = category.ID
+
+		if f.SubCategorySlug != "" {
+			subCategory, err := resolve(f.File, f.SubCategorySlug)
+			if err != nil {
+				return nil, err
+			}
+			if subCategory.ParentID != category.ID {
+				return nil, fmt.Errorf("%w: %s: '%s' is not a sub-category of '%s'", ErrInvalidImport, f.File, f.SubCategorySlug, f.CategorySlug)
+			}
+			subCategoryID := subCategory.ID
+			f.Post.SubCategoryID = &subCategoryID
+		}
+
+		posts[i] = f.Post
+	}
+
+	if err := s.createImportedPosts(ctx, &posts, slugRows, nil); err != nil {
+		return nil, err
+	}
+
+	imported := make([]*ImportedPostResponse, len(posts))
+	for i := range posts {
+		imported[i] = &ImportedPostResponse{
+			File:   (*files)[i].File,
+			ID:     posts[i].ID,
+			UUID:   posts[i].UUID,
+			Slug:   posts[i].Slug,
+			Status: posts[i].Status,
+		}
+	}
+	return imported, nil
+}
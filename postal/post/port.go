@@ -2,9 +2,12 @@ package post
 
 import (
 	"context"
-	"mime/multipart"
+	"io"
+	"time"
 
 	"postal/domain"
+	"postal/feed"
+	"postal/util"
 )
 
 // Service defines the business logic interface for posts
@@ -21,8 +24,58 @@ type Service interface {
 	RestorePost(ctx context.Context, id uint, userID uint) error
 	DeletePost(ctx context.Context, id uint) error
 	HardDeletePost(ctx context.Context, id uint) error
-	BatchUploadPosts(ctx context.Context, userID uint, file *multipart.File) error
+	ImportPosts(ctx context.Context, posts *[]domain.Post, slugRows *[]util.SlugRow) error
+	ExistingSlugs(ctx context.Context, slugs []string) (map[string]bool, error)
+	ImportMarkdownPosts(ctx context.Context, userID uint, file io.ReaderAt, size int64) ([]*ImportedPostResponse, error)
+	ImportDocs(ctx context.Context, root string, opts DocsImportOptions) (*DocsImportReport, error)
+	ExportPosts(ctx context.Context, w io.Writer, filter PostFilter, opts ExportOptions) error
 	BatchDeletePosts(ctx context.Context, uuids *[]string) error
+	ListVersions(ctx context.Context, postID uint) ([]*PostVersionListItem, error)
+	GetVersion(ctx context.Context, postID uint, versionNo int) (*domain.PostVersion, error)
+	DiffVersions(ctx context.Context, postID uint, from, to int, mode string) (*PostVersionDiff, error)
+	RestoreVersion(ctx context.Context, postID uint, versionNo int, userID uint) (*PostResponse, error)
+	RecordView(ctx context.Context, post *PostResponse, visitor string) error
+	FlushViews(ctx context.Context) (int64, error)
+	ListViewStats(ctx context.Context, filter ViewStatFilter) ([]*domain.PostViewStat, error)
+	SchedulePost(ctx context.Context, id uint, req SchedulePostRequest, userID uint) (*PostResponse, error)
+	CancelSchedule(ctx context.Context, id uint, userID uint) error
+	ListScheduledPosts(ctx context.Context, limit, offset int) ([]*ScheduledPostResponse, int64, error)
+	RunScheduledTransitions(ctx context.Context, now time.Time) (int, error)
+	SearchPosts(ctx context.Context, filter SearchFilter) ([]*PostSearchResult, int64, error)
+	ListTags(ctx context.Context, filter TagFilter) ([]*TagResponse, int64, error)
+	GetTagBySlug(ctx context.Context, slug string) (*TagResponse, error)
+	GetFeed(ctx context.Context, filter FeedFilter) (*feed.Feed, error)
+	GetRelatedPosts(ctx context.Context, postID uint, limit int) ([]*PostListItemResponse, error)
+	RecomputeRelatedPosts(ctx context.Context) (int, error)
+	CreateSeries(ctx context.Context, req CreateSeriesRequest, actor Actor) (*SeriesResponse, error)
+	UpdateSeries(ctx context.Context, id uint, req UpdateSeriesRequest, actor Actor) (*SeriesResponse, error)
+	SetSeriesPosts(ctx context.Context, id uint, req SeriesPostsRequest, actor Actor) (*SeriesResponse, error)
+	DeleteSeries(ctx context.Context, id uint, actor Actor) error
+	GetSeriesBySlug(ctx context.Context, slug string) (*SeriesResponse, error)
+	ListSeries(ctx context.Context, limit, offset int) ([]*SeriesListItem, int64, error)
+	ReactToPost(ctx context.Context, postID, userID uint, reaction domain.ReactionType) (*EngagementResponse, error)
+	UnreactToPost(ctx context.Context, postID, userID uint, reaction domain.ReactionType) (*EngagementResponse, error)
+	BookmarkPost(ctx context.Context, postID, userID uint) (*EngagementResponse, error)
+	UnbookmarkPost(ctx context.Context, postID, userID uint) (*EngagementResponse, error)
+	GetEngagement(ctx context.Context, postID, userID uint) (*EngagementResponse, error)
+	ListBookmarks(ctx context.Context, userID uint, limit, offset int) ([]*PostListItemResponse, int64, error)
+	AuthorizePostEdit(ctx context.Context, postID uint, actor Actor) error
+	GetPostCredits(ctx context.Context, postID uint) (*PostCreditsResponse, error)
+	SetPostAuthor(ctx context.Context, postID, userID uint, req SetPostAuthorRequest, actor Actor) (*PostCreditsResponse, error)
+	RemovePostAuthor(ctx context.Context, postID, userID uint, actor Actor) (*PostCreditsResponse, error)
+}
+
+// CategoryStore looks up cortex categories by slug for imports, and creates
+// the ones an imported docs tree needs
+type CategoryStore interface {
+	ResolveCategory(ctx context.Context, slug string) (*Category, error)
+	GetCategory(ctx context.Context, id uint) (*Category, error)
+	CreateCategory(ctx context.Context, req CreateCategoryRequest) (*Category, error)
+}
+
+// AssetLinker records which media library assets a saved post references
+type AssetLinker interface {
+	SyncPostAssets(ctx context.Context, postID uint, refs ...string) error
 }
 
 // Repository defines the interface for post persistence
@@ -32,7 +85,9 @@ type Repository interface {
 	GetByUUID(ctx context.Context, uuid string) (*domain.Post, error)
 	GetBySlug(ctx context.Context, slug string) (*domain.Post, error)
 	List(ctx context.Context, filter PostFilter, withContent bool) ([]*domain.Post, int64, error)
-	Update(ctx context.Context, post *domain.Post) error
+	ListAfter(ctx context.Context, filter PostFilter, afterID uint, limit int) ([]*domain.Post, error)
+	Update(ctx context.Context, post *domain.Post, columns ...string) error
+	UpdateIfVersion(ctx context.Context, post *domain.Post, version int, columns ...string) (bool, error)
 	Delete(ctx context.Context, id uint) error
 	HardDelete(ctx context.Context, id uint) error
 	BatchDeleteByUUIDs(ctx context.Context, uuids []string) error
@@ -40,5 +95,48 @@ type Repository interface {
 	BatchCreate(ctx context.Context, posts *[]domain.Post) error
 	FindExistingSlugs(ctx context.Context, slugs []string) (map[string]bool, error)
 	GetMaxOrderNo(ctx context.Context) (uint, error)
+	AddSlugHistory(ctx context.Context, history *domain.PostSlugHistory) error
+	FindSlugHistory(ctx context.Context, slug string) (*domain.PostSlugHistory, error)
+	AddViews(ctx context.Context, increments []ViewIncrement) error
+	ListViewStats(ctx context.Context, filter ViewStatFilter) ([]*domain.PostViewStat, error)
+	ListDueForPublish(ctx context.Context, now time.Time, limit int) ([]*domain.Post, error)
+	ListDueForUnpublish(ctx context.Context, now time.Time, limit int) ([]*domain.Post, error)
+	ListScheduled(ctx context.Context, limit, offset int) ([]*domain.Post, int64, error)
+	SetPostTags(ctx context.Context, postID uint, names []string) ([]domain.Tag, error)
+	ListTags(ctx context.Context, filter TagFilter) ([]*TagResponse, int64, error)
+	GetTagBySlug(ctx context.Context, slug string) (*TagResponse, error)
+	AddReaction(ctx context.Context, reaction *domain.PostReaction) (bool, error)
+	RemoveReaction(ctx context.Context, postID, userID uint, reactionType domain.ReactionType) (bool, error)
+	ListUserReactions(ctx context.Context, postID, userID uint) ([]domain.ReactionType, error)
+	AddBookmark(ctx context.Context, bookmark *domain.PostBookmark) (bool, error)
+	RemoveBookmark(ctx context.Context, postID, userID uint) (bool, error)
+	IsBookmarked(ctx context.Context, postID, userID uint) (bool, error)
+	CountEngagement(ctx context.Context, postIDs []uint) (map[uint]*Engagement, error)
+	ListBookmarkedPosts(ctx context.Context, userID uint, limit, offset int) ([]*domain.Post, int64, error)
+	ListStaleTermVectorPosts(ctx context.Context, limit int) ([]*domain.Post, error)
+	SaveTermVectors(ctx context.Context, vectors []*domain.PostTermVector) error
+	ListRelatedCandidates(ctx context.Context) ([]*RelatedCandidate, error)
+	ReplaceRelated(ctx context.Context, related []*domain.PostRelated) error
+	ListRelatedPosts(ctx context.Context, postID uint, limit int) ([]*domain.Post, error)
+	SeriesSlugExists(ctx context.Context, slug string, excludeID uint) (bool, error)
+	CreateSeries(ctx context.Context, series *domain.Series, postIDs []uint) error
+	UpdateSeries(ctx context.Context, series *domain.Series) error
+	SetSeriesPosts(ctx context.Context, seriesID uint, postIDs []uint) error
+	DeleteSeries(ctx context.Context, id uint) error
+	GetSeriesByID(ctx context.Context, id uint) (*domain.Series, error)
+	GetSeriesBySlug(ctx context.Context, slug string) (*domain.Series, error)
+	ListSeries(ctx context.Context, limit, offset int) ([]*SeriesListItem, int64, error)
+	ListSeriesPosts(ctx context.Context, seriesID uint, publishedOnly bool) ([]*domain.Post, error)
+	GetPostSeries(ctx context.Context, postID uint) (*domain.Series, []*domain.Post, error)
+	ListPostAuthors(ctx context.Context, postID uint) ([]*domain.PostAuthor, error)
+	GetPostAuthor(ctx context.Context, postID, userID uint) (*domain.PostAuthor, error)
+	SetPostAuthor(ctx context.Context, author *domain.PostAuthor) error
+	RemovePostAuthor(ctx context.Context, postID, userID uint) (bool, error)
+	ListFeedPosts(ctx context.Context, filter FeedFilter, limit int) ([]*domain.Post, error)
+	Search(ctx context.Context, filter SearchFilter) ([]*PostSearchResult, int64, error)
+	TransitionStatus(ctx context.Context, post *domain.Post, from domain.PostStatus) (bool, error)
+	CreateVersion(ctx context.Context, version *domain.PostVersion) error
+	GetPostSource(ctx context.Context, source string) (*domain.PostSource, error)
+	SavePostSource(ctx context.Context, postSource *domain.PostSource) error
 	WithTransaction(ctx context.Context, fn func(txRepo Repository) error) error
 }
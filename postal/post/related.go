@@ -0,0 +1,335 @@
+package post
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"sort"
+	"time"
+
+	"postal/cache"
+	"postal/domain"
+	"postal/util"
+
+	"github.com/google/uuid"
+)
+
+const (
+	// relatedPerPost is the number of recommendations stored per post
+	relatedPerPost = 10
+
+	// relatedTopTerms caps the terms of a content vector, the tail adds cost but little signal
+	relatedTopTerms = 50
+
+	// relatedMinScore drops candidates that only share noise
+	relatedMinScore = 0.05
+
+	// Weights of the signals in the score of a candidate, they add up to 1
+	relatedContentWeight  = 0.5
+	relatedCategoryWeight = 0.3
+	relatedTagWeight      = 0.2
+
+	// relatedDirtyKey is set to a new token when a post is published, unpublished
+	// or edited, the worker recomputes the recommendations on its next run
+	relatedDirtyKey = "post:related:dirty"
+	relatedLockKey  = "post:related:lock"
+	relatedLockTTL  = 10 * time.Minute
+
+	relatedVectorBatchSize = 200
+)
+
+func relatedCacheKey(postID uint) string {
+	return fmt.Sprintf("post:related:list:%d", postID)
+}
+
+// GetRelatedPosts returns the published posts recommended to readers of a post.
+// Posts without computed recommendations yet fall back to the latest posts of their category.
+func (s *service) GetRelatedPosts(ctx context.Context, postID uint, limit int) ([]*PostListItemResponse, error) {
+	if limit <= 0 || limit > relatedPerPost {
+		limit = relatedPerPost
+	}
+
+	var responses []*PostListItemResponse
+	cached := false
+	if s.cache != nil {
+		data, err := s.cache.Get(ctx, relatedCacheKey(postID))
+		if err == nil && data != "" {
+			cached = json.Unmarshal([]byte(data), &responses) == nil
+		}
+	}
+
+	if !cached {
+		posts, err := s.repo.ListRelatedPosts(ctx, postID, relatedPerPost)
+		if err != nil {
+			return nil, fmt.Errorf("failed to list related posts: %w", err)
+		}
+		if len(posts) == 0 {
+			posts, err = s.latestInCategory(ctx, postID)
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		responses = make([]*PostListItemResponse, len(posts))
+		for i, post := range posts {
+			responses[i] = ToPostListItemResponse(post)
+		}
+
+		if s.cache != nil {
+			if data, err := json.Marshal(responses); err == nil {
+				if err := s.cache.Set(ctx, relatedCacheKey(postID), data, time.Hour); err != nil {
+					log.Printf("Failed to cache related posts: %v", err)
+				}
+			}
+		}
+	}
+
+	if len(responses) > limit {
+		responses = responses[:limit]
+	}
+	s.attachListEngagement(ctx, responses)
+	return responses, nil
+}
+
+func (s *service) latestInCategory(ctx context.Context, postID uint) ([]*domain.Post, error) {
+	post, err := s.repo.GetByID(ctx, postID)
+	if err != nil {
+		return nil, err
+	}
+
+	status := domain.StatusPublished
+	posts, _, err := s.repo.List(ctx, PostFilter{
+		Status:     &status,
+		CategoryID: &post.CategoryID,
+		SortBy:     "published_at",
+		Limit:      relatedPerPost + 1,
+	}, false)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list category posts: %w", err)
+	}
+
+	latest := make([]*domain.Post, 0, len(posts))
+	for _, candidate := range posts {
+		if candidate.ID != postID && len(latest) < relatedPerPost {
+			latest = append(latest, candidate)
+		}
+	}
+	return latest, nil
+}
+
+// RecomputeRelatedPosts refreshes the term vectors of new and edited posts and, when
+// posts changed since the last run, recomputes the recommendations of every post.
+// It returns the number of recommendations stored, 0 when nothing changed.
+func (s *service) RecomputeRelatedPosts(ctx context.Context) (int, error) {
+	marker := ""
+	if s.cache != nil {
+		unlock, acquired, err := cache.TryLock(ctx, s.cache, relatedLockKey, relatedLockTTL)
+		if err != nil {
+			log.Printf("⚠️ Failed to acquire related posts lock, running unlocked: %v", err)
+		} else if !acquired {
+			return 0, nil
+		} else {
+			defer unlock()
+		}
+
+		if value, err := s.cache.Get(ctx, relatedDirtyKey); err == nil {
+			marker = value
+		}
+	}
+	dirty := marker != ""
+
+	refreshed, err := s.refreshTermVectors(ctx)
+	if err != nil {
+		return 0, err
+	}
+	if !dirty && refreshed == 0 {
+		return 0, nil
+	}
+
+	candidates, err := s.repo.ListRelatedCandidates(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("failed to load related candidates: %w", err)
+	}
+
+	related := scoreRelated(candidates, time.Now())
+	if err := s.repo.ReplaceRelated(ctx, related); err != nil {
+		return 0, fmt.Errorf("failed to store related posts: %w", err)
+	}
+
+	if s.cache != nil {
+		if err := s.cache.DelPattern(ctx, "post:related:list:*"); err != nil {
+			log.Printf("Failed to invalidate related posts cache: %v", err)
+		}
+
+		// Cleared only after a successful run, and only if no post changed during
+		// it, otherwise the new marker triggers the next run
+		if dirty {
+			if _, err := s.cache.DelIfValue(ctx, relatedDirtyKey, marker); err != nil {
+				log.Printf("⚠️ Failed to clear related posts marker: %v", err)
+			}
+		}
+	}
+
+	return len(related), nil
+}
+
+// markRelatedDirty schedules a recompute of the recommendations
+func (s *service) markRelatedDirty(ctx context.Context) {
+	if s.cache == nil {
+		return
+	}
+	if err := s.cache.Set(ctx, relatedDirtyKey, uuid.New().String(), 0); err != nil {
+		log.Printf("Failed to mark related posts for recompute: %v", err)
+	}
+}
+
+// refreshTermVectors computes the term frequencies of posts whose vector is missing or outdated
+func (s *service) refreshTermVectors(ctx context.Context) (int, error) {
+	refreshed := 0
+	for {
+		posts, err := s.repo.ListStaleTermVectorPosts(ctx, relatedVectorBatchSize)
+		if err != nil {
+			return refreshed, fmt.Errorf("failed to load posts for term vectors: %w", err)
+		}
+		if len(posts) == 0 {
+			return refreshed, nil
+		}
+
+		vectors := make([]*domain.PostTermVector, len(posts))
+		for i, post := range posts {
+			// The title and summary describe the topic best, count them twice
+			text := post.Title + " " + post.Title + " " + post.Summary + " " + post.Summary + " " + post.Content
+			vectors[i] = &domain.PostTermVector{
+				PostID:  post.ID,
+				Version: post.Version,
+				Terms:   util.TermFrequencies(util.Tokenize(text)),
+			}
+		}
+		if err := s.repo.SaveTermVectors(ctx, vectors); err != nil {
+			return refreshed, fmt.Errorf("failed to save term vectors: %w", err)
+		}
+
+		refreshed += len(posts)
+		if len(posts) < relatedVectorBatchSize {
+			return refreshed, nil
+		}
+	}
+}
+
+// scoreRelated picks the best recommendations of every candidate. Candidates
+// sharing a weighted term, a tag or a category are scored by the cosine of their
+// TF-IDF vectors, how close their categories are and the Jaccard index of their tags.
+func scoreRelated(candidates []*RelatedCandidate, now time.Time) []*domain.PostRelated {
+	docFreq := make(map[string]int)
+	for _, candidate := range candidates {
+		for term := range candidate.Terms {
+			docFreq[term]++
+		}
+	}
+
+	vectors := make([]map[string]float64, len(candidates))
+	byTerm := make(map[string][]int)
+	byTag := make(map[uint][]int)
+	byCategory := make(map[uint][]int)
+	for i, candidate := range candidates {
+		vectors[i] = util.TFIDF(candidate.Terms, docFreq, len(candidates), relatedTopTerms)
+		for term := range vectors[i] {
+			byTerm[term] = append(byTerm[term], i)
+		}
+		for _, tagID := range candidate.TagIDs {
+			byTag[tagID] = append(byTag[tagID], i)
+		}
+		byCategory[candidate.CategoryID] = append(byCategory[candidate.CategoryID], i)
+	}
+
+	type scored struct {
+		index int
+		score float64
+	}
+
+	var related []*domain.PostRelated
+	for i, candidate := range candidates {
+		cosine := make(map[int]float64)
+		for term, weight := range vectors[i] {
+			for _, j := range byTerm[term] {
+				if j != i {
+					cosine[j] += weight * vectors[j][term]
+				}
+			}
+		}
+
+		others := make(map[int]bool, len(cosine))
+		for j := range cosine {
+			others[j] = true
+		}
+		for _, tagID := range candidate.TagIDs {
+			for _, j := range byTag[tagID] {
+				others[j] = true
+			}
+		}
+		for _, j := range byCategory[candidate.CategoryID] {
+			others[j] = true
+		}
+		delete(others, i)
+
+		scores := make([]scored, 0, len(others))
+		for j := range others {
+			score := relatedContentWeight*cosine[j] +
+				relatedCategoryWeight*categoryCloseness(candidate, candidates[j]) +
+				relatedTagWeight*tagOverlap(candidate.TagIDs, candidates[j].TagIDs)
+			if score >= relatedMinScore {
+				scores = append(scores, scored{j, score})
+			}
+		}
+
+		sort.Slice(scores, func(a, b int) bool {
+			if scores[a].score != scores[b].score {
+				return scores[a].score > scores[b].score
+			}
+			return candidates[scores[a].index].ID > candidates[scores[b].index].ID
+		})
+		if len(scores) > relatedPerPost {
+			scores = scores[:relatedPerPost]
+		}
+
+		for _, sc := range scores {
+			related = append(related, &domain.PostRelated{
+				PostID:     candidate.ID,
+				RelatedID:  candidates[sc.index].ID,
+				Score:      sc.score,
+				ComputedAt: now,
+			})
+		}
+	}
+	return related
+}
+
+// categoryCloseness is 1 for posts of the same subcategory and 0.6 for the same category
+func categoryCloseness(a, b *RelatedCandidate) float64 {
+	if a.SubCategoryID != nil && b.SubCategoryID != nil && *a.SubCategoryID == *b.SubCategoryID {
+		return 1
+	}
+	if a.CategoryID == b.CategoryID {
+		return 0.6
+	}
+	return 0
+}
+
+// tagOverlap is the Jaccard index of two tag sets
+func tagOverlap(a, b []uint) float64 {
+	if len(a) == 0 || len(b) == 0 {
+		return 0
+	}
+	set := make(map[uint]bool, len(a))
+	for _, id := range a {
+		set[id] = true
+	}
+	shared := 0
+	for _, id := range b {
+		if set[id] {
+			shared++
+		}
+	}
+	return float64(shared) / float64(len(a)+len(b)-shared)
+}
@@ -0,0 +1,54 @@
+package post
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"time"
+
+	"postal/render"
+)
+
+// renderCacheKey is keyed by post version, every content change bumps the
+// version so a stale render is never served
+func renderCacheKey(postID uint, version int) string {
+	return fmt.Sprintf("post:render:%d:v%d:r%d", postID, version, render.Version)
+}
+
+// renderContent fills the rendered HTML and table of contents of a post
+func (s *service) renderContent(ctx context.Context, post *PostResponse) {
+	cacheKey := renderCacheKey(post.ID, post.Version)
+
+	if s.cache != nil {
+		cached, err := s.cache.Get(ctx, cacheKey)
+		if err == nil && cached != "" {
+			var rendered render.Rendered
+			if err := json.Unmarshal([]byte(cached), &rendered); err == nil {
+				post.ContentHTML = rendered.HTML
+				post.TOC = rendered.TOC
+				return
+			}
+		}
+	}
+
+	rendered, err := render.Markdown(post.Content)
+	if err != nil {
+		// Clients can still fall back to the raw content
+		log.Printf("⚠️ Failed to render post content (id=%d): %v", post.ID, err)
+		return
+	}
+	post.ContentHTML = rendered.HTML
+	post.TOC = rendered.TOC
+
+	if s.cache != nil {
+		data, err := json.Marshal(rendered)
+		if err != nil {
+			log.Printf("Failed to marshal rendered post for cache: %v", err)
+			return
+		}
+		if err := s.cache.Set(ctx, cacheKey, data, 7*24*time.Hour); err != nil {
+			log.Printf("Failed to cache rendered post: %v", err)
+		}
+	}
+}
@@ -0,0 +1,257 @@
+package post
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"postal/cache"
+	"postal/domain"
+	"postal/events"
+)
+
+const (
+	// schedulerLockKey makes sure only one replica runs the scheduler at a time
+	schedulerLockKey = "post:scheduler:lock"
+	schedulerLockTTL = time.Minute
+
+	// scheduledBatchSize caps the posts transitioned per run, the rest follow on the next tick
+	scheduledBatchSize = 100
+)
+
+// SchedulePost sets the publish and/or unpublish time of a post. A draft post moves to
+// the scheduled status until the scheduler publishes it at PublishAt.
+func (s *service) SchedulePost(ctx context.Context, id uint, req SchedulePostRequest, userID uint) (*PostResponse, error) {
+	if req.PublishAt == nil && req.UnpublishAt == nil {
+		return nil, fmt.Errorf("publish_at or unpublish_at is required")
+	}
+
+	post, err := s.repo.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	switch post.Status {
+	case domain.StatusDraft, domain.StatusScheduled:
+		if req.PublishAt == nil && post.PublishAt == nil {
+			return nil, fmt.Errorf("publish_at is required for a post that is not published")
+		}
+	case domain.StatusPublished:
+		if req.PublishAt != nil {
+			return nil, fmt.Errorf("post is already published")
+		}
+	default:
+		return nil, fmt.Errorf("cannot schedule a post with status %s", post.Status)
+	}
+
+	if req.PublishAt != nil {
+		if !req.PublishAt.After(now) {
+			return nil, fmt.Errorf("publish_at must be in the future")
+		}
+		publishAt := req.PublishAt.UTC()
+		post.PublishAt = &publishAt
+		post.Status = domain.StatusScheduled
+	}
+
+	if req.UnpublishAt != nil {
+		if !req.UnpublishAt.After(now) {
+			return nil, fmt.Errorf("unpublish_at must be in the future")
+		}
+		if post.PublishAt != nil && !req.UnpublishAt.After(*post.PublishAt) {
+			return nil, fmt.Errorf("unpublish_at must be after publish_at")
+		}
+		unpublishAt := req.UnpublishAt.UTC()
+		post.UnpublishAt = &unpublishAt
+	}
+
+	post.UpdatedBy = userID
+
+	if err := s.saveWithVersion(ctx, post, "Schedule changed", "status", "publish_at", "unpublish_at", "updated_by"); err != nil {
+		return nil, err
+	}
+
+	// Update cache with fresh post data
+	s.cachePost(ctx, post)
+
+	// Invalidate list caches
+	s.invalidateListCaches(ctx)
+
+	return ToPostResponse(post), nil
+}
+
+// CancelSchedule clears the publish and unpublish times of a post,
+// a scheduled post goes back to draft
+func (s *service) CancelSchedule(ctx context.Context, id uint, userID uint) error {
+	post, err := s.repo.GetByID(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	if post.PublishAt == nil && post.UnpublishAt == nil {
+		return fmt.Errorf("post is not scheduled")
+	}
+
+	if post.Status == domain.StatusScheduled {
+		post.Status = domain.StatusDraft
+	}
+	post.PublishAt = nil
+	post.UnpublishAt = nil
+	post.UpdatedBy = userID
+
+	if err := s.saveWithVersion(ctx, post, "Schedule cancelled", "status", "publish_at", "unpublish_at", "updated_by"); err != nil {
+		return err
+	}
+
+	// Update cache with fresh post data
+	s.cachePost(ctx, post)
+
+	// Invalidate list caches
+	s.invalidateListCaches(ctx)
+
+	return nil
+}
+
+// ListScheduledPosts returns the upcoming scheduled transitions, soonest first
+func (s *service) ListScheduledPosts(ctx context.Context, limit, offset int) ([]*ScheduledPostResponse, int64, error) {
+	posts, total, err := s.repo.ListScheduled(ctx, limit, offset)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to list scheduled posts: %w", err)
+	}
+
+	responses := make([]*ScheduledPostResponse, len(posts))
+	for i, post := range posts {
+		responses[i] = ToScheduledPostResponse(post)
+	}
+	return responses, total, nil
+}
+
+// RunScheduledTransitions publishes and unpublishes the posts that are due at now and
+// returns how many posts changed. Replicas coordinate through a Redis lock; the
+// status guarded updates keep a transition from being applied twice without it.
+func (s *service) RunScheduledTransitions(ctx context.Context, now time.Time) (int, error) {
+	if s.cache != nil {
+		unlock, acquired, err := cache.TryLock(ctx, s.cache, schedulerLockKey, schedulerLockTTL)
+		if err != nil {
+			log.Printf("⚠️ Failed to acquire scheduler lock, running unlocked: %v", err)
+		} else if !acquired {
+			return 0, nil
+		} else {
+			defer unlock()
+		}
+	}
+
+	published, err := s.publishDuePosts(ctx, now)
+	if err != nil {
+		return published, err
+	}
+
+	unpublished, err := s.unpublishDuePosts(ctx, now)
+	if published+unpublished > 0 {
+		s.invalidateListCaches(ctx)
+	}
+	return published + unpublished, err
+}
+
+func (s *service) publishDuePosts(ctx context.Context, now time.Time) (int, error) {
+	posts, err := s.repo.ListDueForPublish(ctx, now, scheduledBatchSize)
+	if err != nil {
+		return 0, fmt.Errorf("failed to load posts due for publishing: %w", err)
+	}
+
+	count := 0
+	for _, post := range posts {
+		publishedAt := *post.PublishAt
+		post.Status = domain.StatusPublished
+		post.PublishedAt = &publishedAt
+		post.PublishAt = nil
+
+		ok, err := s.transitionWithVersion(ctx, post, domain.StatusScheduled, "Published on schedule")
+		if err != nil {
+			log.Printf("❌ Failed to publish scheduled post (id=%d): %v", post.ID, err)
+			continue
+		}
+		if !ok {
+			continue
+		}
+
+		log.Printf("✅ Published scheduled post (id=%d, slug=%s)", post.ID, post.Slug)
+		s.cachePost(ctx, post)
+		s.invalidatePublicIndexes(ctx, post)
+		s.publishStatusEvent(ctx, post, true)
+		count++
+	}
+	return count, nil
+}
+
+func (s *service) unpublishDuePosts(ctx context.Context, now time.Time) (int, error) {
+	posts, err := s.repo.ListDueForUnpublish(ctx, now, scheduledBatchSize)
+	if err != nil {
+		return 0, fmt.Errorf("failed to load posts due for unpublishing: %w", err)
+	}
+
+	count := 0
+	for _, post := range posts {
+		post.Status = domain.StatusDraft
+		post.UnpublishAt = nil
+
+		ok, err := s.transitionWithVersion(ctx, post, domain.StatusPublished, "Unpublished on schedule")
+		if err != nil {
+			log.Printf("❌ Failed to unpublish scheduled post (id=%d): %v", post.ID, err)
+			continue
+		}
+		if !ok {
+			continue
+		}
+
+		log.Printf("✅ Unpublished scheduled post (id=%d, slug=%s)", post.ID, post.Slug)
+		s.cachePost(ctx, post)
+		s.invalidatePublicIndexes(ctx, post)
+		s.publishStatusEvent(ctx, post, true)
+		count++
+	}
+	return count, nil
+}
+
+// transitionWithVersion applies a scheduled status change and snapshots the
+// version it bumps to in the same transaction. Nobody edited the post, so the
+// snapshot credits no one.
+func (s *service) transitionWithVersion(ctx context.Context, post *domain.Post, from domain.PostStatus, changeNote string) (bool, error) {
+	applied := false
+	err := s.repo.WithTransaction(ctx, func(txRepo Repository) error {
+		ok, err := txRepo.TransitionStatus(ctx, post, from)
+		if err != nil || !ok {
+			return err
+		}
+		applied = true
+		return txRepo.CreateVersion(ctx, newVersion(post, 0, changeNote))
+	})
+	return applied && err == nil, err
+}
+
+// publishStatusEvent announces that a post went live or was taken down
+func (s *service) publishStatusEvent(ctx context.Context, post *domain.Post, scheduled bool) {
+	if s.events == nil {
+		return
+	}
+
+	routingKey := events.PostUnpublishedRoutingKey
+	if post.Status == domain.StatusPublished {
+		routingKey = events.PostPublishedRoutingKey
+	}
+
+	event := events.PostStatusChanged{
+		PostID:        post.ID,
+		PostUUID:      post.UUID,
+		Slug:          post.Slug,
+		CategoryID:    post.CategoryID,
+		SubCategoryID: post.SubCategoryID,
+		Status:        string(post.Status),
+		Scheduled:     scheduled,
+		ChangedAt:     time.Now().UTC(),
+	}
+	if err := s.events.Publish(ctx, routingKey, event); err != nil {
+		log.Printf("⚠️ Failed to publish %s event (post_id=%d): %v", routingKey, post.ID, err)
+	}
+}
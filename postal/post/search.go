@@ -0,0 +1,98 @@
+package post
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"html"
+	"log"
+	"strings"
+	"time"
+
+	"postal/util"
+)
+
+// Sentinels the repository asks Postgres to wrap search matches in
+const (
+	HighlightStart = "[[hl]]"
+	HighlightStop  = "[[/hl]]"
+)
+
+// ErrEmptySearchQuery is returned for a query without any term to match
+var ErrEmptySearchQuery = errors.New("search query must contain at least one term to match")
+
+// SearchPosts runs a ranked full-text search over published posts. Quoted text is
+// matched as a phrase, word* as a prefix and -word is excluded.
+func (s *service) SearchPosts(ctx context.Context, filter SearchFilter) ([]*PostSearchResult, int64, error) {
+	filter.Terms = util.ParseSearchQuery(filter.Query)
+
+	hasTerm := false
+	for _, term := range filter.Terms {
+		if !term.Exclude {
+			hasTerm = true
+			break
+		}
+	}
+	if !hasTerm {
+		return nil, 0, ErrEmptySearchQuery
+	}
+
+	// Search results live under the list prefix so post writes invalidate them too
+	cacheKey := buildSearchCacheKey(filter)
+	if s.cache != nil {
+		cached, err := s.cache.Get(ctx, cacheKey)
+		if err == nil && cached != "" {
+			var cachedResult struct {
+				Results []*PostSearchResult `json:"results"`
+				Total   int64               `json:"total"`
+			}
+			if err := json.Unmarshal([]byte(cached), &cachedResult); err == nil {
+				log.Printf("Cache HIT - returning search results from Redis (key=%s)", cacheKey)
+				return cachedResult.Results, cachedResult.Total, nil
+			}
+		}
+	}
+
+	results, total, err := s.repo.Search(ctx, filter)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to search posts: %w", err)
+	}
+
+	for _, result := range results {
+		result.TitleHighlight = renderHighlight(result.TitleHighlight)
+		result.Snippet = renderHighlight(result.Snippet)
+	}
+
+	if s.cache != nil {
+		data, err := json.Marshal(struct {
+			Results []*PostSearchResult `json:"results"`
+			Total   int64               `json:"total"`
+		}{Results: results, Total: total})
+		if err == nil {
+			if err := s.cache.Set(ctx, cacheKey, data, 5*time.Minute); err != nil {
+				log.Printf("Failed to cache search results: %v", err)
+			}
+		}
+	}
+
+	return results, total, nil
+}
+
+// renderHighlight escapes a headline and turns the match sentinels into <mark> tags
+func renderHighlight(headline string) string {
+	escaped := html.EscapeString(headline)
+	escaped = strings.ReplaceAll(escaped, HighlightStart, "<mark>")
+	return strings.ReplaceAll(escaped, HighlightStop, "</mark>")
+}
+
+func buildSearchCacheKey(filter SearchFilter) string {
+	key := fmt.Sprintf("post:list:search:limit:%d:offset:%d", filter.Limit, filter.Offset)
+	if filter.CategoryID != nil {
+		key += fmt.Sprintf(":cat:%d", *filter.CategoryID)
+	}
+	if filter.SubCategoryID != nil {
+		key += fmt.Sprintf(":subcat:%d", *filter.SubCategoryID)
+	}
+	return key + ":q:" + strings.ToLower(strings.TrimSpace(filter.Query))
+}
@@ -0,0 +1,291 @@
+package post
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log"
+	"time"
+
+	"postal/domain"
+	"postal/util"
+)
+
+var (
+	ErrNotSeriesOwner = errors.New("only the creator of the series and editors may change it")
+	ErrInvalidSlug    = errors.New("slug must contain letters or digits")
+)
+
+func seriesNavigationCacheKey(postID uint) string {
+	return fmt.Sprintf("post:series:nav:%d", postID)
+}
+
+func (s *service) CreateSeries(ctx context.Context, req CreateSeriesRequest, actor Actor) (*SeriesResponse, error) {
+	slug := req.Slug
+	if slug == "" {
+		slug = util.GenerateSlug(req.Title)
+	} else {
+		slug = util.SanitizeSlug(slug)
+	}
+	if slug == "" {
+		return nil, ErrInvalidSlug
+	}
+
+	exists, err := s.repo.SeriesSlugExists(ctx, slug, 0)
+	if err != nil {
+		return nil, fmt.Errorf("failed to check slug uniqueness: %w", err)
+	}
+	if exists {
+		return nil, fmt.Errorf("slug already exists")
+	}
+
+	postIDs, err := uniquePostIDs(req.PostIDs)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.authorizeSeriesPosts(ctx, postIDs, nil, actor); err != nil {
+		return nil, err
+	}
+
+	series := &domain.Series{
+		Title:       req.Title,
+		Slug:        slug,
+		Description: req.Description,
+		CreatedBy:   actor.UserID,
+		UpdatedBy:   actor.UserID,
+	}
+	if err := s.repo.CreateSeries(ctx, series, postIDs); err != nil {
+		return nil, fmt.Errorf("failed to create series: %w", err)
+	}
+
+	s.invalidateSeriesCaches(ctx)
+	return s.seriesResponse(ctx, series, false)
+}
+
+func (s *service) UpdateSeries(ctx context.Context, id uint, req UpdateSeriesRequest, actor Actor) (*SeriesResponse, error) {
+	series, err := s.repo.GetSeriesByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if err := authorizeSeries(series, actor); err != nil {
+		return nil, err
+	}
+
+	if req.Title != nil {
+		series.Title = *req.Title
+	}
+	if req.Slug != nil {
+		slug := util.SanitizeSlug(*req.Slug)
+		if slug == "" {
+			return nil, ErrInvalidSlug
+		}
+		exists, err := s.repo.SeriesSlugExists(ctx, slug, series.ID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to check slug uniqueness: %w", err)
+		}
+		if exists {
+			return nil, fmt.Errorf("slug already exists")
+		}
+		series.Slug = slug
+	}
+	if req.Description != nil {
+		series.Description = *req.Description
+	}
+	series.UpdatedBy = actor.UserID
+
+	if err := s.repo.UpdateSeries(ctx, series); err != nil {
+		return nil, fmt.Errorf("failed to update series: %w", err)
+	}
+
+	s.invalidateSeriesCaches(ctx)
+	return s.seriesResponse(ctx, series, false)
+}
+
+// SetSeriesPosts replaces the parts of a series, adding, removing and reordering them at once
+func (s *service) SetSeriesPosts(ctx context.Context, id uint, req SeriesPostsRequest, actor Actor) (*SeriesResponse, error) {
+	series, err := s.repo.GetSeriesByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if err := authorizeSeries(series, actor); err != nil {
+		return nil, err
+	}
+
+	postIDs, err := uniquePostIDs(req.PostIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	current, err := s.repo.ListSeriesPosts(ctx, series.ID, false)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list series posts: %w", err)
+	}
+	if err := s.authorizeSeriesPosts(ctx, postIDs, current, actor); err != nil {
+		return nil, err
+	}
+
+	if err := s.repo.SetSeriesPosts(ctx, series.ID, postIDs); err != nil {
+		return nil, fmt.Errorf("failed to update series posts: %w", err)
+	}
+	series.UpdatedBy = actor.UserID
+	if err := s.repo.UpdateSeries(ctx, series); err != nil {
+		return nil, fmt.Errorf("failed to update series: %w", err)
+	}
+
+	s.invalidateSeriesCaches(ctx)
+	return s.seriesResponse(ctx, series, false)
+}
+
+func (s *service) DeleteSeries(ctx context.Context, id uint, actor Actor) error {
+	series, err := s.repo.GetSeriesByID(ctx, id)
+	if err != nil {
+		return err
+	}
+	if err := authorizeSeries(series, actor); err != nil {
+		return err
+	}
+
+	if err := s.repo.DeleteSeries(ctx, id); err != nil {
+		return err
+	}
+
+	s.invalidateSeriesCaches(ctx)
+	return nil
+}
+
+// GetSeriesBySlug returns a series with its published parts
+func (s *service) GetSeriesBySlug(ctx context.Context, slug string) (*SeriesResponse, error) {
+	series, err := s.repo.GetSeriesBySlug(ctx, slug)
+	if err != nil {
+		return nil, err
+	}
+	return s.seriesResponse(ctx, series, true)
+}
+
+// ListSeries returns the series that have published parts
+func (s *service) ListSeries(ctx context.Context, limit, offset int) ([]*SeriesListItem, int64, error) {
+	items, total, err := s.repo.ListSeries(ctx, limit, offset)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to list series: %w", err)
+	}
+	return items, total, nil
+}
+
+func (s *service) seriesResponse(ctx context.Context, series *domain.Series, publishedOnly bool) (*SeriesResponse, error) {
+	posts, err := s.repo.ListSeriesPosts(ctx, series.ID, publishedOnly)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list series posts: %w", err)
+	}
+	return ToSeriesResponse(series, posts), nil
+}
+
+// attachSeries embeds the series navigation of a post in its response
+func (s *service) attachSeries(ctx context.Context, post *PostResponse) {
+	cacheKey := seriesNavigationCacheKey(post.ID)
+	if s.cache != nil {
+		cached, err := s.cache.Get(ctx, cacheKey)
+		if err == nil && cached != "" {
+			// Posts outside a series are cached as null so they skip the lookup too
+			var info *PostSeriesInfo
+			if err := json.Unmarshal([]byte(cached), &info); err == nil {
+				post.Series = info
+				return
+			}
+		}
+	}
+
+	series, parts, err := s.repo.GetPostSeries(ctx, post.ID)
+	if err != nil {
+		log.Printf("⚠️ Failed to load post series (post_id=%d): %v", post.ID, err)
+		return
+	}
+	post.Series = seriesNavigation(post.ID, series, parts)
+
+	if s.cache != nil {
+		data, err := json.Marshal(post.Series)
+		if err == nil {
+			if err := s.cache.Set(ctx, cacheKey, data, 24*time.Hour); err != nil {
+				log.Printf("Failed to cache post series: %v", err)
+			}
+		}
+	}
+}
+
+func seriesNavigation(postID uint, series *domain.Series, parts []*domain.Post) *PostSeriesInfo {
+	if series == nil {
+		return nil
+	}
+
+	info := &PostSeriesInfo{
+		ID:    series.ID,
+		Title: series.Title,
+		Slug:  series.Slug,
+		Total: len(parts),
+	}
+	for i, part := range parts {
+		if part.ID != postID {
+			continue
+		}
+		info.Position = i + 1
+		if i > 0 {
+			info.Previous = &SeriesPostLink{ID: parts[i-1].ID, Title: parts[i-1].Title, Slug: parts[i-1].Slug}
+		}
+		if i+1 < len(parts) {
+			info.Next = &SeriesPostLink{ID: parts[i+1].ID, Title: parts[i+1].Title, Slug: parts[i+1].Slug}
+		}
+	}
+	return info
+}
+
+// invalidateSeriesCaches drops the cached navigation of every post, a change to a
+// series or to the status or title of one of its parts moves its neighbours
+func (s *service) invalidateSeriesCaches(ctx context.Context) {
+	if s.cache == nil {
+		return
+	}
+	if err := s.cache.DelPattern(ctx, "post:series:nav:*"); err != nil {
+		log.Printf("Failed to invalidate post series cache: %v", err)
+	}
+}
+
+// authorizeSeries checks that the actor created the series or is staff
+func authorizeSeries(series *domain.Series, actor Actor) error {
+	if actor.IsStaff || series.CreatedBy == actor.UserID {
+		return nil
+	}
+	return ErrNotSeriesOwner
+}
+
+// authorizeSeriesPosts checks that the actor may edit the posts added to a
+// series, the parts it already has may be kept and reordered
+func (s *service) authorizeSeriesPosts(ctx context.Context, postIDs []uint, current []*domain.Post, actor Actor) error {
+	if actor.IsStaff {
+		return nil
+	}
+
+	existing := make(map[uint]bool, len(current))
+	for _, post := range current {
+		existing[post.ID] = true
+	}
+	for _, postID := range postIDs {
+		if existing[postID] {
+			continue
+		}
+		if err := s.AuthorizePostEdit(ctx, postID, actor); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func uniquePostIDs(ids []uint) ([]uint, error) {
+	seen := make(map[uint]bool, len(ids))
+	for _, id := range ids {
+		if seen[id] {
+			return nil, fmt.Errorf("post %d is listed more than once", id)
+		}
+		seen[id] = true
+	}
+	return ids, nil
+}
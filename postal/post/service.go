@@ -5,11 +5,13 @@ import (
 	"encoding/json"
 	"fmt"
 	"log"
-	"mime/multipart"
+	"slices"
+	"strings"
 	"time"
 
 	"postal/cache"
 	"postal/domain"
+	"postal/events"
 	"postal/post_version"
 	"postal/util"
 )
@@ -18,6 +20,9 @@ type service struct {
 	repo        Repository
 	versionRepo post_version.Repository
 	cache       cache.Cache
+	events      events.Publisher
+	categories  CategoryStore
+	assets      AssetLinker
 }
 
 func (s *service) CreatePost(ctx context.Context, req CreatePostRequest, userID uint) (*PostResponse, error) {
@@ -72,21 +77,33 @@ func (s *service) CreatePost(ctx context.Context, req CreatePostRequest, userID
 		Version:         1,
 	}
 
-	if err := s.repo.Create(ctx, post); err != nil {
+	if err := s.repo.WithTransaction(ctx, func(txRepo Repository) error {
+		if err := txRepo.Create(ctx, post); err != nil {
+			return err
+		}
+		if err := creditCreator(ctx, txRepo, post); err != nil {
+			return err
+		}
+
+		tags, err := txRepo.SetPostTags(ctx, post.ID, tagNames(req.Tags, req.Keywords))
+		if err != nil {
+			return err
+		}
+		post.Tags = tags
+
+		// The post starts out at version 1, snapshotted with it
+		return txRepo.CreateVersion(ctx, newVersion(post, userID, "Initial version"))
+	}); err != nil {
 		return nil, fmt.Errorf("failed to create post: %w", err)
 	}
 
 	s.cachePost(ctx, post)
+	s.syncAssets(ctx, post)
+	s.invalidateCredits(ctx, post.ID)
 
 	// Invalidate list caches to show new post immediately
 	s.invalidateListCaches(ctx)
 
-	// Create initial version
-	if err := s.createVersion(ctx, post, userID, "Initial version"); err != nil {
-		// Log error but don't fail the request
-		fmt.Printf("Failed to create version: %v\n", err)
-	}
-
 	return ToPostResponse(post), nil
 }
 
@@ -99,7 +116,7 @@ func (s *service) GetPostByID(ctx context.Context, id uint) (*PostResponse, erro
 			var post domain.Post
 			if err := json.Unmarshal([]byte(cached), &post); err == nil {
 				log.Printf("Cache HIT - returning from Redis (id=%d)", id)
-				return ToPostResponse(&post), nil
+				return s.toPostDetailResponse(ctx, &post), nil
 			}
 		}
 	}
@@ -113,7 +130,7 @@ func (s *service) GetPostByID(ctx context.Context, id uint) (*PostResponse, erro
 
 	// Backfill cache
 	s.cachePost(ctx, post)
-	return ToPostResponse(post), nil
+	return s.toPostDetailResponse(ctx, post), nil
 }
 
 func (s *service) GetPostByUUID(ctx context.Context, uuid string) (*PostResponse, error) {
@@ -121,7 +138,7 @@ func (s *service) GetPostByUUID(ctx context.Context, uuid string) (*PostResponse
 	if err != nil {
 		return nil, err
 	}
-	return ToPostResponse(post), nil
+	return s.toPostDetailResponse(ctx, post), nil
 }
 
 func (s *service) GetPostBySlug(ctx context.Context, slug string) (*PostResponse, error) {
@@ -133,7 +150,7 @@ func (s *service) GetPostBySlug(ctx context.Context, slug string) (*PostResponse
 			var post domain.Post
 			if err := json.Unmarshal([]byte(cached), &post); err == nil {
 				log.Printf("Cache HIT - returning from Redis (slug=%s)", slug)
-				return ToPostResponse(&post), nil
+				return s.toPostDetailResponse(ctx, &post), nil
 			}
 		}
 	}
@@ -142,12 +159,18 @@ func (s *service) GetPostBySlug(ctx context.Context, slug string) (*PostResponse
 	log.Printf("Cache MISS - loading from DB (slug=%s)", slug)
 	post, err := s.repo.GetBySlug(ctx, slug)
 	if err != nil {
-		return nil, err
+		// Fall back to previous slugs so renamed posts keep resolving.
+		// Callers can detect the redirect by comparing the returned slug.
+		renamed, historyErr := s.getPostBySlugHistory(ctx, slug)
+		if historyErr != nil {
+			return nil, err
+		}
+		return s.toPostDetailResponse(ctx, renamed), nil
 	}
 
 	// Backfill cache
 	s.cachePost(ctx, post)
-	return ToPostResponse(post), nil
+	return s.toPostDetailResponse(ctx, post), nil
 }
 
 func (s *service) ListPosts(ctx context.Context, filter PostFilter) ([]*PostListItemResponse, int64, error) {
@@ -169,6 +192,7 @@ func (s *service) ListPosts(ctx context.Context, filter PostFilter) ([]*PostList
 				unmarshalTime := time.Since(unmarshalStart)
 				log.Printf("Cache HIT - returning post list from Redis (key=%s) [cache_get=%v, unmarshal=%v, total=%v]",
 					cacheKey, cacheGetTime, unmarshalTime, time.Since(cacheStart))
+				s.attachListEngagement(ctx, cachedResult.Posts)
 				return cachedResult.Posts, cachedResult.Total, nil
 			}
 		}
@@ -189,6 +213,8 @@ func (s *service) ListPosts(ctx context.Context, filter PostFilter) ([]*PostList
 	// Cache the result
 	s.cachePostList(ctx, filter, responses, total)
 
+	s.attachListEngagement(ctx, responses)
+
 	return responses, total, nil
 }
 
@@ -218,6 +244,15 @@ func (s *service) buildListCacheKey(filter PostFilter) string {
 	if filter.Search != nil && *filter.Search != "" {
 		key += fmt.Sprintf(":search:%s", *filter.Search)
 	}
+	if len(filter.Tags) > 0 {
+		key += fmt.Sprintf(":tags:%s:%s", filter.TagMatch, strings.Join(filter.Tags, ","))
+	}
+	if filter.AuthorID != nil {
+		key += fmt.Sprintf(":author:%d", *filter.AuthorID)
+		if filter.AuthorRole != nil {
+			key += fmt.Sprintf(":%s", *filter.AuthorRole)
+		}
+	}
 
 	return key
 }
@@ -254,13 +289,24 @@ func (s *service) UpdatePost(ctx context.Context, id uint, req UpdatePostRequest
 	if err != nil {
 		return nil, err
 	}
+	if req.Version != nil && *req.Version != post.Version {
+		return nil, &VersionConflictError{Current: post.Version}
+	}
+	if req.IfMatch != nil && !slices.Contains(req.IfMatch, post.Version) {
+		return nil, &VersionConflictError{Current: post.Version}
+	}
+	baseVersion := post.Version
+	oldSlug := post.Slug
 
-	// Track if content changed for versioning
+	// Track if a versioned field (content, SEO or category) changed, and which
+	// columns to write so concurrent state changes are not overwritten
 	contentChanged := false
+	columns := []string{"updated_by"}
 
 	// Update fields
 	if req.Title != nil {
 		post.Title = *req.Title
+		columns = append(columns, "title")
 		contentChanged = true
 	}
 	if req.Slug != nil {
@@ -273,69 +319,133 @@ func (s *service) UpdatePost(ctx context.Context, id uint, req UpdatePostRequest
 			return nil, fmt.Errorf("slug already exists")
 		}
 		post.Slug = slug
+		columns = append(columns, "slug")
 	}
 	if req.Summary != nil {
 		post.Summary = *req.Summary
+		columns = append(columns, "summary")
 		contentChanged = true
 	}
 	if req.Content != nil {
 		post.Content = *req.Content
+		columns = append(columns, "content")
 		contentChanged = true
 	}
 	if req.Thumbnail != nil {
 		post.Thumbnail = *req.Thumbnail
+		columns = append(columns, "thumbnail")
+		contentChanged = true
 	}
 	if req.CategoryID != nil {
 		post.CategoryID = *req.CategoryID
+		columns = append(columns, "category_id")
+		contentChanged = true
 	}
 	if req.SubCategoryID != nil {
 		post.SubCategoryID = req.SubCategoryID
+		columns = append(columns, "sub_category_id")
+		contentChanged = true
 	}
 	if req.MetaTitle != nil {
 		post.MetaTitle = *req.MetaTitle
+		columns = append(columns, "meta_title")
+		contentChanged = true
 	}
 	if req.MetaDescription != nil {
 		post.MetaDescription = *req.MetaDescription
+		columns = append(columns, "meta_description")
+		contentChanged = true
 	}
 	if req.Keywords != nil {
 		post.Keywords = *req.Keywords
+		columns = append(columns, "keywords")
+		contentChanged = true
 	}
 	if req.OGImage != nil {
 		post.OGImage = *req.OGImage
+		columns = append(columns, "og_image")
+		contentChanged = true
+	}
+
+	// Tags default to the keywords for clients that only send keywords, explicit
+	// tags are never replaced by a keywords-only update
+	var newTags []string
+	if req.Tags != nil {
+		newTags = tagNames(*req.Tags, "")
+	} else if req.Keywords != nil && len(post.Tags) == 0 {
+		newTags = tagNames(nil, *req.Keywords)
 	}
 	if req.IsPublic != nil {
 		post.IsPublic = *req.IsPublic
+		columns = append(columns, "is_public")
 	}
 	if req.IsFeatured != nil {
 		post.IsFeatured = *req.IsFeatured
+		columns = append(columns, "is_featured")
 	}
 	if req.IsPinned != nil {
 		post.IsPinned = *req.IsPinned
+		columns = append(columns, "is_pinned")
 	}
 
 	post.UpdatedBy = userID
 
-	// Increment version if content changed
+	// Every change moves the version on, so the ETag changes with the post, and
+	// is snapshotted with it so version numbers have no gaps
+	post.Version++
+	changeNote := "Post updated"
 	if contentChanged {
-		post.Version++
+		changeNote = "Content updated"
 	}
 
-	if err := s.repo.Update(ctx, post); err != nil {
+	if err := s.repo.WithTransaction(ctx, func(txRepo Repository) error {
+		// Guard against an update that landed between our read and this write
+		updated, err := txRepo.UpdateIfVersion(ctx, post, baseVersion, columns...)
+		if err != nil {
+			return err
+		}
+		if !updated {
+			return versionConflict(ctx, txRepo, post.ID)
+		}
+
+		if newTags != nil {
+			tags, err := txRepo.SetPostTags(ctx, post.ID, newTags)
+			if err != nil {
+				return err
+			}
+			post.Tags = tags
+		}
+
+		// Keep the old slug so existing links can be redirected
+		if post.Slug != oldSlug {
+			if err := txRepo.AddSlugHistory(ctx, &domain.PostSlugHistory{
+				PostID:    post.ID,
+				Slug:      oldSlug,
+				ChangedBy: userID,
+			}); err != nil {
+				return err
+			}
+		}
+		return txRepo.CreateVersion(ctx, newVersion(post, userID, changeNote))
+	}); err != nil {
 		return nil, fmt.Errorf("failed to update post: %w", err)
 	}
 
+	// Drop the entry cached under the old slug
+	if post.Slug != oldSlug {
+		s.invalidateSlugCache(ctx, oldSlug)
+	}
+
 	// Update cache with fresh post data
 	s.cachePost(ctx, post)
+	s.syncAssets(ctx, post)
 
 	// Invalidate list caches (since post data changed)
 	s.invalidateListCaches(ctx)
+	s.invalidatePublicIndexes(ctx, post)
 
-	// Create version if content changed
-	if contentChanged {
-		if err := s.createVersion(ctx, post, userID, "Content updated"); err != nil {
-			fmt.Printf("Failed to create version: %v\n", err)
-		}
-	}
+	// The new version may add a contributor
+	s.invalidateCredits(ctx, post.ID)
 
 	return ToPostResponse(post), nil
 }
@@ -353,9 +463,10 @@ func (s *service) PublishPost(ctx context.Context, id uint, userID uint) error {
 	now := time.Now()
 	post.Status = domain.StatusPublished
 	post.PublishedAt = &now
+	post.PublishAt = nil // publishing now overrides a pending schedule
 	post.UpdatedBy = userID
 
-	if err := s.repo.Update(ctx, post); err != nil {
+	if err := s.saveWithVersion(ctx, post, "Published", "status", "published_at", "publish_at", "updated_by"); err != nil {
 		return err
 	}
 
@@ -364,6 +475,9 @@ func (s *service) PublishPost(ctx context.Context, id uint, userID uint) error {
 
 	// Invalidate list caches
 	s.invalidateListCaches(ctx)
+	s.invalidatePublicIndexes(ctx, post)
+
+	s.publishStatusEvent(ctx, post, false)
 
 	return nil
 }
@@ -374,10 +488,13 @@ func (s *service) UnpublishPost(ctx context.Context, id uint, userID uint) error
 		return err
 	}
 
+	wasPublished := post.Status == domain.StatusPublished
 	post.Status = domain.StatusDraft
+	post.PublishAt = nil
+	post.UnpublishAt = nil
 	post.UpdatedBy = userID
 
-	if err := s.repo.Update(ctx, post); err != nil {
+	if err := s.saveWithVersion(ctx, post, "Unpublished", "status", "publish_at", "unpublish_at", "updated_by"); err != nil {
 		return err
 	}
 
@@ -387,6 +504,11 @@ func (s *service) UnpublishPost(ctx context.Context, id uint, userID uint) error
 	// Invalidate list caches
 	s.invalidateListCaches(ctx)
 
+	if wasPublished {
+		s.invalidatePublicIndexes(ctx, post)
+		s.publishStatusEvent(ctx, post, false)
+	}
+
 	return nil
 }
 
@@ -399,9 +521,11 @@ func (s *service) ArchivePost(ctx context.Context, id uint, userID uint) error {
 	now := time.Now()
 	post.Status = domain.StatusArchived
 	post.ArchivedAt = &now
+	post.PublishAt = nil
+	post.UnpublishAt = nil
 	post.UpdatedBy = userID
 
-	if err := s.repo.Update(ctx, post); err != nil {
+	if err := s.saveWithVersion(ctx, post, "Archived", "status", "archived_at", "publish_at", "unpublish_at", "updated_by"); err != nil {
 		return err
 	}
 
@@ -410,6 +534,7 @@ func (s *service) ArchivePost(ctx context.Context, id uint, userID uint) error {
 
 	// Invalidate list caches
 	s.invalidateListCaches(ctx)
+	s.invalidatePublicIndexes(ctx, post)
 
 	return nil
 }
@@ -424,7 +549,7 @@ func (s *service) RestorePost(ctx context.Context, id uint, userID uint) error {
 	post.ArchivedAt = nil
 	post.UpdatedBy = userID
 
-	if err := s.repo.Update(ctx, post); err != nil {
+	if err := s.saveWithVersion(ctx, post, "Restored from archive", "status", "archived_at", "updated_by"); err != nil {
 		return err
 	}
 
@@ -473,25 +598,77 @@ func (s *service) HardDeletePost(ctx context.Context, id uint) error {
 	return nil
 }
 
-func (s *service) createVersion(ctx context.Context, post *domain.Post, userID uint, changeNote string) error {
-	version := &domain.PostVersion{
-		PostID:     post.ID,
-		VersionNo:  post.Version,
-		Title:      post.Title,
-		Content:    post.Content,
-		Summary:    post.Summary,
-		EditedBy:   userID,
-		ChangeNote: changeNote,
-	}
-	return s.versionRepo.Create(ctx, version)
-}
+// getPostBySlugHistory resolves a previous slug to its current published post
+func (s *service) getPostBySlugHistory(ctx context.Context, slug string) (*domain.Post, error) {
+	history, err := s.repo.FindSlugHistory(ctx, slug)
+	if err != nil {
+		return nil, err
+	}
 
-func (s *service) BatchUploadPosts(ctx context.Context, userID uint, file *multipart.File) error {
-	posts, slugRows, err := util.ParseAndValidateCSV(file, userID)
+	post, err := s.repo.GetByID(ctx, history.PostID)
 	if err != nil {
-		return fmt.Errorf("failed to parse CSV: %w", err)
+		return nil, err
+	}
+	if post.Status != domain.StatusPublished {
+		return nil, ErrPostNotFound
 	}
 
+	return post, nil
+}
+
+// saveWithVersion saves the columns of the post, which bumps its version, and
+// snapshots the new version in the same transaction
+func (s *service) saveWithVersion(ctx context.Context, post *domain.Post, changeNote string, columns ...string) error {
+	if err := s.repo.WithTransaction(ctx, func(txRepo Repository) error {
+		if err := txRepo.Update(ctx, post, columns...); err != nil {
+			return err
+		}
+		return txRepo.CreateVersion(ctx, newVersion(post, post.UpdatedBy, changeNote))
+	}); err != nil {
+		return err
+	}
+
+	// The new version may add a contributor
+	s.invalidateCredits(ctx, post.ID)
+	return nil
+}
+
+// newVersion snapshots the current state of a post as its version post.Version
+func newVersion(post *domain.Post, userID uint, changeNote string) *domain.PostVersion {
+	return &domain.PostVersion{
+		PostID:          post.ID,
+		VersionNo:       post.Version,
+		Title:           post.Title,
+		Content:         post.Content,
+		Summary:         post.Summary,
+		Thumbnail:       post.Thumbnail,
+		EditedBy:        userID,
+		ChangeNote:      changeNote,
+		CategoryID:      post.CategoryID,
+		SubCategoryID:   post.SubCategoryID,
+		MetaTitle:       post.MetaTitle,
+		MetaDescription: post.MetaDescription,
+		Keywords:        post.Keywords,
+		OGImage:         post.OGImage,
+	}
+}
+
+// ImportPosts creates the posts of a batch upload, either all of them or none
+func (s *service) ImportPosts(ctx context.Context, posts *[]domain.Post, slugRows *[]util.SlugRow) error {
+	return s.createImportedPosts(ctx, posts, slugRows, nil)
+}
+
+// ExistingSlugs reports which of the slugs posts already use
+func (s *service) ExistingSlugs(ctx context.Context, slugs []string) (map[string]bool, error) {
+	return s.repo.FindExistingSlugs(ctx, slugs)
+}
+
+// createImportedPosts creates the posts of a bulk import in one transaction. Slugs
+// must be new, posts are numbered after the current highest order_no, their
+// creator is credited as author, their keywords become tags and their content is
+// saved as the initial version. afterCreate, when set, runs in the same transaction
+// once the posts have their IDs.
+func (s *service) createImportedPosts(ctx context.Context, posts *[]domain.Post, slugRows *[]util.SlugRow, afterCreate func(txRepo Repository) error) error {
 	if err := s.repo.WithTransaction(ctx, func(txRepo Repository) error {
 		// collect slugs for uniqueness check
 		slugs := make([]string, 0, len(*slugRows))
@@ -507,7 +684,7 @@ func (s *service) BatchUploadPosts(ctx context.Context, userID uint, file *multi
 
 		for _, sr := range *slugRows {
 			if existing[sr.Slug] {
-				return fmt.Errorf("row %d: slug '%s' already exists in database", sr.Row, sr.Slug)
+				return fmt.Errorf("%w: %s: slug '%s' already exists in database", ErrInvalidImport, sr.Source(), sr.Slug)
 			}
 		}
 
@@ -524,17 +701,44 @@ func (s *service) BatchUploadPosts(ctx context.Context, userID uint, file *multi
 			(*posts)[i].OrderNo = currentOrderNo
 		}
 
-		return txRepo.BatchCreate(ctx, posts)
+		if err := txRepo.BatchCreate(ctx, posts); err != nil {
+			return err
+		}
+
+		for i := range *posts {
+			post := &(*posts)[i]
+			if err := creditCreator(ctx, txRepo, post); err != nil {
+				return fmt.Errorf("failed to credit author of post '%s': %w", post.Slug, err)
+			}
+			if err := txRepo.CreateVersion(ctx, newVersion(post, post.CreatedBy, "Initial version")); err != nil {
+				return fmt.Errorf("failed to save version of post '%s': %w", post.Slug, err)
+			}
+			if post.Keywords == "" {
+				continue
+			}
+			tags, err := txRepo.SetPostTags(ctx, post.ID, util.ParseKeywords(post.Keywords))
+			if err != nil {
+				return fmt.Errorf("failed to tag post '%s': %w", post.Slug, err)
+			}
+			post.Tags = tags
+		}
+
+		if afterCreate != nil {
+			return afterCreate(txRepo)
+		}
+		return nil
 	}); err != nil {
 		return err
 	}
 
 	for i := range *posts {
 		s.cachePost(ctx, &(*posts)[i])
+		s.syncAssets(ctx, &(*posts)[i])
 	}
 
 	// Invalidate list caches to show new posts immediately
 	s.invalidateListCaches(ctx)
+	s.invalidateImportedIndexes(ctx, *posts)
 
 	return nil
 }
@@ -568,9 +772,28 @@ func (s *service) BatchDeletePosts(ctx context.Context, uuids *[]string) error {
 	// Invalidate list caches after batch deletion
 	s.invalidateListCaches(ctx)
 
+	// The ids of the deleted posts are unknown here, regenerate every sitemap page
+	if s.cache != nil {
+		if err := s.cache.DelPattern(ctx, "sitemap:*"); err != nil {
+			log.Printf("Failed to invalidate sitemap cache: %v", err)
+		}
+	}
+	s.markRelatedDirty(ctx)
+
 	return nil
 }
 
+// toPostDetailResponse converts a post for the read endpoints, with its
+// rendered content, engagement counts and series navigation
+func (s *service) toPostDetailResponse(ctx context.Context, post *domain.Post) *PostResponse {
+	response := ToPostResponse(post)
+	s.renderContent(ctx, response)
+	s.attachEngagement(ctx, response)
+	s.attachSeries(ctx, response)
+	s.attachCredits(ctx, response)
+	return response
+}
+
 func (s *service) cachePost(ctx context.Context, post *domain.Post) {
 	if s.cache == nil || post == nil {
 		return
@@ -596,3 +819,14 @@ func (s *service) cachePost(ctx context.Context, post *domain.Post) {
 		}
 	}
 }
+
+// syncAssets records the media assets a saved post references, a failure only
+// leaves the links stale until the next save
+func (s *service) syncAssets(ctx context.Context, post *domain.Post) {
+	if s.assets == nil {
+		return
+	}
+	if err := s.assets.SyncPostAssets(ctx, post.ID, post.Thumbnail, post.OGImage, post.Content); err != nil {
+		log.Printf("⚠️ Failed to sync post assets (post_id=%d): %v", post.ID, err)
+	}
+}
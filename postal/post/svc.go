@@ -2,14 +2,18 @@ package post
 
 import (
 	"postal/cache"
+	"postal/events"
 	"postal/post_version"
 )
 
 // NewService creates a new post service with injected dependencies
-func NewService(repo Repository, versionRepo post_version.Repository, cache cache.Cache) Service {
+func NewService(repo Repository, versionRepo post_version.Repository, cache cache.Cache, publisher events.Publisher, categories CategoryStore, assets AssetLinker) Service {
 	return &service{
 		repo:        repo,
 		versionRepo: versionRepo,
 		cache:       cache,
+		events:      publisher,
+		categories:  categories,
+		assets:      assets,
 	}
 }
@@ -0,0 +1,63 @@
+package post
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"time"
+
+	"postal/util"
+)
+
+// tagNames returns the normalized tag names of a post, falling back to its
+// comma separated keywords when no tags were given
+func tagNames(tags []string, keywords string) []string {
+	if len(tags) > 0 {
+		return util.ParseTags(tags)
+	}
+	return util.ParseKeywords(keywords)
+}
+
+// ListTags returns the tags of published posts with their post counts
+func (s *service) ListTags(ctx context.Context, filter TagFilter) ([]*TagResponse, int64, error) {
+	// Tag listings live under the list prefix so post writes invalidate them too
+	cacheKey := fmt.Sprintf("post:list:tags:limit:%d:offset:%d:sort:%s:search:%s",
+		filter.Limit, filter.Offset, filter.SortBy, util.TagSlug(filter.Search))
+	if s.cache != nil {
+		cached, err := s.cache.Get(ctx, cacheKey)
+		if err == nil && cached != "" {
+			var cachedResult struct {
+				Tags  []*TagResponse `json:"tags"`
+				Total int64          `json:"total"`
+			}
+			if err := json.Unmarshal([]byte(cached), &cachedResult); err == nil {
+				log.Printf("Cache HIT - returning tag list from Redis (key=%s)", cacheKey)
+				return cachedResult.Tags, cachedResult.Total, nil
+			}
+		}
+	}
+
+	tags, total, err := s.repo.ListTags(ctx, filter)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to list tags: %w", err)
+	}
+
+	if s.cache != nil {
+		data, err := json.Marshal(struct {
+			Tags  []*TagResponse `json:"tags"`
+			Total int64          `json:"total"`
+		}{Tags: tags, Total: total})
+		if err == nil {
+			if err := s.cache.Set(ctx, cacheKey, data, 5*time.Minute); err != nil {
+				log.Printf("Failed to cache tag list: %v", err)
+			}
+		}
+	}
+
+	return tags, total, nil
+}
+
+func (s *service) GetTagBySlug(ctx context.Context, slug string) (*TagResponse, error) {
+	return s.repo.GetTagBySlug(ctx, util.TagSlug(slug))
+}
@@ -0,0 +1,156 @@
+package post
+
+import (
+	"context"
+	"fmt"
+
+	"postal/domain"
+	"postal/util"
+)
+
+func (s *service) ListVersions(ctx context.Context, postID uint) ([]*PostVersionListItem, error) {
+	if _, err := s.repo.GetByID(ctx, postID); err != nil {
+		return nil, err
+	}
+
+	versions, err := s.versionRepo.GetByPostID(ctx, postID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list versions: %w", err)
+	}
+
+	items := make([]*PostVersionListItem, 0, len(versions))
+	for _, version := range versions {
+		items = append(items, ToPostVersionListItem(version))
+	}
+	return items, nil
+}
+
+func (s *service) GetVersion(ctx context.Context, postID uint, versionNo int) (*domain.PostVersion, error) {
+	return s.versionRepo.GetByPostIDAndVersion(ctx, postID, versionNo)
+}
+
+func (s *service) DiffVersions(ctx context.Context, postID uint, from, to int, mode string) (*PostVersionDiff, error) {
+	if mode == "" {
+		mode = util.DiffModeLine
+	}
+	if mode != util.DiffModeLine && mode != util.DiffModeWord {
+		return nil, fmt.Errorf("invalid diff mode %q, expected line or word", mode)
+	}
+
+	fromVersion, err := s.versionRepo.GetByPostIDAndVersion(ctx, postID, from)
+	if err != nil {
+		return nil, fmt.Errorf("version %d: %w", from, err)
+	}
+	toVersion, err := s.versionRepo.GetByPostIDAndVersion(ctx, postID, to)
+	if err != nil {
+		return nil, fmt.Errorf("version %d: %w", to, err)
+	}
+
+	diff := &PostVersionDiff{
+		PostID:  postID,
+		From:    from,
+		To:      to,
+		Mode:    mode,
+		Title:   util.DiffText(fromVersion.Title, toVersion.Title, util.DiffModeWord),
+		Summary: util.DiffText(fromVersion.Summary, toVersion.Summary, mode),
+		Content: util.DiffText(fromVersion.Content, toVersion.Content, mode),
+		Fields:  map[string]FieldChange{},
+	}
+
+	addChange := func(field string, a, b any) {
+		if a != b {
+			diff.Fields[field] = FieldChange{From: a, To: b}
+		}
+	}
+	addChange("thumbnail_url", fromVersion.Thumbnail, toVersion.Thumbnail)
+	addChange("category_id", fromVersion.CategoryID, toVersion.CategoryID)
+	addChange("sub_category_id", derefUint(fromVersion.SubCategoryID), derefUint(toVersion.SubCategoryID))
+	addChange("meta_title", fromVersion.MetaTitle, toVersion.MetaTitle)
+	addChange("meta_description", fromVersion.MetaDescription, toVersion.MetaDescription)
+	addChange("keywords", fromVersion.Keywords, toVersion.Keywords)
+	addChange("og_image", fromVersion.OGImage, toVersion.OGImage)
+
+	return diff, nil
+}
+
+// RestoreVersion brings the post back to the state of an earlier version.
+// History is never rewritten, the restored state is saved as a new version.
+func (s *service) RestoreVersion(ctx context.Context, postID uint, versionNo int, userID uint) (*PostResponse, error) {
+	post, err := s.repo.GetByID(ctx, postID)
+	if err != nil {
+		return nil, err
+	}
+
+	version, err := s.versionRepo.GetByPostIDAndVersion(ctx, postID, versionNo)
+	if err != nil {
+		return nil, err
+	}
+
+	post.Title = version.Title
+	post.Content = version.Content
+	post.Summary = version.Summary
+	post.Thumbnail = version.Thumbnail
+	post.MetaTitle = version.MetaTitle
+	post.MetaDescription = version.MetaDescription
+	post.Keywords = version.Keywords
+	post.OGImage = version.OGImage
+	// Versions written before category snapshots existed have no category
+	if version.CategoryID != 0 {
+		post.CategoryID = version.CategoryID
+		post.SubCategoryID = version.SubCategoryID
+	}
+	baseVersion := post.Version
+	post.UpdatedBy = userID
+	post.Version++
+
+	// The restored state and its version are saved together, or not at all
+	if err := s.repo.WithTransaction(ctx, func(txRepo Repository) error {
+		updated, err := txRepo.UpdateIfVersion(ctx, post, baseVersion,
+			"title", "content", "summary", "thumbnail", "meta_title", "meta_description",
+			"keywords", "og_image", "category_id", "sub_category_id", "updated_by")
+		if err != nil {
+			return err
+		}
+		if !updated {
+			return versionConflict(ctx, txRepo, post.ID)
+		}
+		return txRepo.CreateVersion(ctx, newVersion(post, userID, fmt.Sprintf("Restored from version %d", versionNo)))
+	}); err != nil {
+		return nil, fmt.Errorf("failed to restore post: %w", err)
+	}
+
+	// The new version may add a contributor
+	s.invalidateCredits(ctx, post.ID)
+	s.cachePost(ctx, post)
+	s.syncAssets(ctx, post)
+	s.invalidateListCaches(ctx)
+	s.invalidatePublicIndexes(ctx, post)
+
+	return ToPostResponse(post), nil
+}
+
+// VersionConflictError reports an update based on a version of the post that is
+// no longer current
+type VersionConflictError struct {
+	Current int
+}
+
+func (e *VersionConflictError) Error() string {
+	return fmt.Sprintf("post has been modified, current version is %d", e.Current)
+}
+
+// versionConflict reads the version that won the race for the conflict error
+func versionConflict(ctx context.Context, repo Repository, postID uint) error {
+	current, err := repo.GetByID(ctx, postID)
+	if err != nil {
+		return err
+	}
+	return &VersionConflictError{Current: current.Version}
+}
+
+func derefUint(v *uint) any {
+	if v == nil {
+		return nil
+	}
+	return *v
+}
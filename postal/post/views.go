@@ -0,0 +1,158 @@
+package post
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"log"
+	"strconv"
+	"strings"
+	"time"
+
+	"postal/domain"
+	"postal/events"
+)
+
+const (
+	// viewDedupeWindow is how long repeated reads of a post by the same visitor count once
+	viewDedupeWindow = 30 * time.Minute
+
+	// viewBufferKey holds the views not yet written to the database,
+	// one field per post and day ("<post_id>:<yyyy-mm-dd>")
+	viewBufferKey = "post:views:pending"
+)
+
+func viewSeenKey(postID uint, visitor string) string {
+	sum := sha256.Sum256([]byte(visitor))
+	return fmt.Sprintf("post:views:seen:%d:%s", postID, hex.EncodeToString(sum[:16]))
+}
+
+// RecordView counts a read of a published post by a visitor and publishes a
+// post.viewed event for the cortex leaderboards. Reads of the same visitor within
+// viewDedupeWindow count once. Views are buffered in Redis and written by FlushViews,
+// so a hot post does not take a row lock on every read.
+func (s *service) RecordView(ctx context.Context, post *PostResponse, visitor string) error {
+	if post.Status != domain.StatusPublished {
+		return nil
+	}
+
+	now := time.Now().UTC()
+	day := now.Truncate(24 * time.Hour)
+
+	if s.cache == nil {
+		// Without Redis there is nothing to dedupe against or buffer in
+		err := s.repo.AddViews(ctx, []ViewIncrement{{PostID: post.ID, Day: day, Count: 1}})
+		if err != nil {
+			return fmt.Errorf("failed to record post view: %w", err)
+		}
+	} else {
+		if visitor != "" {
+			first, err := s.cache.SetNX(ctx, viewSeenKey(post.ID, visitor), now.Unix(), viewDedupeWindow)
+			if err != nil {
+				log.Printf("⚠️ Failed to dedupe post view, counting it (post_id=%d): %v", post.ID, err)
+			} else if !first {
+				return nil
+			}
+		}
+
+		field := fmt.Sprintf("%d:%s", post.ID, day.Format("2006-01-02"))
+		if err := s.cache.HIncrBy(ctx, viewBufferKey, field, 1); err != nil {
+			return fmt.Errorf("failed to buffer post view: %w", err)
+		}
+	}
+
+	if s.events != nil {
+		event := events.PostViewed{
+			PostID:        post.ID,
+			PostUUID:      post.UUID,
+			CategoryID:    post.CategoryID,
+			SubCategoryID: post.SubCategoryID,
+			ViewedAt:      now,
+		}
+		if err := s.events.Publish(ctx, events.PostViewedRoutingKey, event); err != nil {
+			// The daily stats are the source of truth, cortex rebuilds from them
+			log.Printf("⚠️ Failed to publish post.viewed event (post_id=%d): %v", post.ID, err)
+		}
+	}
+
+	return nil
+}
+
+// FlushViews writes the buffered views to the posts and their daily stats and
+// returns how many views were written. The buffer is drained atomically, so
+// replicas can flush concurrently; views that fail to write go back to the buffer.
+func (s *service) FlushViews(ctx context.Context) (int64, error) {
+	if s.cache == nil {
+		return 0, nil
+	}
+
+	fields, err := s.cache.HDrain(ctx, viewBufferKey)
+	if err != nil {
+		return 0, err
+	}
+	if len(fields) == 0 {
+		return 0, nil
+	}
+
+	increments := make([]ViewIncrement, 0, len(fields))
+	var total int64
+	for field, value := range fields {
+		increment, ok := parseViewIncrement(field, value)
+		if !ok {
+			log.Printf("⚠️ Dropping malformed buffered view (%s=%s)", field, value)
+			continue
+		}
+		increments = append(increments, increment)
+		total += increment.Count
+	}
+
+	if err := s.repo.AddViews(ctx, increments); err != nil {
+		s.rebufferViews(context.WithoutCancel(ctx), fields)
+		return 0, fmt.Errorf("failed to flush post views: %w", err)
+	}
+
+	return total, nil
+}
+
+func (s *service) rebufferViews(ctx context.Context, fields map[string]string) {
+	for field, value := range fields {
+		count, err := strconv.ParseInt(value, 10, 64)
+		if err != nil || count <= 0 {
+			continue
+		}
+		if err := s.cache.HIncrBy(ctx, viewBufferKey, field, count); err != nil {
+			log.Printf("❌ Lost %d buffered views (%s): %v", count, field, err)
+		}
+	}
+}
+
+func parseViewIncrement(field, value string) (ViewIncrement, bool) {
+	postIDStr, dayStr, found := strings.Cut(field, ":")
+	if !found {
+		return ViewIncrement{}, false
+	}
+
+	postID, err := strconv.ParseUint(postIDStr, 10, 32)
+	if err != nil {
+		return ViewIncrement{}, false
+	}
+	day, err := time.Parse("2006-01-02", dayStr)
+	if err != nil {
+		return ViewIncrement{}, false
+	}
+	count, err := strconv.ParseInt(value, 10, 64)
+	if err != nil || count <= 0 {
+		return ViewIncrement{}, false
+	}
+
+	return ViewIncrement{PostID: uint(postID), Day: day, Count: count}, true
+}
+
+func (s *service) ListViewStats(ctx context.Context, filter ViewStatFilter) ([]*domain.PostViewStat, error) {
+	stats, err := s.repo.ListViewStats(ctx, filter)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list view stats: %w", err)
+	}
+	return stats, nil
+}
@@ -2,6 +2,7 @@ package post_version
 
 import (
 	"context"
+	"time"
 
 	"postal/domain"
 )
@@ -11,4 +12,14 @@ type Repository interface {
 	Create(ctx context.Context, version *domain.PostVersion) error
 	GetByPostID(ctx context.Context, postID uint) ([]*domain.PostVersion, error)
 	GetByID(ctx context.Context, id uint) (*domain.PostVersion, error)
+	GetByPostIDAndVersion(ctx context.Context, postID uint, versionNo int) (*domain.PostVersion, error)
+	ListContributors(ctx context.Context, postID uint) ([]*Contributor, error)
+}
+
+// Contributor sums up the versions a user saved of a post
+type Contributor struct {
+	UserID      uint
+	Edits       int
+	FirstEditAt time.Time
+	LastEditAt  time.Time
 }
@@ -0,0 +1,33 @@
+package preview
+
+import (
+	"time"
+
+	"postal/post"
+)
+
+// CreateLinkRequest asks for a preview link, ExpiresIn is in hours and
+// defaults to the configured lifetime
+type CreateLinkRequest struct {
+	ExpiresIn int  `json:"expires_in" validate:"omitempty,min=1,max=720"`
+	Version   *int `json:"version" validate:"omitempty,min=1"`
+}
+
+// LinkResponse is a preview link, URL is filled in by the HTTP layer
+type LinkResponse struct {
+	ID        uint      `json:"id"`
+	PostID    uint      `json:"post_id"`
+	Version   *int      `json:"version,omitempty"`
+	Token     string    `json:"token"`
+	URL       string    `json:"url,omitempty"`
+	ExpiresAt time.Time `json:"expires_at"`
+	CreatedBy uint      `json:"created_by"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+// PreviewResponse is the post as the preview link shows it
+type PreviewResponse struct {
+	Post      *post.PostResponse `json:"post"`
+	Version   *int               `json:"version,omitempty"`
+	ExpiresAt time.Time          `json:"expires_at"`
+}
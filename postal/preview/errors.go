@@ -0,0 +1,8 @@
+package preview
+
+import "errors"
+
+var (
+	ErrInvalidToken = errors.New("preview link is invalid or has expired")
+	ErrLinkNotFound = errors.New("preview link not found")
+)
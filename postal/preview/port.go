@@ -0,0 +1,26 @@
+package preview
+
+import (
+	"context"
+	"time"
+
+	"postal/domain"
+)
+
+// Service defines the business logic interface for draft preview links
+type Service interface {
+	CreateLink(ctx context.Context, postID uint, req CreateLinkRequest, userID uint) (*LinkResponse, error)
+	ListLinks(ctx context.Context, postID uint) ([]*LinkResponse, error)
+	RevokeLink(ctx context.Context, postID, linkID, userID uint) error
+	RevokeAll(ctx context.Context, postID, userID uint) (int64, error)
+	Resolve(ctx context.Context, token string) (*PreviewResponse, error)
+}
+
+// Repository defines the interface for preview link persistence
+type Repository interface {
+	Create(ctx context.Context, link *domain.PostPreviewLink) error
+	GetByUUID(ctx context.Context, uuid string) (*domain.PostPreviewLink, error)
+	ListActive(ctx context.Context, postID uint, now time.Time) ([]*domain.PostPreviewLink, error)
+	Revoke(ctx context.Context, postID, linkID, userID uint, now time.Time) (bool, error)
+	RevokeAll(ctx context.Context, postID, userID uint, now time.Time) (int64, error)
+}
@@ -0,0 +1,165 @@
+package preview
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"postal/domain"
+	"postal/post"
+	"postal/render"
+
+	"github.com/google/uuid"
+)
+
+type service struct {
+	repo   Repository
+	posts  post.Service
+	secret []byte
+	ttl    time.Duration
+}
+
+// CreateLink hands out a preview link for a post, pinned to a saved version when
+// the request names one
+func (s *service) CreateLink(ctx context.Context, postID uint, req CreateLinkRequest, userID uint) (*LinkResponse, error) {
+	if _, err := s.posts.GetPostByID(ctx, postID); err != nil {
+		return nil, err
+	}
+	if req.Version != nil {
+		if _, err := s.posts.GetVersion(ctx, postID, *req.Version); err != nil {
+			return nil, err
+		}
+	}
+
+	ttl := s.ttl
+	if req.ExpiresIn > 0 {
+		ttl = time.Duration(req.ExpiresIn) * time.Hour
+	}
+
+	link := &domain.PostPreviewLink{
+		UUID:    uuid.New().String(),
+		PostID:  postID,
+		Version: req.Version,
+		// Tokens carry the expiry in whole seconds
+		ExpiresAt: time.Now().Add(ttl).UTC().Truncate(time.Second),
+		CreatedBy: userID,
+	}
+	if err := s.repo.Create(ctx, link); err != nil {
+		return nil, fmt.Errorf("failed to create preview link: %w", err)
+	}
+
+	return s.toLinkResponse(link), nil
+}
+
+// ListLinks returns the preview links of a post that still work, newest first
+func (s *service) ListLinks(ctx context.Context, postID uint) ([]*LinkResponse, error) {
+	links, err := s.repo.ListActive(ctx, postID, time.Now())
+	if err != nil {
+		return nil, fmt.Errorf("failed to list preview links: %w", err)
+	}
+
+	responses := make([]*LinkResponse, len(links))
+	for i, link := range links {
+		responses[i] = s.toLinkResponse(link)
+	}
+	return responses, nil
+}
+
+func (s *service) RevokeLink(ctx context.Context, postID, linkID, userID uint) error {
+	revoked, err := s.repo.Revoke(ctx, postID, linkID, userID, time.Now())
+	if err != nil {
+		return fmt.Errorf("failed to revoke preview link: %w", err)
+	}
+	if !revoked {
+		return ErrLinkNotFound
+	}
+	return nil
+}
+
+// RevokeAll revokes every outstanding preview link of a post and returns how many
+func (s *service) RevokeAll(ctx context.Context, postID, userID uint) (int64, error) {
+	count, err := s.repo.RevokeAll(ctx, postID, userID, time.Now())
+	if err != nil {
+		return 0, fmt.Errorf("failed to revoke preview links: %w", err)
+	}
+	return count, nil
+}
+
+// Resolve returns the post a token gives access to, as the current draft or as
+// the version the link is pinned to
+func (s *service) Resolve(ctx context.Context, token string) (*PreviewResponse, error) {
+	now := time.Now()
+	linkUUID, expiresAt, err := s.verify(token, now)
+	if err != nil {
+		return nil, err
+	}
+
+	link, err := s.repo.GetByUUID(ctx, linkUUID)
+	if err != nil {
+		return nil, err
+	}
+	if link == nil || link.RevokedAt != nil || !link.ExpiresAt.Equal(expiresAt) {
+		return nil, ErrInvalidToken
+	}
+
+	p, err := s.posts.GetPostByID(ctx, link.PostID)
+	if err != nil {
+		return nil, ErrInvalidToken
+	}
+
+	if link.Version != nil {
+		version, err := s.posts.GetVersion(ctx, link.PostID, *link.Version)
+		if err != nil {
+			return nil, ErrInvalidToken
+		}
+		applyVersion(p, version)
+	}
+
+	return &PreviewResponse{
+		Post:      p,
+		Version:   link.Version,
+		ExpiresAt: link.ExpiresAt,
+	}, nil
+}
+
+// applyVersion shows the content of a saved version in place of the current one
+func applyVersion(p *post.PostResponse, version *domain.PostVersion) {
+	p.Title = version.Title
+	p.Content = version.Content
+	p.Summary = version.Summary
+	p.Thumbnail = version.Thumbnail
+	p.MetaTitle = version.MetaTitle
+	p.MetaDescription = version.MetaDescription
+	p.Keywords = version.Keywords
+	p.OGImage = version.OGImage
+	// Versions written before category snapshots existed have no category
+	if version.CategoryID != 0 {
+		p.CategoryID = version.CategoryID
+		p.SubCategoryID = version.SubCategoryID
+	}
+	p.Version = version.VersionNo
+
+	p.ContentHTML = ""
+	p.TOC = nil
+	rendered, err := render.Markdown(version.Content)
+	if err != nil {
+		// Clients can still fall back to the raw content
+		log.Printf("⚠️ Failed to render post version (post_id=%d, version=%d): %v", p.ID, version.VersionNo, err)
+		return
+	}
+	p.ContentHTML = rendered.HTML
+	p.TOC = rendered.TOC
+}
+
+func (s *service) toLinkResponse(link *domain.PostPreviewLink) *LinkResponse {
+	return &LinkResponse{
+		ID:        link.ID,
+		PostID:    link.PostID,
+		Version:   link.Version,
+		Token:     s.sign(link),
+		ExpiresAt: link.ExpiresAt,
+		CreatedBy: link.CreatedBy,
+		CreatedAt: link.CreatedAt,
+	}
+}
@@ -0,0 +1,18 @@
+package preview
+
+import (
+	"time"
+
+	"postal/post"
+)
+
+// NewService creates a new preview service, links are signed with secret and
+// live for ttl unless the request asks otherwise
+func NewService(repo Repository, posts post.Service, secret string, ttl time.Duration) Service {
+	return &service{
+		repo:   repo,
+		posts:  posts,
+		secret: []byte(secret),
+		ttl:    ttl,
+	}
+}
@@ -0,0 +1,107 @@
+package preview
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"postal/domain"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestTokenSigning(t *testing.T) {
+	now := time.Date(2026, 5, 1, 12, 0, 0, 0, time.UTC)
+	link := &domain.PostPreviewLink{
+		UUID:      "5f0c6f3e-8a4b-4c1e-9a57-2d3e4f5a6b7c",
+		ExpiresAt: now.Add(time.Hour),
+	}
+	s := &service{secret: []byte("preview-secret")}
+	token := s.sign(link)
+	parts := strings.Split(token, ".")
+
+	tests := []struct {
+		name        string
+		service     *service
+		token       string
+		now         time.Time
+		expectError bool
+	}{
+		{
+			name:    "Valid Token",
+			service: s,
+			token:   token,
+			now:     now,
+		},
+		{
+			name:        "Signed With Another Secret",
+			service:     &service{secret: []byte("other-secret")},
+			token:       token,
+			now:         now,
+			expectError: true,
+		},
+		{
+			name:        "Expiry Extended",
+			service:     s,
+			token:       parts[0] + "." + "9999999999" + "." + parts[2],
+			now:         now,
+			expectError: true,
+		},
+		{
+			name:        "Signature Tampered",
+			service:     s,
+			token:       parts[0] + "." + parts[1] + "." + strings.Repeat("A", len(parts[2])),
+			now:         now,
+			expectError: true,
+		},
+		{
+			name:        "Expired",
+			service:     s,
+			token:       token,
+			now:         link.ExpiresAt,
+			expectError: true,
+		},
+		{
+			name:        "Malformed",
+			service:     s,
+			token:       "not-a-token",
+			now:         now,
+			expectError: true,
+		},
+		{
+			name:        "Signed Payload Without UUID",
+			service:     s,
+			token:       s.sign(&domain.PostPreviewLink{UUID: "draft", ExpiresAt: link.ExpiresAt}),
+			now:         now,
+			expectError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			uuid, expiresAt, err := tt.service.verify(tt.token, tt.now)
+			if tt.expectError {
+				require.ErrorIs(t, err, ErrInvalidToken)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, link.UUID, uuid)
+			require.True(t, link.ExpiresAt.Equal(expiresAt))
+		})
+	}
+}
+
+func TestDeriveSecret(t *testing.T) {
+	secret, err := DeriveSecret("jwt-secret")
+	require.NoError(t, err)
+	require.Len(t, secret, 32)
+	require.NotEqual(t, "jwt-secret", secret)
+
+	again, err := DeriveSecret("jwt-secret")
+	require.NoError(t, err)
+	require.Equal(t, secret, again)
+
+	other, err := DeriveSecret("another-jwt-secret")
+	require.NoError(t, err)
+	require.NotEqual(t, secret, other)
+}
@@ -0,0 +1,60 @@
+package render
+
+import (
+	"fmt"
+	"strings"
+	"unicode"
+
+	"github.com/yuin/goldmark/ast"
+	"github.com/yuin/goldmark/parser"
+)
+
+// headingIDs generates heading anchors that keep Unicode letters, goldmark's
+// default drops every non-ASCII character so a Bangla heading became "-"
+type headingIDs struct {
+	used map[string]bool
+}
+
+func newHeadingIDs() parser.IDs {
+	return &headingIDs{used: map[string]bool{}}
+}
+
+// Generate lowercases letters, keeps digits and combining marks, turns spaces,
+// dashes and underscores into single dashes and drops everything else. Repeated
+// anchors get a numeric suffix like goldmark's.
+func (g *headingIDs) Generate(value []byte, kind ast.NodeKind) []byte {
+	var sb strings.Builder
+	dash := false
+	for _, r := range strings.TrimSpace(string(value)) {
+		switch {
+		case unicode.IsLetter(r) || unicode.IsDigit(r) || unicode.IsMark(r):
+			if dash && sb.Len() > 0 {
+				sb.WriteByte('-')
+			}
+			dash = false
+			sb.WriteRune(unicode.ToLower(r))
+		case unicode.IsSpace(r) || r == '-' || r == '_':
+			dash = true
+		}
+	}
+
+	id := sb.String()
+	if id == "" {
+		id = "id"
+		if kind == ast.KindHeading {
+			id = "heading"
+		}
+	}
+
+	result := id
+	for i := 1; g.used[result]; i++ {
+		result = fmt.Sprintf("%s-%d", id, i)
+	}
+	g.used[result] = true
+	return []byte(result)
+}
+
+// Put marks an id set explicitly in the document as used
+func (g *headingIDs) Put(value []byte) {
+	g.used[string(value)] = true
+}
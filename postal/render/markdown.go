@@ -0,0 +1,197 @@
+// Package render turns the Markdown content of posts into sanitized HTML
+package render
+
+import (
+	"bytes"
+	"fmt"
+	"html"
+	"regexp"
+	"strings"
+
+	"github.com/alecthomas/chroma/v2"
+	chromahtml "github.com/alecthomas/chroma/v2/formatters/html"
+	"github.com/alecthomas/chroma/v2/lexers"
+	"github.com/alecthomas/chroma/v2/styles"
+	"github.com/microcosm-cc/bluemonday"
+	"github.com/yuin/goldmark"
+	"github.com/yuin/goldmark/ast"
+	"github.com/yuin/goldmark/extension"
+	"github.com/yuin/goldmark/parser"
+	"github.com/yuin/goldmark/renderer"
+	goldmarkhtml "github.com/yuin/goldmark/renderer/html"
+	"github.com/yuin/goldmark/text"
+	"github.com/yuin/goldmark/util"
+)
+
+// Version identifies the rendering pipeline, bump it whenever the output
+// changes so cached renders are rebuilt
+const Version = 2
+
+// Rendered is the HTML of a Markdown document and its table of contents
+type Rendered struct {
+	HTML string      `json:"html"`
+	TOC  []*TOCEntry `json:"toc"`
+}
+
+// TOCEntry is a heading of the document, nested under the previous higher level heading
+type TOCEntry struct {
+	Level    int         `json:"level"`
+	Text     string      `json:"text"`
+	Anchor   string      `json:"anchor"`
+	Children []*TOCEntry `json:"children,omitempty"`
+}
+
+var (
+	markdown = goldmark.New(
+		goldmark.WithExtensions(extension.GFM),
+		goldmark.WithParserOptions(parser.WithAutoHeadingID()),
+		goldmark.WithRendererOptions(
+			// Raw HTML is kept and cleaned up by the sanitizer afterwards
+			goldmarkhtml.WithUnsafe(),
+			renderer.WithNodeRenderers(util.Prioritized(newCodeBlockRenderer(), 100)),
+		),
+	)
+
+	policy = newPolicy()
+)
+
+// Markdown renders a Markdown document to sanitized HTML. Headings get anchors
+// for the table of contents and Go code blocks are syntax highlighted.
+func Markdown(content string) (*Rendered, error) {
+	source := []byte(content)
+	ctx := parser.NewContext(parser.WithIDs(newHeadingIDs()))
+	doc := markdown.Parser().Parse(text.NewReader(source), parser.WithContext(ctx))
+
+	var buf bytes.Buffer
+	if err := markdown.Renderer().Render(&buf, source, doc); err != nil {
+		return nil, fmt.Errorf("failed to render markdown: %w", err)
+	}
+
+	return &Rendered{
+		HTML: policy.Sanitize(buf.String()),
+		TOC:  tableOfContents(doc, source),
+	}, nil
+}
+
+// tableOfContents nests the headings of a document by level
+func tableOfContents(doc ast.Node, source []byte) []*TOCEntry {
+	toc := []*TOCEntry{}
+	var stack []*TOCEntry
+
+	_ = ast.Walk(doc, func(node ast.Node, entering bool) (ast.WalkStatus, error) {
+		heading, ok := node.(*ast.Heading)
+		if !entering || !ok {
+			return ast.WalkContinue, nil
+		}
+
+		entry := &TOCEntry{
+			Level: heading.Level,
+			Text:  headingText(heading, source),
+		}
+		if id, ok := heading.AttributeString("id"); ok {
+			if anchor, ok := id.([]byte); ok {
+				entry.Anchor = string(anchor)
+			}
+		}
+
+		for len(stack) > 0 && stack[len(stack)-1].Level >= entry.Level {
+			stack = stack[:len(stack)-1]
+		}
+		if len(stack) == 0 {
+			toc = append(toc, entry)
+		} else {
+			parent := stack[len(stack)-1]
+			parent.Children = append(parent.Children, entry)
+		}
+		stack = append(stack, entry)
+
+		return ast.WalkSkipChildren, nil
+	})
+
+	return toc
+}
+
+// headingText returns the plain text of a heading, without any inline markup
+func headingText(heading *ast.Heading, source []byte) string {
+	var sb strings.Builder
+	_ = ast.Walk(heading, func(node ast.Node, entering bool) (ast.WalkStatus, error) {
+		if !entering {
+			return ast.WalkContinue, nil
+		}
+		switch n := node.(type) {
+		case *ast.Text:
+			sb.Write(n.Segment.Value(source))
+			if n.SoftLineBreak() || n.HardLineBreak() {
+				sb.WriteByte(' ')
+			}
+		case *ast.String:
+			sb.Write(n.Value)
+		}
+		return ast.WalkContinue, nil
+	})
+	return strings.TrimSpace(sb.String())
+}
+
+// codeBlockRenderer highlights Go fenced code blocks with inline styles so they
+// look the same in every client, other languages are left to the client
+type codeBlockRenderer struct {
+	lexer     chroma.Lexer
+	style     *chroma.Style
+	formatter *chromahtml.Formatter
+}
+
+func newCodeBlockRenderer() *codeBlockRenderer {
+	return &codeBlockRenderer{
+		lexer:     chroma.Coalesce(lexers.Get("go")),
+		style:     styles.Get("github"),
+		formatter: chromahtml.New(chromahtml.WithClasses(false), chromahtml.TabWidth(4)),
+	}
+}
+
+func (r *codeBlockRenderer) RegisterFuncs(reg renderer.NodeRendererFuncRegisterer) {
+	reg.Register(ast.KindFencedCodeBlock, r.renderFencedCodeBlock)
+}
+
+func (r *codeBlockRenderer) renderFencedCodeBlock(w util.BufWriter, source []byte, node ast.Node, entering bool) (ast.WalkStatus, error) {
+	if !entering {
+		return ast.WalkContinue, nil
+	}
+
+	n := node.(*ast.FencedCodeBlock)
+	language := strings.ToLower(string(n.Language(source)))
+
+	var code strings.Builder
+	for i := 0; i < n.Lines().Len(); i++ {
+		line := n.Lines().At(i)
+		code.Write(line.Value(source))
+	}
+
+	if language == "go" || language == "golang" {
+		iterator, err := r.lexer.Tokenise(nil, code.String())
+		if err == nil {
+			if err := r.formatter.Format(w, r.style, iterator); err == nil {
+				return ast.WalkSkipChildren, nil
+			}
+		}
+	}
+
+	_, _ = w.WriteString("<pre><code")
+	if language != "" {
+		_, _ = w.WriteString(` class="language-` + html.EscapeString(language) + `"`)
+	}
+	_, _ = w.WriteString(">" + html.EscapeString(code.String()) + "</code></pre>\n")
+	return ast.WalkSkipChildren, nil
+}
+
+// newPolicy allows user generated content plus the markup the renderer itself
+// produces: heading anchors, code languages, highlighting styles and task lists
+func newPolicy() *bluemonday.Policy {
+	p := bluemonday.UGCPolicy()
+	p.AllowAttrs("id").Matching(regexp.MustCompile(`^[\p{L}\p{M}\p{N}_-]+$`)).OnElements("h1", "h2", "h3", "h4", "h5", "h6")
+	p.AllowAttrs("class").Matching(regexp.MustCompile(`^language-[\w+#.-]+$`)).OnElements("code")
+	p.AllowStyles("color", "background-color", "font-weight", "font-style", "text-decoration", "display").OnElements("pre", "span")
+	p.AllowAttrs("tabindex").Matching(regexp.MustCompile(`^0$`)).OnElements("pre")
+	p.AllowAttrs("type").Matching(regexp.MustCompile(`^checkbox$`)).OnElements("input")
+	p.AllowAttrs("checked", "disabled").Matching(regexp.MustCompile(`^(|checked|disabled)$`)).OnElements("input")
+	return p
+}
@@ -0,0 +1,53 @@
+package render
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestMarkdownHeadingAnchors(t *testing.T) {
+	tests := []struct {
+		name     string
+		content  string
+		expected []string
+	}{
+		{
+			name:     "ASCII",
+			content:  "# Hello World",
+			expected: []string{"hello-world"},
+		},
+		{
+			name:     "Bangla",
+			content:  "## আমার সোনার বাংলা",
+			expected: []string{"আমার-সোনার-বাংলা"},
+		},
+		{
+			name:     "Punctuation Dropped",
+			content:  "# What's new in Go 1.22?",
+			expected: []string{"whats-new-in-go-122"},
+		},
+		{
+			name:     "Repeated Headings",
+			content:  "# Intro\n\n# Intro",
+			expected: []string{"intro", "intro-1"},
+		},
+		{
+			name:     "No Letters",
+			content:  "# ???",
+			expected: []string{"heading"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rendered, err := Markdown(tt.content)
+			require.NoError(t, err)
+			require.Len(t, rendered.TOC, len(tt.expected))
+			for i, anchor := range tt.expected {
+				require.Equal(t, anchor, rendered.TOC[i].Anchor)
+				require.Contains(t, rendered.HTML, `id="`+anchor+`"`)
+			}
+		})
+	}
+}
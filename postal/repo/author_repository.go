@@ -0,0 +1,71 @@
+package repo
+
+import (
+	"context"
+	"errors"
+
+	"postal/domain"
+	"postal/post"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// ListPostAuthors returns the users credited on a post in the order they were added
+func (r *postRepository) ListPostAuthors(ctx context.Context, postID uint) ([]*domain.PostAuthor, error) {
+	var authors []*domain.PostAuthor
+	err := r.db.WithContext(ctx).
+		Where("post_id = ?", postID).
+		Order("created_at ASC, user_id ASC").
+		Find(&authors).Error
+	return authors, err
+}
+
+// GetPostAuthor returns the credit of a user on a post, nil when the user is not credited
+func (r *postRepository) GetPostAuthor(ctx context.Context, postID, userID uint) (*domain.PostAuthor, error) {
+	var author domain.PostAuthor
+	err := r.db.WithContext(ctx).
+		Where("post_id = ? AND user_id = ?", postID, userID).
+		First(&author).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return &author, nil
+}
+
+// SetPostAuthor credits a user on a post, or changes the role of an existing credit
+func (r *postRepository) SetPostAuthor(ctx context.Context, author *domain.PostAuthor) error {
+	return r.db.WithContext(ctx).
+		Clauses(clause.OnConflict{
+			Columns:   []clause.Column{{Name: "post_id"}, {Name: "user_id"}},
+			DoUpdates: clause.AssignmentColumns([]string{"role", "added_by", "updated_at"}),
+		}).
+		Create(author).Error
+}
+
+// RemovePostAuthor drops the credit of a user, reporting whether there was one
+func (r *postRepository) RemovePostAuthor(ctx context.Context, postID, userID uint) (bool, error) {
+	result := r.db.WithContext(ctx).
+		Where("post_id = ? AND user_id = ?", postID, userID).
+		Delete(&domain.PostAuthor{})
+	return result.RowsAffected > 0, result.Error
+}
+
+// applyAuthorFilter keeps the posts the user is credited on, optionally with one role
+func applyAuthorFilter(query *gorm.DB, filter post.PostFilter) *gorm.DB {
+	if filter.AuthorID == nil {
+		return query
+	}
+
+	credited := query.Session(&gorm.Session{NewDB: true}).
+		Table("post_authors").
+		Select("post_authors.post_id").
+		Where("post_authors.user_id = ?", *filter.AuthorID)
+	if filter.AuthorRole != nil {
+		credited = credited.Where("post_authors.role = ?", *filter.AuthorRole)
+	}
+	return query.Where("id IN (?)", credited)
+}
@@ -0,0 +1,144 @@
+package repo
+
+import (
+	"context"
+	"errors"
+
+	"postal/comment"
+	"postal/domain"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type commentRepository struct {
+	db *gorm.DB
+}
+
+func NewCommentRepository(db *gorm.DB) comment.Repository {
+	return &commentRepository{db: db}
+}
+
+func (r *commentRepository) Create(ctx context.Context, c *domain.Comment) error {
+	return r.db.WithContext(ctx).Create(c).Error
+}
+
+func (r *commentRepository) GetByID(ctx context.Context, id uint) (*domain.Comment, error) {
+	var c domain.Comment
+	err := r.db.WithContext(ctx).First(&c, id).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, comment.ErrCommentNotFound
+		}
+		return nil, err
+	}
+	return &c, nil
+}
+
+func (r *commentRepository) Update(ctx context.Context, c *domain.Comment) error {
+	return r.db.WithContext(ctx).Save(c).Error
+}
+
+func (r *commentRepository) Delete(ctx context.Context, id uint) error {
+	return r.db.WithContext(ctx).Delete(&domain.Comment{}, id).Error
+}
+
+func (r *commentRepository) HasReplies(ctx context.Context, id uint) (bool, error) {
+	var count int64
+	err := r.db.WithContext(ctx).Model(&domain.Comment{}).Where("parent_id = ?", id).Limit(1).Count(&count).Error
+	return count > 0, err
+}
+
+func (r *commentRepository) ListRoots(ctx context.Context, postID uint, limit, offset int) ([]*domain.Comment, int64, error) {
+	query := r.db.WithContext(ctx).Model(&domain.Comment{}).
+		Where("post_id = ? AND parent_id IS NULL AND status = ?", postID, domain.CommentApproved)
+
+	var total int64
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+
+	var comments []*domain.Comment
+	selectQuery := query.Order("created_at ASC, id ASC")
+	if limit > 0 {
+		selectQuery = selectQuery.Limit(limit)
+	}
+	if offset > 0 {
+		selectQuery = selectQuery.Offset(offset)
+	}
+	err := selectQuery.Find(&comments).Error
+	return comments, total, err
+}
+
+func (r *commentRepository) ListThreadReplies(ctx context.Context, rootIDs []uint) ([]*domain.Comment, error) {
+	var comments []*domain.Comment
+	err := r.db.WithContext(ctx).
+		Where("root_id IN ? AND status = ?", rootIDs, domain.CommentApproved).
+		Order("created_at ASC, id ASC").
+		Find(&comments).Error
+	return comments, err
+}
+
+func (r *commentRepository) CountApprovedByPostIDs(ctx context.Context, postIDs []uint) (map[uint]int64, error) {
+	var rows []struct {
+		PostID uint
+		Count  int64
+	}
+	err := r.db.WithContext(ctx).Model(&domain.Comment{}).
+		Select("post_id, COUNT(*) AS count").
+		Where("post_id IN ? AND status = ? AND is_deleted = ?", postIDs, domain.CommentApproved, false).
+		Group("post_id").
+		Scan(&rows).Error
+	if err != nil {
+		return nil, err
+	}
+
+	counts := make(map[uint]int64, len(rows))
+	for _, row := range rows {
+		counts[row.PostID] = row.Count
+	}
+	return counts, nil
+}
+
+func (r *commentRepository) ListByStatus(ctx context.Context, filter comment.ModerationFilter) ([]*domain.Comment, int64, error) {
+	query := r.db.WithContext(ctx).Model(&domain.Comment{}).Where("status = ?", filter.Status)
+	if filter.PostID != nil {
+		query = query.Where("post_id = ?", *filter.PostID)
+	}
+
+	var total int64
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+
+	// Oldest first, the queue is worked through in order
+	var comments []*domain.Comment
+	selectQuery := query.Order("created_at ASC, id ASC")
+	if filter.Limit > 0 {
+		selectQuery = selectQuery.Limit(filter.Limit)
+	}
+	if filter.Offset > 0 {
+		selectQuery = selectQuery.Offset(filter.Offset)
+	}
+	err := selectQuery.Find(&comments).Error
+	return comments, total, err
+}
+
+func (r *commentRepository) GetSettings(ctx context.Context, tenant string) (*domain.CommentSettings, error) {
+	var settings domain.CommentSettings
+	err := r.db.WithContext(ctx).Where("tenant = ?", tenant).First(&settings).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return &settings, nil
+}
+
+func (r *commentRepository) UpsertSettings(ctx context.Context, settings *domain.CommentSettings) error {
+	return r.db.WithContext(ctx).Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "tenant"}},
+		DoUpdates: clause.AssignmentColumns([]string{"enabled", "require_approval", "updated_by", "updated_at"}),
+	}).Create(settings).Error
+}
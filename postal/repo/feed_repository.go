@@ -0,0 +1,32 @@
+package repo
+
+import (
+	"context"
+
+	"postal/domain"
+	"postal/post"
+)
+
+// ListFeedPosts returns the latest published public posts, newest first.
+// A category feed includes the posts of its subcategories.
+func (r *postRepository) ListFeedPosts(ctx context.Context, filter post.FeedFilter, limit int) ([]*domain.Post, error) {
+	query := r.db.WithContext(ctx).
+		Preload("Tags", orderTags).
+		Where("status = ? AND is_public = ?", domain.StatusPublished, true)
+
+	if filter.CategoryID != nil {
+		query = query.Where("category_id = ? OR sub_category_id = ?", *filter.CategoryID, *filter.CategoryID)
+	}
+	if filter.TagSlug != "" {
+		query = query.Where(
+			"id IN (SELECT post_tags.post_id FROM post_tags JOIN tags ON tags.id = post_tags.tag_id WHERE tags.slug = ?)",
+			filter.TagSlug,
+		)
+	}
+
+	var posts []*domain.Post
+	if err := query.Order("published_at DESC NULLS LAST, id DESC").Limit(limit).Find(&posts).Error; err != nil {
+		return nil, err
+	}
+	return posts, nil
+}
@@ -0,0 +1,173 @@
+package repo
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"postal/domain"
+	"postal/importjob"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type importJobRepository struct {
+	db *gorm.DB
+}
+
+func NewImportJobRepository(db *gorm.DB) importjob.Repository {
+	return &importJobRepository{db: db}
+}
+
+func (r *importJobRepository) Create(ctx context.Context, job *domain.ImportJob) error {
+	return r.db.WithContext(ctx).Create(job).Error
+}
+
+// GetByUUID returns a job without its upload
+func (r *importJobRepository) GetByUUID(ctx context.Context, uuid string) (*domain.ImportJob, error) {
+	var job domain.ImportJob
+	err := r.db.WithContext(ctx).Omit("data").Where("uuid = ?", uuid).First(&job).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, importjob.ErrJobNotFound
+		}
+		return nil, err
+	}
+	return &job, nil
+}
+
+// ClaimNext marks the oldest pending job, or a running one without progress
+// since staleBefore, as running and returns it. Rows other workers hold are
+// skipped, nil means there is nothing to do.
+func (r *importJobRepository) ClaimNext(ctx context.Context, staleBefore, now time.Time) (*domain.ImportJob, error) {
+	var job domain.ImportJob
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		err := tx.Clauses(clause.Locking{Strength: "UPDATE", Options: "SKIP LOCKED"}).
+			Where("status = ? OR (status = ? AND updated_at < ?)", domain.ImportJobPending, domain.ImportJobRunning, staleBefore).
+			Order("created_at ASC").
+			First(&job).Error
+		if err != nil {
+			return err
+		}
+
+		job.Status = domain.ImportJobRunning
+		job.StartedAt = &now
+		job.UpdatedAt = now
+		return tx.Model(&job).Updates(map[string]interface{}{
+			"status":     job.Status,
+			"started_at": now,
+			"updated_at": now,
+		}).Error
+	})
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return &job, nil
+}
+
+// SaveProgress stores the counters of a running job, which also keeps it from
+// being taken over as stale
+func (r *importJobRepository) SaveProgress(ctx context.Context, job *domain.ImportJob) error {
+	return r.db.WithContext(ctx).Model(&domain.ImportJob{}).
+		Where("id = ?", job.ID).
+		Updates(map[string]interface{}{
+			"total_rows":     job.TotalRows,
+			"processed_rows": job.ProcessedRows,
+			"created":        job.Created,
+			"valid":          job.Valid,
+			"skipped":        job.Skipped,
+			"invalid":        job.Invalid,
+			"failed":         job.Failed,
+			"updated_at":     time.Now(),
+		}).Error
+}
+
+// Heartbeat marks a running job as alive while it makes no countable progress,
+// like during the single transaction of an all-or-nothing job
+func (r *importJobRepository) Heartbeat(ctx context.Context, jobID uint) error {
+	return r.db.WithContext(ctx).Model(&domain.ImportJob{}).
+		Where("id = ? AND status = ?", jobID, domain.ImportJobRunning).
+		Update("updated_at", time.Now()).Error
+}
+
+// SaveRows stores the outcomes of some rows of a running job, replacing what an
+// earlier run stored for them
+func (r *importJobRepository) SaveRows(ctx context.Context, jobID uint, rows []domain.ImportJobRow) error {
+	if len(rows) == 0 {
+		return nil
+	}
+	rowNos := make([]int, len(rows))
+	for i := range rows {
+		rowNos[i] = rows[i].Row
+	}
+
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Where("job_id = ? AND row_no IN ?", jobID, rowNos).Delete(&domain.ImportJobRow{}).Error; err != nil {
+			return err
+		}
+		return tx.CreateInBatches(rows, 500).Error
+	})
+}
+
+// ListCreatedRows returns the rows of a job that became posts, in row order
+func (r *importJobRepository) ListCreatedRows(ctx context.Context, jobID uint) ([]domain.ImportJobRow, error) {
+	var rows []domain.ImportJobRow
+	err := r.db.WithContext(ctx).
+		Where("job_id = ? AND outcome = ?", jobID, domain.ImportRowCreated).
+		Order("row_no ASC").
+		Find(&rows).Error
+	return rows, err
+}
+
+// Finish stores the outcome of a job and its rows, replacing the rows of an
+// earlier run that went stale
+func (r *importJobRepository) Finish(ctx context.Context, job *domain.ImportJob, rows []domain.ImportJobRow) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Where("job_id = ?", job.ID).Delete(&domain.ImportJobRow{}).Error; err != nil {
+			return err
+		}
+		if len(rows) > 0 {
+			if err := tx.CreateInBatches(rows, 500).Error; err != nil {
+				return err
+			}
+		}
+
+		return tx.Model(&domain.ImportJob{}).
+			Where("id = ?", job.ID).
+			Updates(map[string]interface{}{
+				"status":         job.Status,
+				"error":          job.Error,
+				"data":           nil,
+				"total_rows":     job.TotalRows,
+				"processed_rows": job.ProcessedRows,
+				"created":        job.Created,
+				"valid":          job.Valid,
+				"skipped":        job.Skipped,
+				"invalid":        job.Invalid,
+				"failed":         job.Failed,
+				"finished_at":    job.FinishedAt,
+				"updated_at":     time.Now(),
+			}).Error
+	})
+}
+
+// ListRows pages the row outcomes of a job in row order
+func (r *importJobRepository) ListRows(ctx context.Context, jobID uint, filter importjob.RowFilter) ([]*domain.ImportJobRow, int64, error) {
+	query := r.db.WithContext(ctx).Model(&domain.ImportJobRow{}).Where("job_id = ?", jobID)
+	if filter.Outcome != nil {
+		query = query.Where("outcome = ?", *filter.Outcome)
+	}
+
+	var total int64
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+
+	var rows []*domain.ImportJobRow
+	err := query.Order("row_no ASC").Limit(filter.Limit).Offset(filter.Offset).Find(&rows).Error
+	return rows, total, err
+}
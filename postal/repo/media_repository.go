@@ -0,0 +1,163 @@
+package repo
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"postal/domain"
+	"postal/media"
+
+	"gorm.io/gorm"
+)
+
+// assetInUse matches a link to a post row, soft-deleted posts still hold their
+// assets since they can be restored
+const assetInUse = "EXISTS (SELECT 1 FROM post_assets JOIN posts ON posts.id = post_assets.post_id WHERE post_assets.asset_id = assets.id)"
+
+type mediaRepository struct {
+	db *gorm.DB
+}
+
+func NewMediaRepository(db *gorm.DB) media.Repository {
+	return &mediaRepository{db: db}
+}
+
+func (r *mediaRepository) Create(ctx context.Context, asset *domain.Asset) error {
+	return r.db.WithContext(ctx).Create(asset).Error
+}
+
+func (r *mediaRepository) GetByID(ctx context.Context, id uint) (*domain.Asset, error) {
+	var asset domain.Asset
+	err := r.db.WithContext(ctx).First(&asset, id).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, media.ErrAssetNotFound
+		}
+		return nil, err
+	}
+	return &asset, nil
+}
+
+// FindByChecksum returns the asset of a tenant with the same content, nil when there is none
+func (r *mediaRepository) FindByChecksum(ctx context.Context, tenant, checksum string) (*domain.Asset, error) {
+	var asset domain.Asset
+	err := r.db.WithContext(ctx).
+		Where("tenant = ? AND checksum = ?", tenant, checksum).
+		First(&asset).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return &asset, nil
+}
+
+// Touch restarts the grace period of an asset, false when it no longer exists
+func (r *mediaRepository) Touch(ctx context.Context, id uint) (bool, error) {
+	result := r.db.WithContext(ctx).Model(&domain.Asset{}).
+		Where("id = ?", id).
+		UpdateColumn("created_at", time.Now())
+	return result.RowsAffected == 1, result.Error
+}
+
+func (r *mediaRepository) List(ctx context.Context, filter media.AssetFilter) ([]*domain.Asset, int64, error) {
+	query := r.db.WithContext(ctx).Model(&domain.Asset{}).Where("tenant = ?", filter.Tenant)
+	if filter.UploadedBy != nil {
+		query = query.Where("uploaded_by = ?", *filter.UploadedBy)
+	}
+
+	var total int64
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+
+	var assets []*domain.Asset
+	err := query.Order("created_at DESC, id DESC").
+		Limit(filter.Limit).
+		Offset(filter.Offset).
+		Find(&assets).Error
+	return assets, total, err
+}
+
+func (r *mediaRepository) ListByPost(ctx context.Context, postID uint) ([]*domain.Asset, error) {
+	var assets []*domain.Asset
+	err := r.db.WithContext(ctx).
+		Joins("JOIN post_assets ON post_assets.asset_id = assets.id").
+		Where("post_assets.post_id = ?", postID).
+		Order("assets.id").
+		Find(&assets).Error
+	return assets, err
+}
+
+func (r *mediaRepository) IsLinked(ctx context.Context, id uint) (bool, error) {
+	var count int64
+	err := r.db.WithContext(ctx).Model(&domain.Asset{}).
+		Where("id = ? AND "+assetInUse, id).
+		Count(&count).Error
+	return count > 0, err
+}
+
+// ReplacePostAssets links a post to the assets with the given UUIDs, dropping its other links
+func (r *mediaRepository) ReplacePostAssets(ctx context.Context, postID uint, uuids []string) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Where("post_id = ?", postID).Delete(&domain.PostAsset{}).Error; err != nil {
+			return err
+		}
+		if len(uuids) == 0 {
+			return nil
+		}
+		return tx.Exec(
+			"INSERT INTO post_assets (post_id, asset_id, created_at) SELECT ?, id, ? FROM assets WHERE uuid IN ?",
+			postID, time.Now(), uuids,
+		).Error
+	})
+}
+
+func (r *mediaRepository) Usage(ctx context.Context, tenant string) (*media.UsageResponse, error) {
+	usage := &media.UsageResponse{Tenant: tenant}
+	err := r.db.WithContext(ctx).Model(&domain.Asset{}).
+		Select("COUNT(*) AS assets, COALESCE(SUM(total_size), 0) AS bytes").
+		Where("tenant = ?", tenant).
+		Row().Scan(&usage.Assets, &usage.Bytes)
+	return usage, err
+}
+
+// ListOrphans returns assets created before the cutoff that no post uses
+func (r *mediaRepository) ListOrphans(ctx context.Context, createdBefore time.Time, limit int) ([]*domain.Asset, error) {
+	var assets []*domain.Asset
+	err := r.db.WithContext(ctx).
+		Where("created_at < ? AND NOT "+assetInUse, createdBefore).
+		Order("id").
+		Limit(limit).
+		Find(&assets).Error
+	return assets, err
+}
+
+// DeleteIfOrphan deletes an asset created before the cutoff and its dangling
+// links unless a post uses it
+func (r *mediaRepository) DeleteIfOrphan(ctx context.Context, id uint, createdBefore time.Time) (bool, error) {
+	deleted := false
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		result := tx.Where("id = ? AND created_at < ? AND NOT "+assetInUse, id, createdBefore).Delete(&domain.Asset{})
+		if result.Error != nil {
+			return result.Error
+		}
+		deleted = result.RowsAffected == 1
+		if !deleted {
+			return nil
+		}
+		return tx.Where("asset_id = ?", id).Delete(&domain.PostAsset{}).Error
+	})
+	return deleted, err
+}
+
+func (r *mediaRepository) Delete(ctx context.Context, id uint) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Where("asset_id = ?", id).Delete(&domain.PostAsset{}).Error; err != nil {
+			return err
+		}
+		return tx.Delete(&domain.Asset{}, id).Error
+	})
+}
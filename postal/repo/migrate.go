@@ -4,6 +4,7 @@ import (
 	"log"
 
 	"postal/domain"
+	"postal/util"
 
 	"gorm.io/gorm"
 )
@@ -11,15 +12,166 @@ import (
 func AutoMigrate(db *gorm.DB) error {
 	log.Println("🔄 Running database migrations...")
 
+	// Use the explicit join model so post_tags gets its tag_id index and timestamps
+	if err := db.SetupJoinTable(&domain.Post{}, "Tags", &domain.PostTag{}); err != nil {
+		log.Printf("❌ Migration failed: %v", err)
+		return err
+	}
+
 	err := db.AutoMigrate(
+		&domain.Tag{},
 		&domain.Post{},
+		&domain.PostTag{},
 		&domain.PostVersion{},
+		&domain.PostSlugHistory{},
+		&domain.PostViewStat{},
+		&domain.Comment{},
+		&domain.CommentSettings{},
+		&domain.PostReaction{},
+		&domain.PostBookmark{},
+		&domain.PostTermVector{},
+		&domain.PostRelated{},
+		&domain.Series{},
+		&domain.SeriesPost{},
+		&domain.PostAuthor{},
+		&domain.PostPreviewLink{},
+		&domain.Asset{},
+		&domain.PostAsset{},
+		&domain.PostSource{},
+		&domain.ImportJob{},
+		&domain.ImportJobRow{},
 	)
 	if err != nil {
 		log.Printf("❌ Migration failed: %v", err)
 		return err
 	}
 
+	if err := migrateSearchVector(db); err != nil {
+		log.Printf("❌ Search vector migration failed: %v", err)
+		return err
+	}
+
+	if err := pruneOrphanSlugHistory(db); err != nil {
+		log.Printf("❌ Slug history cleanup failed: %v", err)
+		return err
+	}
+
+	if err := runOnce(db, "backfill_keyword_tags", backfillKeywordTags); err != nil {
+		log.Printf("❌ Keyword tags backfill failed: %v", err)
+		return err
+	}
+
+	if err := backfillPostAuthors(db); err != nil {
+		log.Printf("❌ Post authors backfill failed: %v", err)
+		return err
+	}
+
 	log.Println("✅ Migrations completed successfully")
 	return nil
 }
+
+// migrateSearchVector adds the full-text search column of posts. It is a generated
+// column so Postgres keeps it in sync on every write, weighting title over summary
+// over content, and is left out of domain.Post so GORM never writes it.
+func migrateSearchVector(db *gorm.DB) error {
+	statements := []string{
+		`ALTER TABLE posts ADD COLUMN IF NOT EXISTS search_vector tsvector
+			GENERATED ALWAYS AS (
+				setweight(to_tsvector('` + searchConfig + `', coalesce(title, '')), 'A') ||
+				setweight(to_tsvector('` + searchConfig + `', coalesce(summary, '')), 'B') ||
+				setweight(to_tsvector('` + searchConfig + `', coalesce(content, '')), 'C')
+			) STORED`,
+		`CREATE INDEX IF NOT EXISTS idx_posts_search_vector ON posts USING GIN (search_vector)`,
+	}
+
+	for _, statement := range statements {
+		if err := db.Exec(statement).Error; err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// pruneOrphanSlugHistory drops the old slugs of posts that were hard deleted
+// before HardDelete cleaned them up
+func pruneOrphanSlugHistory(db *gorm.DB) error {
+	result := db.Exec(`DELETE FROM post_slug_histories
+		WHERE NOT EXISTS (SELECT 1 FROM posts WHERE posts.id = post_slug_histories.post_id)`)
+	if result.Error != nil {
+		return result.Error
+	}
+
+	if result.RowsAffected > 0 {
+		log.Printf("✅ Removed %d slug history rows of deleted posts", result.RowsAffected)
+	}
+	return nil
+}
+
+// runOnce runs a data migration the first time it is seen, the migrations table
+// records it in the same transaction so a failed run is retried on the next start
+func runOnce(db *gorm.DB, name string, migrate func(tx *gorm.DB) error) error {
+	if err := db.Exec(`CREATE TABLE IF NOT EXISTS data_migrations (
+		name text PRIMARY KEY,
+		applied_at timestamptz NOT NULL DEFAULT now()
+	)`).Error; err != nil {
+		return err
+	}
+
+	return db.Transaction(func(tx *gorm.DB) error {
+		result := tx.Exec(`INSERT INTO data_migrations (name) VALUES (?) ON CONFLICT DO NOTHING`, name)
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			return nil
+		}
+		return migrate(tx)
+	})
+}
+
+// backfillKeywordTags turns the comma separated keywords of untagged posts into
+// tags. It runs once, posts untagged on purpose afterwards stay untagged.
+func backfillKeywordTags(db *gorm.DB) error {
+	var posts []*domain.Post
+	migrated := 0
+
+	result := db.Model(&domain.Post{}).
+		Select("id", "keywords").
+		Where("keywords <> '' AND NOT EXISTS (SELECT 1 FROM post_tags WHERE post_tags.post_id = posts.id)").
+		FindInBatches(&posts, 500, func(_ *gorm.DB, batch int) error {
+			for _, post := range posts {
+				tags, err := setPostTags(db, post.ID, util.ParseKeywords(post.Keywords))
+				if err != nil {
+					return err
+				}
+				if len(tags) > 0 {
+					migrated++
+				}
+			}
+			return nil
+		})
+	if result.Error != nil {
+		return result.Error
+	}
+
+	if migrated > 0 {
+		log.Printf("✅ Migrated keywords of %d posts into tags", migrated)
+	}
+	return nil
+}
+
+// backfillPostAuthors credits the creator of posts made before post_authors existed
+func backfillPostAuthors(db *gorm.DB) error {
+	result := db.Exec(`INSERT INTO post_authors (post_id, user_id, role, added_by, created_at, updated_at)
+		SELECT id, created_by, ?, created_by, created_at, created_at FROM posts
+		WHERE created_by <> 0 AND NOT EXISTS (SELECT 1 FROM post_authors WHERE post_authors.post_id = posts.id)
+		ON CONFLICT DO NOTHING`, domain.AuthorRoleAuthor)
+	if result.Error != nil {
+		return result.Error
+	}
+
+	if result.RowsAffected > 0 {
+		log.Printf("✅ Credited the creators of %d posts as authors", result.RowsAffected)
+	}
+	return nil
+}
@@ -4,11 +4,15 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"sort"
+	"time"
 
 	"postal/domain"
 	"postal/post"
+	"postal/util"
 
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 )
 
 type postRepository struct {
@@ -30,12 +34,15 @@ func (r *postRepository) WithTransaction(ctx context.Context, fn func(txRepo pos
 	})
 }
 
+// errPostNotFound is post.ErrPostNotFound for the lookups below, whose local post shadows the package
+var errPostNotFound = post.ErrPostNotFound
+
 func (r *postRepository) GetByID(ctx context.Context, id uint) (*domain.Post, error) {
 	var post domain.Post
-	err := r.db.WithContext(ctx).First(&post, id).Error
+	err := r.db.WithContext(ctx).Preload("Tags", orderTags).First(&post, id).Error
 	if err != nil {
 		if errors.Is(err, gorm.ErrRecordNotFound) {
-			return nil, fmt.Errorf("post not found")
+			return nil, errPostNotFound
 		}
 		return nil, err
 	}
@@ -44,10 +51,10 @@ func (r *postRepository) GetByID(ctx context.Context, id uint) (*domain.Post, er
 
 func (r *postRepository) GetByUUID(ctx context.Context, uuid string) (*domain.Post, error) {
 	var post domain.Post
-	err := r.db.WithContext(ctx).Where("uuid = ?", uuid).First(&post).Error
+	err := r.db.WithContext(ctx).Preload("Tags", orderTags).Where("uuid = ?", uuid).First(&post).Error
 	if err != nil {
 		if errors.Is(err, gorm.ErrRecordNotFound) {
-			return nil, fmt.Errorf("post not found")
+			return nil, errPostNotFound
 		}
 		return nil, err
 	}
@@ -56,10 +63,10 @@ func (r *postRepository) GetByUUID(ctx context.Context, uuid string) (*domain.Po
 
 func (r *postRepository) GetBySlug(ctx context.Context, slug string) (*domain.Post, error) {
 	var post domain.Post
-	err := r.db.WithContext(ctx).Where("slug = ? AND status = ?", slug, domain.StatusPublished).First(&post).Error
+	err := r.db.WithContext(ctx).Preload("Tags", orderTags).Where("slug = ? AND status = ?", slug, domain.StatusPublished).First(&post).Error
 	if err != nil {
 		if errors.Is(err, gorm.ErrRecordNotFound) {
-			return nil, fmt.Errorf("post not found")
+			return nil, errPostNotFound
 		}
 		return nil, err
 	}
@@ -70,32 +77,7 @@ func (r *postRepository) List(ctx context.Context, filter post.PostFilter, withC
 	var posts []*domain.Post
 	var total int64
 
-	baseQuery := r.db.WithContext(ctx).Model(&domain.Post{})
-
-	// Apply filters
-	if filter.Status != nil {
-		baseQuery = baseQuery.Where("status = ?", *filter.Status)
-	}
-	if filter.CategoryID != nil {
-		baseQuery = baseQuery.Where("category_id = ?", *filter.CategoryID)
-	}
-	if filter.SubCategoryID != nil {
-		baseQuery = baseQuery.Where("sub_category_id = ?", *filter.SubCategoryID)
-	}
-	if filter.IsFeatured != nil {
-		baseQuery = baseQuery.Where("is_featured = ?", *filter.IsFeatured)
-	}
-	if filter.IsPinned != nil {
-		baseQuery = baseQuery.Where("is_pinned = ?", *filter.IsPinned)
-	}
-	if filter.IsPublic != nil {
-		baseQuery = baseQuery.Where("is_public = ?", *filter.IsPublic)
-	}
-	if filter.Search != nil && *filter.Search != "" {
-		searchTerm := "%" + *filter.Search + "%"
-		baseQuery = baseQuery.Where("title ILIKE ? OR content ILIKE ? OR summary ILIKE ?", searchTerm, searchTerm, searchTerm)
-	}
-
+	baseQuery := applyPostFilter(r.db.WithContext(ctx).Model(&domain.Post{}), filter)
 	if err := baseQuery.Count(&total).Error; err != nil {
 		return nil, 0, err
 	}
@@ -112,28 +94,7 @@ func (r *postRepository) List(ctx context.Context, filter post.PostFilter, withC
 	}
 
 	// Apply same filters
-	if filter.Status != nil {
-		selectQuery = selectQuery.Where("status = ?", *filter.Status)
-	}
-	if filter.CategoryID != nil {
-		selectQuery = selectQuery.Where("category_id = ?", *filter.CategoryID)
-	}
-	if filter.SubCategoryID != nil {
-		selectQuery = selectQuery.Where("sub_category_id = ?", *filter.SubCategoryID)
-	}
-	if filter.IsFeatured != nil {
-		selectQuery = selectQuery.Where("is_featured = ?", *filter.IsFeatured)
-	}
-	if filter.IsPinned != nil {
-		selectQuery = selectQuery.Where("is_pinned = ?", *filter.IsPinned)
-	}
-	if filter.IsPublic != nil {
-		selectQuery = selectQuery.Where("is_public = ?", *filter.IsPublic)
-	}
-	if filter.Search != nil && *filter.Search != "" {
-		searchTerm := "%" + *filter.Search + "%"
-		selectQuery = selectQuery.Where("title ILIKE ? OR content ILIKE ? OR summary ILIKE ?", searchTerm, searchTerm, searchTerm)
-	}
+	selectQuery = applyPostFilter(selectQuery, filter).Preload("Tags", orderTags)
 
 	sortBy := "created_at"
 	if filter.SortBy != "" {
@@ -156,16 +117,86 @@ func (r *postRepository) List(ctx context.Context, filter post.PostFilter, withC
 	return posts, total, err
 }
 
-func (r *postRepository) Update(ctx context.Context, post *domain.Post) error {
-	return r.db.WithContext(ctx).Save(post).Error
+// ListAfter returns up to limit posts matching the filter with an ID above
+// afterID, by ID. Unlike List it doesn't count the matches, paging through a
+// large table costs one indexed range scan per page.
+func (r *postRepository) ListAfter(ctx context.Context, filter post.PostFilter, afterID uint, limit int) ([]*domain.Post, error) {
+	var posts []*domain.Post
+	err := applyPostFilter(r.db.WithContext(ctx).Model(&domain.Post{}), filter).
+		Preload("Tags", orderTags).
+		Where("id > ?", afterID).
+		Order("id ASC").
+		Limit(limit).
+		Find(&posts).Error
+	return posts, err
+}
+
+// applyPostFilter narrows a posts query to the filter, its paging and sorting
+// are left to the caller
+func applyPostFilter(query *gorm.DB, filter post.PostFilter) *gorm.DB {
+	if filter.Status != nil {
+		query = query.Where("status = ?", *filter.Status)
+	}
+	if filter.CategoryID != nil {
+		query = query.Where("category_id = ?", *filter.CategoryID)
+	}
+	if filter.SubCategoryID != nil {
+		query = query.Where("sub_category_id = ?", *filter.SubCategoryID)
+	}
+	if filter.IsFeatured != nil {
+		query = query.Where("is_featured = ?", *filter.IsFeatured)
+	}
+	if filter.IsPinned != nil {
+		query = query.Where("is_pinned = ?", *filter.IsPinned)
+	}
+	if filter.IsPublic != nil {
+		query = query.Where("is_public = ?", *filter.IsPublic)
+	}
+	if filter.Search != nil && *filter.Search != "" {
+		if terms := util.ParseSearchQuery(*filter.Search); len(terms) > 0 {
+			tsQuery, args := buildTSQuery(terms)
+			query = query.Where("search_vector @@ "+tsQuery, args...)
+		}
+	}
+
+	query = applyAuthorFilter(query, filter)
+	return applyTagFilter(query, filter)
+}
+
+// Update saves the given columns of the post and bumps its version, post.Version
+// is set to the stored one. Columns not listed keep what concurrent writes stored.
+func (r *postRepository) Update(ctx context.Context, post *domain.Post, columns ...string) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		// Tags are replaced through SetPostTags only
+		err := tx.Model(post).
+			Select(append(columns, "updated_at")).
+			Omit(clause.Associations).
+			Updates(post).Error
+		if err != nil {
+			return err
+		}
+		return tx.Raw(`UPDATE posts SET version = version + 1 WHERE id = ? RETURNING version`, post.ID).
+			Scan(&post.Version).Error
+	})
+}
+
+// orderTags preloads the tags of a post by name
+func orderTags(db *gorm.DB) *gorm.DB {
+	return db.Order("tags.name ASC")
 }
 
 func (r *postRepository) Delete(ctx context.Context, id uint) error {
 	return r.db.WithContext(ctx).Delete(&domain.Post{}, id).Error
 }
 
+// HardDelete removes a post for good, together with the old slugs that redirected to it
 func (r *postRepository) HardDelete(ctx context.Context, id uint) error {
-	return r.db.WithContext(ctx).Unscoped().Delete(&domain.Post{}, id).Error
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Where("post_id = ?", id).Delete(&domain.PostSlugHistory{}).Error; err != nil {
+			return err
+		}
+		return tx.Unscoped().Delete(&domain.Post{}, id).Error
+	})
 }
 
 func (r *postRepository) BatchDeleteByUUIDs(ctx context.Context, uuids []string) error {
@@ -248,3 +279,166 @@ func (r *postRepository) GetMaxOrderNo(ctx context.Context) (uint, error) {
 
 	return maxOrderNo, nil
 }
+
+// CreateVersion saves a version of a post, in a transaction together with the post write
+func (r *postRepository) CreateVersion(ctx context.Context, version *domain.PostVersion) error {
+	return r.db.WithContext(ctx).Create(version).Error
+}
+
+func (r *postRepository) AddSlugHistory(ctx context.Context, history *domain.PostSlugHistory) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		// A previous slug can only point to one post, the latest rename wins
+		if err := tx.Where("slug = ?", history.Slug).Delete(&domain.PostSlugHistory{}).Error; err != nil {
+			return err
+		}
+		return tx.Create(history).Error
+	})
+}
+
+func (r *postRepository) FindSlugHistory(ctx context.Context, slug string) (*domain.PostSlugHistory, error) {
+	var history domain.PostSlugHistory
+	err := r.db.WithContext(ctx).Where("slug = ?", slug).First(&history).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, fmt.Errorf("slug history not found")
+		}
+		return nil, err
+	}
+	return &history, nil
+}
+
+// AddViews adds views to the view count of the posts and to their daily stats.
+// Posts are updated in id order so concurrent flushes take row locks in the same order.
+func (r *postRepository) AddViews(ctx context.Context, increments []post.ViewIncrement) error {
+	sorted := make([]post.ViewIncrement, len(increments))
+	copy(sorted, increments)
+	sort.Slice(sorted, func(i, j int) bool {
+		if sorted[i].PostID != sorted[j].PostID {
+			return sorted[i].PostID < sorted[j].PostID
+		}
+		return sorted[i].Day.Before(sorted[j].Day)
+	})
+
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		for _, increment := range sorted {
+			err := tx.Model(&domain.Post{}).
+				Where("id = ?", increment.PostID).
+				UpdateColumn("view_count", gorm.Expr("view_count + ?", increment.Count)).Error
+			if err != nil {
+				return err
+			}
+
+			err = tx.Exec(`
+				INSERT INTO post_view_stats (post_id, category_id, sub_category_id, day, count)
+				SELECT id, category_id, sub_category_id, ?, ? FROM posts WHERE id = ? AND deleted_at IS NULL
+				ON CONFLICT (post_id, day) DO UPDATE SET
+					count = post_view_stats.count + EXCLUDED.count,
+					category_id = EXCLUDED.category_id,
+					sub_category_id = EXCLUDED.sub_category_id`,
+				increment.Day.Format("2006-01-02"), increment.Count, increment.PostID).Error
+			if err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+func (r *postRepository) ListViewStats(ctx context.Context, filter post.ViewStatFilter) ([]*domain.PostViewStat, error) {
+	query := r.db.WithContext(ctx).Model(&domain.PostViewStat{})
+
+	if filter.CategoryID != nil {
+		query = query.Where("category_id = ? OR sub_category_id = ?", *filter.CategoryID, *filter.CategoryID)
+	}
+	if !filter.Since.IsZero() {
+		query = query.Where("day >= ?", filter.Since.Format("2006-01-02"))
+	}
+
+	var stats []*domain.PostViewStat
+	if err := query.Order("day ASC, post_id ASC").Find(&stats).Error; err != nil {
+		return nil, err
+	}
+	return stats, nil
+}
+
+func (r *postRepository) ListDueForPublish(ctx context.Context, now time.Time, limit int) ([]*domain.Post, error) {
+	var posts []*domain.Post
+	err := r.db.WithContext(ctx).
+		Where("status = ? AND publish_at <= ?", domain.StatusScheduled, now).
+		Order("publish_at ASC").
+		Limit(limit).
+		Find(&posts).Error
+	return posts, err
+}
+
+func (r *postRepository) ListDueForUnpublish(ctx context.Context, now time.Time, limit int) ([]*domain.Post, error) {
+	var posts []*domain.Post
+	err := r.db.WithContext(ctx).
+		Where("status = ? AND unpublish_at <= ?", domain.StatusPublished, now).
+		Order("unpublish_at ASC").
+		Limit(limit).
+		Find(&posts).Error
+	return posts, err
+}
+
+// ListScheduled returns posts waiting to be published and published posts
+// with an unpublish time, ordered by whichever transition comes first
+func (r *postRepository) ListScheduled(ctx context.Context, limit, offset int) ([]*domain.Post, int64, error) {
+	query := r.db.WithContext(ctx).Model(&domain.Post{}).
+		Where("status = ? OR (status = ? AND unpublish_at IS NOT NULL)", domain.StatusScheduled, domain.StatusPublished)
+
+	var total int64
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+
+	var posts []*domain.Post
+	selectQuery := query.
+		Select("id, uuid, title, slug, category_id, sub_category_id, status, publish_at, unpublish_at, published_at, updated_by, created_by, created_at, updated_at").
+		Order("COALESCE(publish_at, unpublish_at) ASC, id ASC")
+	if limit > 0 {
+		selectQuery = selectQuery.Limit(limit)
+	}
+	if offset > 0 {
+		selectQuery = selectQuery.Offset(offset)
+	}
+
+	err := selectQuery.Find(&posts).Error
+	return posts, total, err
+}
+
+// UpdateIfVersion saves the given columns and post.Version only while the stored
+// row is still at version, reporting false when another update got there first
+func (r *postRepository) UpdateIfVersion(ctx context.Context, post *domain.Post, version int, columns ...string) (bool, error) {
+	// Selected columns are written even when zero, the others are left alone
+	result := r.db.WithContext(ctx).Model(post).
+		Select(append(columns, "version", "updated_at")).
+		Omit(clause.Associations).
+		Where("version = ?", version).
+		Updates(post)
+	if result.Error != nil {
+		return false, result.Error
+	}
+	return result.RowsAffected == 1, nil
+}
+
+// TransitionStatus saves the status and scheduling fields of the post only if
+// it still has the expected status, so a transition is applied exactly once.
+// It bumps the version like any other change and sets post.Version to it.
+func (r *postRepository) TransitionStatus(ctx context.Context, post *domain.Post, from domain.PostStatus) (bool, error) {
+	var versions []int
+	result := r.db.WithContext(ctx).Raw(`UPDATE posts
+		SET status = ?, published_at = ?, publish_at = ?, unpublish_at = ?, updated_at = ?, version = version + 1
+		WHERE id = ? AND status = ? AND deleted_at IS NULL
+		RETURNING version`,
+		post.Status, post.PublishedAt, post.PublishAt, post.UnpublishAt, time.Now(), post.ID, from).
+		Scan(&versions)
+	if result.Error != nil {
+		return false, result.Error
+	}
+	if len(versions) != 1 {
+		return false, nil
+	}
+	post.Version = versions[0]
+	return true, nil
+}
@@ -0,0 +1,100 @@
+package repo
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"postal/domain"
+	"postal/post"
+	"postal/util"
+)
+
+// searchConfig is the text search configuration of the search_vector column,
+// queries must use the same one to match its lexemes
+const searchConfig = "english"
+
+// headlineOptions mark matches with sentinels instead of HTML, the service escapes
+// the text before turning them into <mark> tags
+const headlineOptions = `StartSel="` + post.HighlightStart + `", StopSel="` + post.HighlightStop + `"`
+
+// buildTSQuery turns parsed search terms into a tsquery expression and its arguments.
+// Every term must match, excluded terms must not.
+func buildTSQuery(terms []util.SearchTerm) (string, []interface{}) {
+	var parts []string
+	var args []interface{}
+
+	for _, term := range terms {
+		var expr string
+		switch {
+		case term.Phrase:
+			expr = "phraseto_tsquery('" + searchConfig + "', ?)"
+			args = append(args, term.Text)
+		case term.Prefix:
+			expr = "to_tsquery('" + searchConfig + "', ?)"
+			args = append(args, term.Text+":*")
+		default:
+			expr = "plainto_tsquery('" + searchConfig + "', ?)"
+			args = append(args, term.Text)
+		}
+		if term.Exclude {
+			expr = "!!" + expr
+		}
+		parts = append(parts, expr)
+	}
+
+	return "(" + strings.Join(parts, " && ") + ")", args
+}
+
+func (r *postRepository) Search(ctx context.Context, filter post.SearchFilter) ([]*post.PostSearchResult, int64, error) {
+	tsQuery, queryArgs := buildTSQuery(filter.Terms)
+
+	where := "p.search_vector @@ q.query AND p.deleted_at IS NULL AND p.status = ? AND p.is_public = ?"
+	whereArgs := []interface{}{domain.StatusPublished, true}
+	if filter.CategoryID != nil {
+		where += " AND p.category_id = ?"
+		whereArgs = append(whereArgs, *filter.CategoryID)
+	}
+	if filter.SubCategoryID != nil {
+		where += " AND p.sub_category_id = ?"
+		whereArgs = append(whereArgs, *filter.SubCategoryID)
+	}
+
+	args := append(append([]interface{}{}, queryArgs...), whereArgs...)
+
+	var total int64
+	countSQL := fmt.Sprintf("WITH q AS (SELECT %s AS query) SELECT COUNT(*) FROM posts p, q WHERE %s", tsQuery, where)
+	if err := r.db.WithContext(ctx).Raw(countSQL, args...).Scan(&total).Error; err != nil {
+		return nil, 0, err
+	}
+	if total == 0 {
+		return []*post.PostSearchResult{}, 0, nil
+	}
+
+	// Rank and paginate on the index first, headlines are only built for the returned page
+	searchSQL := fmt.Sprintf(`WITH q AS (SELECT %s AS query),
+hits AS (
+	SELECT p.id, ts_rank_cd(p.search_vector, q.query, 32) AS rank
+	FROM posts p, q
+	WHERE %s
+	ORDER BY rank DESC, p.published_at DESC NULLS LAST, p.id DESC
+	LIMIT ? OFFSET ?
+)
+SELECT p.id, p.uuid, p.slug, p.title, p.summary, p.category_id, p.sub_category_id,
+	p.status, p.published_at, p.view_count, p.created_at, hits.rank,
+	ts_headline('%s', p.title, q.query, '%s, HighlightAll=true') AS title_highlight,
+	ts_headline('%s', p.content, q.query, '%s, MaxFragments=2, MaxWords=30, MinWords=10, FragmentDelimiter=" … "') AS snippet
+FROM hits
+JOIN posts p ON p.id = hits.id
+CROSS JOIN q
+ORDER BY hits.rank DESC, p.published_at DESC NULLS LAST, p.id DESC`,
+		tsQuery, where, searchConfig, headlineOptions, searchConfig, headlineOptions)
+
+	args = append(args, filter.Limit, filter.Offset)
+
+	var results []*post.PostSearchResult
+	if err := r.db.WithContext(ctx).Raw(searchSQL, args...).Scan(&results).Error; err != nil {
+		return nil, 0, err
+	}
+	return results, total, nil
+}
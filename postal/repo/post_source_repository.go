@@ -0,0 +1,32 @@
+package repo
+
+import (
+	"context"
+	"errors"
+
+	"postal/domain"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// GetPostSource returns the import record of a source file, nil when it was never imported
+func (r *postRepository) GetPostSource(ctx context.Context, source string) (*domain.PostSource, error) {
+	var postSource domain.PostSource
+	err := r.db.WithContext(ctx).Where("source = ?", source).First(&postSource).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return &postSource, nil
+}
+
+// SavePostSource records the post and content hash of a source file
+func (r *postRepository) SavePostSource(ctx context.Context, postSource *domain.PostSource) error {
+	return r.db.WithContext(ctx).Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "source"}},
+		DoUpdates: clause.AssignmentColumns([]string{"post_id", "content_hash", "updated_at"}),
+	}).Create(postSource).Error
+}
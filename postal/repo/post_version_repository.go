@@ -2,8 +2,10 @@ package repo
 
 import (
 	"context"
+	"errors"
 
 	"postal/domain"
+	"postal/post"
 	"postal/post_version"
 
 	"gorm.io/gorm"
@@ -35,3 +37,31 @@ func (r *postVersionRepository) GetByID(ctx context.Context, id uint) (*domain.P
 	err := r.db.WithContext(ctx).First(&version, id).Error
 	return &version, err
 }
+
+func (r *postVersionRepository) GetByPostIDAndVersion(ctx context.Context, postID uint, versionNo int) (*domain.PostVersion, error) {
+	var version domain.PostVersion
+	err := r.db.WithContext(ctx).
+		Where("post_id = ? AND version_no = ?", postID, versionNo).
+		Order("id DESC").
+		First(&version).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, post.ErrVersionNotFound
+		}
+		return nil, err
+	}
+	return &version, nil
+}
+
+// ListContributors groups the versions of a post by editor, first contributor first
+func (r *postVersionRepository) ListContributors(ctx context.Context, postID uint) ([]*post_version.Contributor, error) {
+	var contributors []*post_version.Contributor
+	err := r.db.WithContext(ctx).
+		Model(&domain.PostVersion{}).
+		Select("edited_by AS user_id, COUNT(*) AS edits, MIN(created_at) AS first_edit_at, MAX(created_at) AS last_edit_at").
+		Where("post_id = ? AND edited_by <> 0", postID).
+		Group("edited_by").
+		Order("first_edit_at ASC").
+		Scan(&contributors).Error
+	return contributors, err
+}
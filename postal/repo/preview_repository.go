@@ -0,0 +1,68 @@
+package repo
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"postal/domain"
+	"postal/preview"
+
+	"gorm.io/gorm"
+)
+
+type previewRepository struct {
+	db *gorm.DB
+}
+
+func NewPreviewRepository(db *gorm.DB) preview.Repository {
+	return &previewRepository{db: db}
+}
+
+func (r *previewRepository) Create(ctx context.Context, link *domain.PostPreviewLink) error {
+	return r.db.WithContext(ctx).Create(link).Error
+}
+
+// GetByUUID returns the link a token points at, nil when there is none
+func (r *previewRepository) GetByUUID(ctx context.Context, uuid string) (*domain.PostPreviewLink, error) {
+	var link domain.PostPreviewLink
+	err := r.db.WithContext(ctx).Where("uuid = ?", uuid).First(&link).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return &link, nil
+}
+
+// ListActive returns the links of a post that are neither revoked nor expired
+func (r *previewRepository) ListActive(ctx context.Context, postID uint, now time.Time) ([]*domain.PostPreviewLink, error) {
+	var links []*domain.PostPreviewLink
+	err := r.db.WithContext(ctx).
+		Where("post_id = ? AND revoked_at IS NULL AND expires_at > ?", postID, now).
+		Order("created_at DESC").
+		Find(&links).Error
+	return links, err
+}
+
+// Revoke revokes one outstanding link of a post, reporting whether there was one
+func (r *previewRepository) Revoke(ctx context.Context, postID, linkID, userID uint, now time.Time) (bool, error) {
+	result := r.db.WithContext(ctx).Model(&domain.PostPreviewLink{}).
+		Where("id = ? AND post_id = ? AND revoked_at IS NULL AND expires_at > ?", linkID, postID, now).
+		Updates(map[string]interface{}{
+			"revoked_at": now,
+			"revoked_by": userID,
+		})
+	return result.RowsAffected == 1, result.Error
+}
+
+func (r *previewRepository) RevokeAll(ctx context.Context, postID, userID uint, now time.Time) (int64, error) {
+	result := r.db.WithContext(ctx).Model(&domain.PostPreviewLink{}).
+		Where("post_id = ? AND revoked_at IS NULL AND expires_at > ?", postID, now).
+		Updates(map[string]interface{}{
+			"revoked_at": now,
+			"revoked_by": userID,
+		})
+	return result.RowsAffected, result.Error
+}
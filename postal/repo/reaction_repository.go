@@ -0,0 +1,123 @@
+package repo
+
+import (
+	"context"
+
+	"postal/domain"
+	"postal/post"
+
+	"gorm.io/gorm/clause"
+)
+
+func (r *postRepository) AddReaction(ctx context.Context, reaction *domain.PostReaction) (bool, error) {
+	result := r.db.WithContext(ctx).Clauses(clause.OnConflict{DoNothing: true}).Create(reaction)
+	return result.RowsAffected == 1, result.Error
+}
+
+func (r *postRepository) RemoveReaction(ctx context.Context, postID, userID uint, reactionType domain.ReactionType) (bool, error) {
+	result := r.db.WithContext(ctx).
+		Where("post_id = ? AND user_id = ? AND type = ?", postID, userID, reactionType).
+		Delete(&domain.PostReaction{})
+	return result.RowsAffected > 0, result.Error
+}
+
+func (r *postRepository) ListUserReactions(ctx context.Context, postID, userID uint) ([]domain.ReactionType, error) {
+	var reactions []domain.ReactionType
+	err := r.db.WithContext(ctx).Model(&domain.PostReaction{}).
+		Where("post_id = ? AND user_id = ?", postID, userID).
+		Order("type ASC").
+		Pluck("type", &reactions).Error
+	return reactions, err
+}
+
+func (r *postRepository) AddBookmark(ctx context.Context, bookmark *domain.PostBookmark) (bool, error) {
+	result := r.db.WithContext(ctx).Clauses(clause.OnConflict{DoNothing: true}).Create(bookmark)
+	return result.RowsAffected == 1, result.Error
+}
+
+func (r *postRepository) RemoveBookmark(ctx context.Context, postID, userID uint) (bool, error) {
+	result := r.db.WithContext(ctx).
+		Where("post_id = ? AND user_id = ?", postID, userID).
+		Delete(&domain.PostBookmark{})
+	return result.RowsAffected > 0, result.Error
+}
+
+func (r *postRepository) IsBookmarked(ctx context.Context, postID, userID uint) (bool, error) {
+	var count int64
+	err := r.db.WithContext(ctx).Model(&domain.PostBookmark{}).
+		Where("post_id = ? AND user_id = ?", postID, userID).
+		Count(&count).Error
+	return count > 0, err
+}
+
+func (r *postRepository) CountEngagement(ctx context.Context, postIDs []uint) (map[uint]*post.Engagement, error) {
+	counts := make(map[uint]*post.Engagement, len(postIDs))
+	for _, id := range postIDs {
+		counts[id] = post.NewEngagement()
+	}
+
+	var reactionRows []struct {
+		PostID uint
+		Type   domain.ReactionType
+		Count  int64
+	}
+	if err := r.db.WithContext(ctx).Model(&domain.PostReaction{}).
+		Select("post_id, type, COUNT(*) AS count").
+		Where("post_id IN ?", postIDs).
+		Group("post_id, type").
+		Scan(&reactionRows).Error; err != nil {
+		return nil, err
+	}
+	for _, row := range reactionRows {
+		counts[row.PostID].Reactions[row.Type] = row.Count
+	}
+
+	var bookmarkRows []struct {
+		PostID uint
+		Count  int64
+	}
+	if err := r.db.WithContext(ctx).Model(&domain.PostBookmark{}).
+		Select("post_id, COUNT(*) AS count").
+		Where("post_id IN ?", postIDs).
+		Group("post_id").
+		Scan(&bookmarkRows).Error; err != nil {
+		return nil, err
+	}
+	for _, row := range bookmarkRows {
+		counts[row.PostID].Bookmarks = row.Count
+	}
+
+	return counts, nil
+}
+
+// ListBookmarkedPosts returns the posts a user bookmarked, latest bookmark first
+func (r *postRepository) ListBookmarkedPosts(ctx context.Context, userID uint, limit, offset int) ([]*domain.Post, int64, error) {
+	query := r.db.WithContext(ctx).Model(&domain.Post{}).
+		Joins("JOIN post_bookmarks ON post_bookmarks.post_id = posts.id AND post_bookmarks.user_id = ?", userID).
+		Where("posts.status = ?", domain.StatusPublished)
+
+	var total int64
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+
+	var posts []*domain.Post
+	selectQuery := query.
+		Select(
+			"posts.id", "posts.slug", "posts.title", "posts.summary", "posts.meta_description", "posts.keywords",
+			"posts.category_id", "posts.sub_category_id", "posts.is_featured", "posts.is_pinned",
+			"posts.status", "posts.created_by", "posts.view_count", "posts.created_at",
+			"CHAR_LENGTH(posts.content) as content_length",
+		).
+		Preload("Tags", orderTags).
+		Order("post_bookmarks.created_at DESC")
+	if limit > 0 {
+		selectQuery = selectQuery.Limit(limit)
+	}
+	if offset > 0 {
+		selectQuery = selectQuery.Offset(offset)
+	}
+
+	err := selectQuery.Find(&posts).Error
+	return posts, total, err
+}
@@ -0,0 +1,113 @@
+package repo
+
+import (
+	"context"
+
+	"postal/domain"
+	"postal/post"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// ListStaleTermVectorPosts returns published posts whose term vector is
+// missing or was computed from an older version of the post
+func (r *postRepository) ListStaleTermVectorPosts(ctx context.Context, limit int) ([]*domain.Post, error) {
+	var posts []*domain.Post
+	err := r.db.WithContext(ctx).
+		Select("posts.id", "posts.version", "posts.title", "posts.summary", "posts.content").
+		Joins("LEFT JOIN post_term_vectors ON post_term_vectors.post_id = posts.id").
+		Where("posts.status = ?", domain.StatusPublished).
+		Where("post_term_vectors.post_id IS NULL OR post_term_vectors.version <> posts.version").
+		Order("posts.id ASC").
+		Limit(limit).
+		Find(&posts).Error
+	return posts, err
+}
+
+func (r *postRepository) SaveTermVectors(ctx context.Context, vectors []*domain.PostTermVector) error {
+	if len(vectors) == 0 {
+		return nil
+	}
+	return r.db.WithContext(ctx).Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "post_id"}},
+		DoUpdates: clause.AssignmentColumns([]string{"version", "terms", "updated_at"}),
+	}).Create(&vectors).Error
+}
+
+// ListRelatedCandidates loads every published post with its term vector and tags
+func (r *postRepository) ListRelatedCandidates(ctx context.Context) ([]*post.RelatedCandidate, error) {
+	var rows []struct {
+		ID            uint
+		CategoryID    uint
+		SubCategoryID *uint
+		Terms         map[string]float64 `gorm:"serializer:json"`
+	}
+	err := r.db.WithContext(ctx).Model(&domain.Post{}).
+		Select("posts.id", "posts.category_id", "posts.sub_category_id", "post_term_vectors.terms").
+		Joins("JOIN post_term_vectors ON post_term_vectors.post_id = posts.id").
+		Where("posts.status = ?", domain.StatusPublished).
+		Order("posts.id ASC").
+		Scan(&rows).Error
+	if err != nil {
+		return nil, err
+	}
+
+	var postTags []domain.PostTag
+	err = r.db.WithContext(ctx).
+		Select("post_tags.post_id", "post_tags.tag_id").
+		Joins("JOIN posts ON posts.id = post_tags.post_id AND posts.deleted_at IS NULL").
+		Where("posts.status = ?", domain.StatusPublished).
+		Find(&postTags).Error
+	if err != nil {
+		return nil, err
+	}
+	tagIDs := make(map[uint][]uint)
+	for _, postTag := range postTags {
+		tagIDs[postTag.PostID] = append(tagIDs[postTag.PostID], postTag.TagID)
+	}
+
+	candidates := make([]*post.RelatedCandidate, len(rows))
+	for i, row := range rows {
+		candidates[i] = &post.RelatedCandidate{
+			ID:            row.ID,
+			CategoryID:    row.CategoryID,
+			SubCategoryID: row.SubCategoryID,
+			TagIDs:        tagIDs[row.ID],
+			Terms:         row.Terms,
+		}
+	}
+	return candidates, nil
+}
+
+// ReplaceRelated swaps the stored recommendations for a freshly computed set
+func (r *postRepository) ReplaceRelated(ctx context.Context, related []*domain.PostRelated) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Where("1 = 1").Delete(&domain.PostRelated{}).Error; err != nil {
+			return err
+		}
+		if len(related) == 0 {
+			return nil
+		}
+		return tx.CreateInBatches(related, 1000).Error
+	})
+}
+
+// ListRelatedPosts returns the published recommendations of a post, best first
+func (r *postRepository) ListRelatedPosts(ctx context.Context, postID uint, limit int) ([]*domain.Post, error) {
+	var posts []*domain.Post
+	err := r.db.WithContext(ctx).Model(&domain.Post{}).
+		Select(
+			"posts.id", "posts.slug", "posts.title", "posts.summary", "posts.meta_description", "posts.keywords",
+			"posts.category_id", "posts.sub_category_id", "posts.is_featured", "posts.is_pinned",
+			"posts.status", "posts.created_by", "posts.view_count", "posts.created_at",
+			"CHAR_LENGTH(posts.content) as content_length",
+		).
+		Joins("JOIN post_related ON post_related.related_id = posts.id AND post_related.post_id = ?", postID).
+		Where("posts.status = ?", domain.StatusPublished).
+		Preload("Tags", orderTags).
+		Order("post_related.score DESC, posts.id ASC").
+		Limit(limit).
+		Find(&posts).Error
+	return posts, err
+}
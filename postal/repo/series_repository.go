@@ -0,0 +1,195 @@
+package repo
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"postal/domain"
+	"postal/post"
+
+	"gorm.io/gorm"
+)
+
+func (r *postRepository) SeriesSlugExists(ctx context.Context, slug string, excludeID uint) (bool, error) {
+	var count int64
+	query := r.db.WithContext(ctx).Model(&domain.Series{}).Where("slug = ?", slug)
+	if excludeID > 0 {
+		query = query.Where("id != ?", excludeID)
+	}
+	err := query.Count(&count).Error
+	return count > 0, err
+}
+
+func (r *postRepository) CreateSeries(ctx context.Context, series *domain.Series, postIDs []uint) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Omit("Parts").Create(series).Error; err != nil {
+			return err
+		}
+		return setSeriesPosts(tx, series.ID, postIDs)
+	})
+}
+
+func (r *postRepository) UpdateSeries(ctx context.Context, series *domain.Series) error {
+	return r.db.WithContext(ctx).Omit("Parts").Save(series).Error
+}
+
+func (r *postRepository) SetSeriesPosts(ctx context.Context, seriesID uint, postIDs []uint) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		// Touch the series so its updated_at reflects the new order
+		if err := tx.Model(&domain.Series{}).Where("id = ?", seriesID).Update("updated_at", gorm.Expr("NOW()")).Error; err != nil {
+			return err
+		}
+		return setSeriesPosts(tx, seriesID, postIDs)
+	})
+}
+
+// setSeriesPosts replaces the parts of a series, numbering them in the given order
+func setSeriesPosts(tx *gorm.DB, seriesID uint, postIDs []uint) error {
+	if err := tx.Where("series_id = ?", seriesID).Delete(&domain.SeriesPost{}).Error; err != nil {
+		return err
+	}
+	if len(postIDs) == 0 {
+		return nil
+	}
+
+	var count int64
+	if err := tx.Model(&domain.Post{}).Where("id IN ?", postIDs).Count(&count).Error; err != nil {
+		return err
+	}
+	if count != int64(len(postIDs)) {
+		return fmt.Errorf("some posts not found")
+	}
+
+	var taken []domain.SeriesPost
+	if err := tx.Where("post_id IN ?", postIDs).Find(&taken).Error; err != nil {
+		return err
+	}
+	if len(taken) > 0 {
+		return fmt.Errorf("post %d already belongs to another series", taken[0].PostID)
+	}
+
+	parts := make([]domain.SeriesPost, len(postIDs))
+	for i, postID := range postIDs {
+		parts[i] = domain.SeriesPost{SeriesID: seriesID, PostID: postID, Position: i + 1}
+	}
+	return tx.Create(&parts).Error
+}
+
+func (r *postRepository) DeleteSeries(ctx context.Context, id uint) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Where("series_id = ?", id).Delete(&domain.SeriesPost{}).Error; err != nil {
+			return err
+		}
+		result := tx.Delete(&domain.Series{}, id)
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			return fmt.Errorf("series not found")
+		}
+		return nil
+	})
+}
+
+func (r *postRepository) GetSeriesByID(ctx context.Context, id uint) (*domain.Series, error) {
+	var series domain.Series
+	if err := r.db.WithContext(ctx).First(&series, id).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, fmt.Errorf("series not found")
+		}
+		return nil, err
+	}
+	return &series, nil
+}
+
+func (r *postRepository) GetSeriesBySlug(ctx context.Context, slug string) (*domain.Series, error) {
+	var series domain.Series
+	if err := r.db.WithContext(ctx).Where("slug = ?", slug).First(&series).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, fmt.Errorf("series not found")
+		}
+		return nil, err
+	}
+	return &series, nil
+}
+
+// ListSeries returns the series that have published parts, latest updated first
+func (r *postRepository) ListSeries(ctx context.Context, limit, offset int) ([]*post.SeriesListItem, int64, error) {
+	query := r.db.WithContext(ctx).Model(&domain.Series{}).
+		Joins("JOIN series_posts ON series_posts.series_id = series.id").
+		Joins("JOIN posts ON posts.id = series_posts.post_id AND posts.deleted_at IS NULL AND posts.status = ?", domain.StatusPublished).
+		Group("series.id")
+
+	var total int64
+	if err := r.db.WithContext(ctx).Table("(?) AS listed", query.Select("series.id")).Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+
+	var items []*post.SeriesListItem
+	selectQuery := query.
+		Select("series.id, series.title, series.slug, series.description, series.created_at, series.updated_at, COUNT(posts.id) AS post_count").
+		Order("series.updated_at DESC, series.id DESC")
+	if limit > 0 {
+		selectQuery = selectQuery.Limit(limit)
+	}
+	if offset > 0 {
+		selectQuery = selectQuery.Offset(offset)
+	}
+	if err := selectQuery.Scan(&items).Error; err != nil {
+		return nil, 0, err
+	}
+	return items, total, nil
+}
+
+// ListSeriesPosts returns the parts of a series in reading order
+func (r *postRepository) ListSeriesPosts(ctx context.Context, seriesID uint, publishedOnly bool) ([]*domain.Post, error) {
+	query := r.db.WithContext(ctx).Model(&domain.Post{}).
+		Select(
+			"posts.id", "posts.slug", "posts.title", "posts.summary", "posts.meta_description", "posts.keywords",
+			"posts.category_id", "posts.sub_category_id", "posts.is_featured", "posts.is_pinned",
+			"posts.status", "posts.created_by", "posts.view_count", "posts.created_at",
+			"CHAR_LENGTH(posts.content) as content_length",
+		).
+		Joins("JOIN series_posts ON series_posts.post_id = posts.id AND series_posts.series_id = ?", seriesID).
+		Preload("Tags", orderTags).
+		Order("series_posts.position ASC")
+	if publishedOnly {
+		query = query.Where("posts.status = ?", domain.StatusPublished)
+	}
+
+	var posts []*domain.Post
+	err := query.Find(&posts).Error
+	return posts, err
+}
+
+// GetPostSeries returns the series of a post with its published parts in reading
+// order, the post itself is included whatever its status. Both are nil for a post
+// that is not part of a series.
+func (r *postRepository) GetPostSeries(ctx context.Context, postID uint) (*domain.Series, []*domain.Post, error) {
+	var part domain.SeriesPost
+	err := r.db.WithContext(ctx).Where("post_id = ?", postID).First(&part).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil, nil
+		}
+		return nil, nil, err
+	}
+
+	series, err := r.GetSeriesByID(ctx, part.SeriesID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var posts []*domain.Post
+	err = r.db.WithContext(ctx).Model(&domain.Post{}).
+		Select("posts.id", "posts.title", "posts.slug", "posts.status").
+		Joins("JOIN series_posts ON series_posts.post_id = posts.id AND series_posts.series_id = ?", series.ID).
+		Where("posts.status = ? OR posts.id = ?", domain.StatusPublished, postID).
+		Order("series_posts.position ASC").
+		Find(&posts).Error
+	if err != nil {
+		return nil, nil, err
+	}
+	return series, posts, nil
+}
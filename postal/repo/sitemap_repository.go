@@ -0,0 +1,61 @@
+package repo
+
+import (
+	"context"
+	"net/url"
+	"time"
+
+	"postal/domain"
+	"postal/sitemap"
+
+	"gorm.io/gorm"
+)
+
+type sitemapRepository struct {
+	db *gorm.DB
+}
+
+func NewSitemapRepository(db *gorm.DB) sitemap.Repository {
+	return &sitemapRepository{db: db}
+}
+
+func (r *sitemapRepository) published(ctx context.Context) *gorm.DB {
+	return r.db.WithContext(ctx).Model(&domain.Post{}).
+		Where("status = ? AND is_public = ?", domain.StatusPublished, true)
+}
+
+// ListPostPages counts the published posts of every non-empty block of pageSize ids
+func (r *sitemapRepository) ListPostPages(ctx context.Context, pageSize int) ([]sitemap.Page, error) {
+	var pages []sitemap.Page
+	err := r.published(ctx).
+		Select("id / ? AS number, COUNT(*) AS count, MAX(updated_at) AS last_mod", pageSize).
+		Group("number").
+		Order("number ASC").
+		Scan(&pages).Error
+	if err != nil {
+		return nil, err
+	}
+	return pages, nil
+}
+
+// ListPostEntries returns the published posts with an id in the block of the page
+func (r *sitemapRepository) ListPostEntries(ctx context.Context, page, pageSize int) ([]sitemap.Entry, error) {
+	var rows []struct {
+		Slug      string
+		UpdatedAt time.Time
+	}
+	err := r.published(ctx).
+		Select("slug", "updated_at").
+		Where("id >= ? AND id < ?", page*pageSize, (page+1)*pageSize).
+		Order("id ASC").
+		Scan(&rows).Error
+	if err != nil {
+		return nil, err
+	}
+
+	entries := make([]sitemap.Entry, len(rows))
+	for i, row := range rows {
+		entries[i] = sitemap.Entry{Path: "/posts/" + url.PathEscape(row.Slug), LastMod: row.UpdatedAt}
+	}
+	return entries, nil
+}
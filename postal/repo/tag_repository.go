@@ -0,0 +1,150 @@
+package repo
+
+import (
+	"context"
+	"errors"
+
+	"postal/domain"
+	"postal/post"
+	"postal/util"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+func (r *postRepository) SetPostTags(ctx context.Context, postID uint, names []string) ([]domain.Tag, error) {
+	var tags []domain.Tag
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var err error
+		tags, err = setPostTags(tx, postID, names)
+		return err
+	})
+	return tags, err
+}
+
+// setPostTags creates the missing tags and replaces the tags of a post with them
+func setPostTags(tx *gorm.DB, postID uint, names []string) ([]domain.Tag, error) {
+	names = util.ParseTags(names)
+
+	if err := tx.Where("post_id = ?", postID).Delete(&domain.PostTag{}).Error; err != nil {
+		return nil, err
+	}
+	if len(names) == 0 {
+		return []domain.Tag{}, nil
+	}
+
+	slugs := make([]string, len(names))
+	newTags := make([]domain.Tag, len(names))
+	for i, name := range names {
+		slugs[i] = util.TagSlug(name)
+		newTags[i] = domain.Tag{Name: name, Slug: slugs[i]}
+	}
+
+	// Existing tags keep their first spelling
+	if err := tx.Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "slug"}},
+		DoNothing: true,
+	}).Create(&newTags).Error; err != nil {
+		return nil, err
+	}
+
+	var tags []domain.Tag
+	if err := tx.Where("slug IN ?", slugs).Order("name ASC").Find(&tags).Error; err != nil {
+		return nil, err
+	}
+
+	postTags := make([]domain.PostTag, len(tags))
+	for i, tag := range tags {
+		postTags[i] = domain.PostTag{PostID: postID, TagID: tag.ID}
+	}
+	if err := tx.Create(&postTags).Error; err != nil {
+		return nil, err
+	}
+
+	return tags, nil
+}
+
+// publishedTagCounts joins tags with the published posts they label
+func (r *postRepository) publishedTagCounts(ctx context.Context) *gorm.DB {
+	return r.db.WithContext(ctx).
+		Table("tags").
+		Joins("JOIN post_tags ON post_tags.tag_id = tags.id").
+		Joins("JOIN posts ON posts.id = post_tags.post_id AND posts.status = ? AND posts.deleted_at IS NULL", domain.StatusPublished)
+}
+
+func (r *postRepository) ListTags(ctx context.Context, filter post.TagFilter) ([]*post.TagResponse, int64, error) {
+	query := r.publishedTagCounts(ctx)
+	if filter.Search != "" {
+		query = query.Where("tags.slug LIKE ?", util.TagSlug(filter.Search)+"%")
+	}
+
+	var total int64
+	if err := query.Distinct("tags.id").Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+
+	order := "post_count DESC, tags.name ASC"
+	if filter.SortBy == "name" {
+		order = "tags.name ASC"
+	}
+
+	selectQuery := r.publishedTagCounts(ctx).
+		Select("tags.id, tags.name, tags.slug, COUNT(posts.id) AS post_count").
+		Group("tags.id").
+		Order(order)
+	if filter.Search != "" {
+		selectQuery = selectQuery.Where("tags.slug LIKE ?", util.TagSlug(filter.Search)+"%")
+	}
+	if filter.Limit > 0 {
+		selectQuery = selectQuery.Limit(filter.Limit)
+	}
+	if filter.Offset > 0 {
+		selectQuery = selectQuery.Offset(filter.Offset)
+	}
+
+	var tags []*post.TagResponse
+	if err := selectQuery.Scan(&tags).Error; err != nil {
+		return nil, 0, err
+	}
+	return tags, total, nil
+}
+
+func (r *postRepository) GetTagBySlug(ctx context.Context, slug string) (*post.TagResponse, error) {
+	var tag domain.Tag
+	if err := r.db.WithContext(ctx).Where("slug = ?", slug).First(&tag).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, post.ErrTagNotFound
+		}
+		return nil, err
+	}
+
+	var count int64
+	if err := r.publishedTagCounts(ctx).Where("tags.id = ?", tag.ID).Count(&count).Error; err != nil {
+		return nil, err
+	}
+
+	return &post.TagResponse{
+		ID:        tag.ID,
+		Name:      tag.Name,
+		Slug:      tag.Slug,
+		PostCount: count,
+	}, nil
+}
+
+// applyTagFilter keeps posts labelled with any, or all, of the filter tags
+func applyTagFilter(query *gorm.DB, filter post.PostFilter) *gorm.DB {
+	if len(filter.Tags) == 0 {
+		return query
+	}
+
+	tagged := query.Session(&gorm.Session{NewDB: true}).
+		Table("post_tags").
+		Select("post_tags.post_id").
+		Joins("JOIN tags ON tags.id = post_tags.tag_id").
+		Where("tags.slug IN ?", filter.Tags)
+	if filter.TagMatch == post.TagMatchAll {
+		tagged = tagged.Group("post_tags.post_id").Having("COUNT(DISTINCT tags.id) = ?", len(filter.Tags))
+	}
+
+	return query.Where("id IN (?)", tagged)
+}
@@ -0,0 +1,172 @@
+package handlers
+
+import (
+	"encoding/json"
+	"net/http"
+	"strconv"
+
+	"postal/comment"
+	"postal/domain"
+	"postal/rest/middlewares"
+	"postal/rest/utils"
+)
+
+// CommentModeratorRoles may work the moderation queue and change comment settings
+var CommentModeratorRoles = []string{"admin", "editor"}
+
+// ListModerationQueue returns comments by moderation status, oldest first,
+// ?status=pending|approved|rejected|spam&post_id=&limit=&offset=
+func (h *Handlers) ListModerationQueue(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	query := r.URL.Query()
+
+	filter := comment.ModerationFilter{
+		Status: domain.CommentPending,
+		Limit:  50,
+		Offset: 0,
+	}
+
+	if status := query.Get("status"); status != "" {
+		switch s := domain.CommentStatus(status); s {
+		case domain.CommentPending, domain.CommentApproved, domain.CommentRejected, domain.CommentSpam:
+			filter.Status = s
+		default:
+			w.WriteHeader(http.StatusBadRequest)
+			json.NewEncoder(w).Encode(ErrorResponse{
+				Status:  false,
+				Message: "Invalid status, expected pending, approved, rejected or spam",
+			})
+			return
+		}
+	}
+
+	if postID := query.Get("post_id"); postID != "" {
+		if pid, err := strconv.ParseUint(postID, 10, 32); err == nil {
+			id := uint(pid)
+			filter.PostID = &id
+		}
+	}
+
+	if l, err := strconv.Atoi(query.Get("limit")); err == nil && l > 0 && l <= 200 {
+		filter.Limit = l
+	}
+	if o, err := strconv.Atoi(query.Get("offset")); err == nil && o > 0 {
+		filter.Offset = o
+	}
+
+	comments, total, err := h.CommentService.ListModerationQueue(ctx, filter)
+	if err != nil {
+		w.WriteHeader(http.StatusInternalServerError)
+		json.NewEncoder(w).Encode(ErrorResponse{
+			Status:  false,
+			Message: "Failed to retrieve moderation queue",
+			Error:   err.Error(),
+		})
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(PaginatedResponse{
+		Status:  true,
+		Message: "Moderation queue retrieved successfully",
+		Data:    comments,
+		Meta: MetaData{
+			Total:  total,
+			Limit:  filter.Limit,
+			Offset: filter.Offset,
+		},
+	})
+}
+
+func (h *Handlers) ModerateComment(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	id, ok := parseCommentID(w, r)
+	if !ok {
+		return
+	}
+
+	var req comment.ModerateCommentRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		json.NewEncoder(w).Encode(ErrorResponse{
+			Status:  false,
+			Message: "Invalid request body",
+			Error:   err.Error(),
+		})
+		return
+	}
+	if validationErrs := h.Validator.ValidateStruct(&req); validationErrs != nil {
+		utils.SendJson(w, http.StatusBadRequest, map[string]any{
+			"status":  false,
+			"message": "Validation failed",
+			"errors":  validationErrs.Errors,
+		})
+		return
+	}
+
+	moderated, err := h.CommentService.ModerateComment(ctx, id, req.Status, middlewares.GetUserID(r))
+	if err != nil {
+		sendCommentError(w, "Failed to moderate comment", err)
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(SuccessResponse{
+		Status:  true,
+		Message: "Comment moderated successfully",
+		Data:    moderated,
+	})
+}
+
+// GetCommentSettings returns the comment settings of the requesting tenant
+func (h *Handlers) GetCommentSettings(w http.ResponseWriter, r *http.Request) {
+	settings, err := h.CommentService.GetSettings(r.Context(), h.requestTenant(r))
+	if err != nil {
+		w.WriteHeader(http.StatusInternalServerError)
+		json.NewEncoder(w).Encode(ErrorResponse{
+			Status:  false,
+			Message: "Failed to retrieve comment settings",
+			Error:   err.Error(),
+		})
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(SuccessResponse{
+		Status:  true,
+		Message: "Comment settings retrieved successfully",
+		Data:    settings,
+	})
+}
+
+func (h *Handlers) UpdateCommentSettings(w http.ResponseWriter, r *http.Request) {
+	var req comment.UpdateSettingsRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		json.NewEncoder(w).Encode(ErrorResponse{
+			Status:  false,
+			Message: "Invalid request body",
+			Error:   err.Error(),
+		})
+		return
+	}
+
+	settings, err := h.CommentService.UpdateSettings(r.Context(), h.requestTenant(r), req, middlewares.GetUserID(r))
+	if err != nil {
+		w.WriteHeader(http.StatusInternalServerError)
+		json.NewEncoder(w).Encode(ErrorResponse{
+			Status:  false,
+			Message: "Failed to update comment settings",
+			Error:   err.Error(),
+		})
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(SuccessResponse{
+		Status:  true,
+		Message: "Comment settings updated successfully",
+		Data:    settings,
+	})
+}
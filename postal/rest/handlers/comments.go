@@ -0,0 +1,239 @@
+package handlers
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"postal/comment"
+	"postal/rest/middlewares"
+	"postal/rest/utils"
+)
+
+// ListPostComments returns the approved comment threads of a post, ?limit=&offset= page the threads
+func (h *Handlers) ListPostComments(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	query := r.URL.Query()
+
+	postID, ok := parsePostID(w, r)
+	if !ok {
+		return
+	}
+
+	limit, offset := 20, 0
+	if l, err := strconv.Atoi(query.Get("limit")); err == nil && l > 0 && l <= 100 {
+		limit = l
+	}
+	if o, err := strconv.Atoi(query.Get("offset")); err == nil && o > 0 {
+		offset = o
+	}
+
+	comments, total, err := h.CommentService.ListPostComments(ctx, h.requestTenant(r), postID, limit, offset)
+	if err != nil {
+		w.WriteHeader(http.StatusInternalServerError)
+		json.NewEncoder(w).Encode(ErrorResponse{
+			Status:  false,
+			Message: "Failed to retrieve comments",
+			Error:   err.Error(),
+		})
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(PaginatedResponse{
+		Status:  true,
+		Message: "Comments retrieved successfully",
+		Data:    comments,
+		Meta: MetaData{
+			Total:  total,
+			Limit:  limit,
+			Offset: offset,
+		},
+	})
+}
+
+func (h *Handlers) CreateComment(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	postID, ok := parsePostID(w, r)
+	if !ok {
+		return
+	}
+
+	var req comment.CreateCommentRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		json.NewEncoder(w).Encode(ErrorResponse{
+			Status:  false,
+			Message: "Invalid request body",
+			Error:   err.Error(),
+		})
+		return
+	}
+	if validationErrs := h.Validator.ValidateStruct(&req); validationErrs != nil {
+		utils.SendJson(w, http.StatusBadRequest, map[string]any{
+			"status":  false,
+			"message": "Validation failed",
+			"errors":  validationErrs.Errors,
+		})
+		return
+	}
+
+	created, err := h.CommentService.CreateComment(ctx, h.requestTenant(r), postID, req, commentAuthor(r))
+	if err != nil {
+		sendCommentError(w, "Failed to create comment", err)
+		return
+	}
+
+	w.WriteHeader(http.StatusCreated)
+	json.NewEncoder(w).Encode(SuccessResponse{
+		Status:  true,
+		Message: "Comment created successfully",
+		Data:    created,
+	})
+}
+
+func (h *Handlers) UpdateComment(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	id, ok := parseCommentID(w, r)
+	if !ok {
+		return
+	}
+
+	var req comment.UpdateCommentRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		json.NewEncoder(w).Encode(ErrorResponse{
+			Status:  false,
+			Message: "Invalid request body",
+			Error:   err.Error(),
+		})
+		return
+	}
+	if validationErrs := h.Validator.ValidateStruct(&req); validationErrs != nil {
+		utils.SendJson(w, http.StatusBadRequest, map[string]any{
+			"status":  false,
+			"message": "Validation failed",
+			"errors":  validationErrs.Errors,
+		})
+		return
+	}
+
+	updated, err := h.CommentService.UpdateComment(ctx, h.requestTenant(r), id, req, commentAuthor(r))
+	if err != nil {
+		sendCommentError(w, "Failed to update comment", err)
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(SuccessResponse{
+		Status:  true,
+		Message: "Comment updated successfully",
+		Data:    updated,
+	})
+}
+
+func (h *Handlers) DeleteComment(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	id, ok := parseCommentID(w, r)
+	if !ok {
+		return
+	}
+
+	if err := h.CommentService.DeleteComment(ctx, id, commentAuthor(r)); err != nil {
+		sendCommentError(w, "Failed to delete comment", err)
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(SuccessResponse{
+		Status:  true,
+		Message: "Comment deleted successfully",
+	})
+}
+
+// CountPostComments returns the approved comment count of each post, ?post_ids=1,2,3
+func (h *Handlers) CountPostComments(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var postIDs []uint
+	for _, raw := range strings.Split(r.URL.Query().Get("post_ids"), ",") {
+		id, err := strconv.ParseUint(strings.TrimSpace(raw), 10, 32)
+		if err != nil {
+			continue
+		}
+		postIDs = append(postIDs, uint(id))
+	}
+	if len(postIDs) == 0 || len(postIDs) > 100 {
+		w.WriteHeader(http.StatusBadRequest)
+		json.NewEncoder(w).Encode(ErrorResponse{
+			Status:  false,
+			Message: "post_ids must list between 1 and 100 post IDs",
+		})
+		return
+	}
+
+	counts, err := h.CommentService.CountPostComments(ctx, postIDs)
+	if err != nil {
+		w.WriteHeader(http.StatusInternalServerError)
+		json.NewEncoder(w).Encode(ErrorResponse{
+			Status:  false,
+			Message: "Failed to count comments",
+			Error:   err.Error(),
+		})
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(SuccessResponse{
+		Status:  true,
+		Message: "Comment counts retrieved successfully",
+		Data:    counts,
+	})
+}
+
+// commentAuthor is the authenticated user, admins and editors may moderate
+func commentAuthor(r *http.Request) comment.Author {
+	return comment.Author{
+		ID:       middlewares.GetUserID(r),
+		Username: middlewares.GetUsername(r),
+		IsEditor: middlewares.HasRole(r, CommentModeratorRoles...),
+	}
+}
+
+func parseCommentID(w http.ResponseWriter, r *http.Request) (uint, bool) {
+	id, err := strconv.ParseUint(r.PathValue("id"), 10, 32)
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		json.NewEncoder(w).Encode(ErrorResponse{
+			Status:  false,
+			Message: "Invalid comment ID",
+		})
+		return 0, false
+	}
+	return uint(id), true
+}
+
+// sendCommentError maps comment errors to their HTTP status
+func sendCommentError(w http.ResponseWriter, message string, err error) {
+	status := http.StatusInternalServerError
+	switch {
+	case errors.Is(err, comment.ErrCommentNotFound), errors.Is(err, comment.ErrPostNotFound):
+		status = http.StatusNotFound
+	case errors.Is(err, comment.ErrNotAuthor), errors.Is(err, comment.ErrCommentsDisabled):
+		status = http.StatusForbidden
+	case errors.Is(err, comment.ErrInvalidParent), errors.Is(err, comment.ErrThreadTooDeep):
+		status = http.StatusBadRequest
+	}
+
+	w.WriteHeader(status)
+	json.NewEncoder(w).Encode(ErrorResponse{
+		Status:  false,
+		Message: message,
+		Error:   err.Error(),
+	})
+}
@@ -1,15 +1,22 @@
 package handlers
 
 import (
+	"errors"
+	"io"
 	"net/http"
+	"strconv"
 
 	"postal/config"
+	"postal/domain"
+	"postal/importjob"
 	"postal/rest/middlewares"
 	"postal/rest/utils"
 )
 
 
 
+// BatchUploadPosts queues the multipart "file" CSV as an import job. The form
+// fields policy (all_or_nothing or best_effort) and dry_run configure the job.
 func (h *Handlers) BatchUploadPosts(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
@@ -42,16 +49,36 @@ func (h *Handlers) BatchUploadPosts(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	err = h.PostService.BatchUploadPosts(ctx, userID, &file)
+	data, err := io.ReadAll(file)
 	if err != nil {
-		utils.SendError(w, http.StatusBadRequest, err.Error(), nil)
+		utils.SendError(w, http.StatusBadRequest, "Failed to read file", nil)
+		return
+	}
+
+	dryRun, _ := strconv.ParseBool(r.FormValue("dry_run"))
+	job, err := h.ImportJobService.CreateJob(ctx, importjob.CreateJobRequest{
+		UserID:   userID,
+		Filename: fileheader.Filename,
+		Data:     data,
+		Policy:   domain.ImportPolicy(r.FormValue("policy")),
+		DryRun:   dryRun,
+	})
+	if err != nil {
+		status := http.StatusInternalServerError
+		if errors.Is(err, importjob.ErrInvalidPolicy) || errors.Is(err, importjob.ErrEmptyUpload) {
+			status = http.StatusBadRequest
+		}
+		utils.SendError(w, status, err.Error(), nil)
 		return
 	}
 
+	// The rows are imported in the background, the job reports how that went
+	w.Header().Set("Location", "/api/v1/import-jobs/"+job.UUID)
 	response := map[string]any{
 		"success": true,
-		"message": "Posts uploaded successfully",
+		"message": "Upload accepted, the posts are imported in the background",
+		"data":    job,
 	}
 
-	utils.SendJson(w, http.StatusOK, response)
+	utils.SendJson(w, http.StatusAccepted, response)
 }
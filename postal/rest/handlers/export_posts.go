@@ -0,0 +1,46 @@
+package handlers
+
+import (
+	"fmt"
+	"log"
+	"net/http"
+	"strconv"
+	"time"
+
+	"postal/post"
+	"postal/rest/utils"
+)
+
+// ExportPosts streams a zip of the posts matching the ListPosts filters.
+// ?format= is markdown (default), jsonl or csv, ?versions=true adds the
+// version history of every post.
+func (h *Handlers) ExportPosts(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	query := r.URL.Query()
+
+	opts := post.ExportOptions{Format: post.ExportMarkdown}
+	if format := query.Get("format"); format != "" {
+		opts.Format = post.ExportFormat(format)
+	}
+	if !post.IsValidExportFormat(opts.Format) {
+		utils.SendError(w, http.StatusBadRequest, post.ErrInvalidExportFormat.Error(), nil)
+		return
+	}
+	if versions, err := strconv.ParseBool(query.Get("versions")); err == nil {
+		opts.WithVersions = versions
+	}
+
+	var filter post.PostFilter
+	applyPostFilterQuery(&filter, query)
+
+	filename := fmt.Sprintf("posts-%s-%s.zip", opts.Format, time.Now().UTC().Format("20060102-150405"))
+	w.Header().Set("Content-Type", "application/zip")
+	w.Header().Set("Content-Disposition", `attachment; filename="`+filename+`"`)
+	w.Header().Set("Cache-Control", "no-store")
+
+	// The zip is written while the posts are read, once it has started the
+	// status cannot change anymore and a failure leaves a truncated archive
+	if err := h.PostService.ExportPosts(ctx, w, filter, opts); err != nil {
+		log.Printf("❌ Post export failed (format=%s): %v", opts.Format, err)
+	}
+}
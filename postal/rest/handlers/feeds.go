@@ -0,0 +1,131 @@
+package handlers
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"postal/feed"
+	"postal/post"
+)
+
+// GetPostsFeed serves the feed of the latest posts as /feeds/posts.{rss,atom,json}
+func (h *Handlers) GetPostsFeed(w http.ResponseWriter, r *http.Request) {
+	h.serveFeed(w, r, post.FeedFilter{FeedPath: "/feeds/posts"})
+}
+
+// GetCategoryFeed serves the feed of a category as /feeds/categories/{id}/posts.{rss,atom,json}
+func (h *Handlers) GetCategoryFeed(w http.ResponseWriter, r *http.Request) {
+	id, err := strconv.ParseUint(r.PathValue("id"), 10, 32)
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		json.NewEncoder(w).Encode(ErrorResponse{
+			Status:  false,
+			Message: "Invalid category ID",
+		})
+		return
+	}
+
+	categoryID := uint(id)
+	h.serveFeed(w, r, post.FeedFilter{
+		FeedPath:   "/feeds/categories/" + strconv.FormatUint(id, 10) + "/posts",
+		CategoryID: &categoryID,
+	})
+}
+
+// GetTagFeed serves the feed of a tag as /feeds/tags/{slug}/posts.{rss,atom,json}
+func (h *Handlers) GetTagFeed(w http.ResponseWriter, r *http.Request) {
+	slug := r.PathValue("slug")
+	h.serveFeed(w, r, post.FeedFilter{
+		FeedPath: "/feeds/tags/" + slug + "/posts",
+		TagSlug:  slug,
+	})
+}
+
+// serveFeed encodes the feed in the format of the requested file. ?content=full
+// includes the rendered post content instead of only the summary. Feed readers
+// revalidate with If-None-Match or If-Modified-Since and get 304 when nothing changed.
+func (h *Handlers) serveFeed(w http.ResponseWriter, r *http.Request, filter post.FeedFilter) {
+	ctx := r.Context()
+
+	name, ext, _ := strings.Cut(r.PathValue("file"), ".")
+	format, ok := feed.ParseFormat(ext)
+	if name != "posts" || !ok {
+		http.NotFound(w, r)
+		return
+	}
+
+	filter.BaseURL = h.publicBaseURL(r)
+	filter.FullContent = r.URL.Query().Get("content") == "full"
+
+	result, err := h.PostService.GetFeed(ctx, filter)
+	if err != nil {
+		status := http.StatusInternalServerError
+		if errors.Is(err, post.ErrCategoryNotFound) || errors.Is(err, post.ErrTagNotFound) {
+			status = http.StatusNotFound
+		}
+		w.WriteHeader(status)
+		json.NewEncoder(w).Encode(ErrorResponse{
+			Status:  false,
+			Message: "Failed to build feed",
+			Error:   err.Error(),
+		})
+		return
+	}
+	result.FeedURL = filter.BaseURL + r.URL.Path
+	if filter.FullContent {
+		result.FeedURL += "?content=full"
+	}
+
+	body, err := result.Encode(format)
+	if err != nil {
+		w.WriteHeader(http.StatusInternalServerError)
+		json.NewEncoder(w).Encode(ErrorResponse{
+			Status:  false,
+			Message: "Failed to encode feed",
+			Error:   err.Error(),
+		})
+		return
+	}
+
+	sum := sha256.Sum256(body)
+	etag := `"` + hex.EncodeToString(sum[:16]) + `"`
+	lastModified := result.Updated.UTC().Truncate(time.Second)
+
+	w.Header().Set("ETag", etag)
+	w.Header().Set("Last-Modified", lastModified.Format(http.TimeFormat))
+	w.Header().Set("Cache-Control", "public, max-age=300")
+
+	if feedNotModified(r, etag, lastModified) {
+		w.WriteHeader(http.StatusNotModified)
+		return
+	}
+
+	w.Header().Set("Content-Type", format.ContentType())
+	w.WriteHeader(http.StatusOK)
+	w.Write(body)
+}
+
+// feedNotModified evaluates the conditional headers, If-None-Match wins over If-Modified-Since
+func feedNotModified(r *http.Request, etag string, lastModified time.Time) bool {
+	if match := r.Header.Get("If-None-Match"); match != "" {
+		for _, candidate := range strings.Split(match, ",") {
+			candidate = strings.TrimPrefix(strings.TrimSpace(candidate), "W/")
+			if candidate == etag || candidate == "*" {
+				return true
+			}
+		}
+		return false
+	}
+
+	if since := r.Header.Get("If-Modified-Since"); since != "" {
+		t, err := http.ParseTime(since)
+		return err == nil && !lastModified.After(t)
+	}
+	return false
+}
@@ -2,8 +2,11 @@ package handlers
 
 import (
 	"encoding/json"
+	"log"
+	"net"
 	"net/http"
 	"strconv"
+	"strings"
 
 	"postal/rest/utils"
 )
@@ -33,6 +36,11 @@ func (h *Handlers) GetPostByID(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	if err := h.PostService.RecordView(ctx, post, viewVisitor(r)); err != nil {
+		log.Printf("⚠️ Failed to record view (post_id=%d): %v", post.ID, err)
+	}
+
+	w.Header().Set("ETag", postETag(post.Version))
 	w.WriteHeader(http.StatusOK)
 	json.NewEncoder(w).Encode(SuccessResponse{
 		Status:  true,
@@ -56,6 +64,23 @@ func (h *Handlers) GetPostBySlug(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	// The slug is a previous slug of a renamed post, point the client to the canonical one
+	if post.Slug != slug {
+		w.Header().Set("Location", "/api/v1/posts/slug/"+post.Slug)
+		w.WriteHeader(http.StatusMovedPermanently)
+		json.NewEncoder(w).Encode(SuccessResponse{
+			Status:  true,
+			Message: "Post has moved permanently",
+			Data:    SlugRedirect{CanonicalSlug: post.Slug},
+		})
+		return
+	}
+
+	if err := h.PostService.RecordView(ctx, post, viewVisitor(r)); err != nil {
+		log.Printf("⚠️ Failed to record view (post_id=%d): %v", post.ID, err)
+	}
+
+	w.Header().Set("ETag", postETag(post.Version))
 	w.WriteHeader(http.StatusOK)
 	json.NewEncoder(w).Encode(SuccessResponse{
 		Status:  true,
@@ -63,3 +88,21 @@ func (h *Handlers) GetPostBySlug(w http.ResponseWriter, r *http.Request) {
 		Data:    post,
 	})
 }
+
+// viewVisitor identifies the reader for view deduplication. Frontends behind a
+// trusted proxy send X-Visitor-ID, otherwise the client address and user agent are
+// used. The TrustProxies middleware drops both headers from other clients, and
+// only the last X-Forwarded-For address is taken since the proxy appended it.
+func viewVisitor(r *http.Request) string {
+	if visitor := strings.TrimSpace(r.Header.Get("X-Visitor-ID")); visitor != "" {
+		return "id:" + visitor
+	}
+
+	ip := r.RemoteAddr
+	if forwarded := r.Header.Get("X-Forwarded-For"); forwarded != "" {
+		ip = forwarded[strings.LastIndex(forwarded, ",")+1:]
+	} else if host, _, err := net.SplitHostPort(r.RemoteAddr); err == nil {
+		ip = host
+	}
+	return "ip:" + strings.TrimSpace(ip) + "|" + r.UserAgent()
+}
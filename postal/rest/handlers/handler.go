@@ -1,22 +1,40 @@
 package handlers
 
 import (
+	"postal/comment"
+	"postal/importjob"
+	"postal/media"
 	"postal/post"
 	"postal/post_version"
+	"postal/preview"
 	"postal/rest/utils"
+	"postal/sitemap"
 )
 
 type Handlers struct {
-	PostService     post.Service
-	PostVersionRepo post_version.Repository
-	Validator       *utils.Validator
+	PostService      post.Service
+	CommentService   comment.Service
+	SitemapService   sitemap.Service
+	PreviewService   preview.Service
+	MediaService     media.Service
+	ImportJobService importjob.Service
+	PostVersionRepo  post_version.Repository
+	Validator        *utils.Validator
+	// PublicBaseURLs are the sites links in feeds and sitemaps point to
+	PublicBaseURLs []string
 }
 
-func NewHandlers(postService post.Service, postVersionRepo post_version.Repository, validator *utils.Validator) *Handlers {
+func NewHandlers(postService post.Service, commentService comment.Service, sitemapService sitemap.Service, previewService preview.Service, mediaService media.Service, importJobService importjob.Service, postVersionRepo post_version.Repository, validator *utils.Validator, publicBaseURLs []string) *Handlers {
 	return &Handlers{
-		PostService:     postService,
-		PostVersionRepo: postVersionRepo,
-		Validator:       validator,
+		PostService:      postService,
+		CommentService:   commentService,
+		SitemapService:   sitemapService,
+		PreviewService:   previewService,
+		MediaService:     mediaService,
+		ImportJobService: importJobService,
+		PostVersionRepo:  postVersionRepo,
+		Validator:        validator,
+		PublicBaseURLs:   publicBaseURLs,
 	}
 }
 
@@ -32,6 +50,10 @@ type ErrorResponse struct {
 	Error   string `json:"error,omitempty"`
 }
 
+type SlugRedirect struct {
+	CanonicalSlug string `json:"canonical_slug"`
+}
+
 type PaginatedResponse struct {
 	Status  bool        `json:"status"`
 	Message string      `json:"message"`
@@ -0,0 +1,86 @@
+package handlers
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strconv"
+
+	"postal/domain"
+	"postal/importjob"
+)
+
+// GetImportJob reports the progress of a CSV import job, or its outcome once finished
+func (h *Handlers) GetImportJob(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	job, err := h.ImportJobService.GetJob(ctx, r.PathValue("id"), postActor(r))
+	if err != nil {
+		sendImportJobError(w, "Failed to retrieve import job", err)
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(SuccessResponse{
+		Status:  true,
+		Message: "Import job retrieved successfully",
+		Data:    job,
+	})
+}
+
+// ListImportJobRows pages the outcome of every row of a finished import job,
+// ?outcome=invalid narrows it to one outcome
+func (h *Handlers) ListImportJobRows(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	query := r.URL.Query()
+
+	filter := importjob.RowFilter{Limit: 20}
+	if l, err := strconv.Atoi(query.Get("limit")); err == nil && l > 0 && l <= 100 {
+		filter.Limit = l
+	}
+	if o, err := strconv.Atoi(query.Get("offset")); err == nil && o > 0 {
+		filter.Offset = o
+	}
+	if outcome := query.Get("outcome"); outcome != "" {
+		o := domain.ImportRowOutcome(outcome)
+		filter.Outcome = &o
+	}
+
+	rows, total, err := h.ImportJobService.ListJobRows(ctx, r.PathValue("id"), filter, postActor(r))
+	if err != nil {
+		sendImportJobError(w, "Failed to retrieve import job rows", err)
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(PaginatedResponse{
+		Status:  true,
+		Message: "Import job rows retrieved successfully",
+		Data:    rows,
+		Meta: MetaData{
+			Total:  total,
+			Limit:  filter.Limit,
+			Offset: filter.Offset,
+		},
+	})
+}
+
+// sendImportJobError maps import job errors to their HTTP status
+func sendImportJobError(w http.ResponseWriter, message string, err error) {
+	status := http.StatusInternalServerError
+	switch {
+	case errors.Is(err, importjob.ErrJobNotFound):
+		status = http.StatusNotFound
+	case errors.Is(err, importjob.ErrNotJobOwner):
+		status = http.StatusForbidden
+	case errors.Is(err, importjob.ErrInvalidOutcome):
+		status = http.StatusBadRequest
+	}
+
+	w.WriteHeader(status)
+	json.NewEncoder(w).Encode(ErrorResponse{
+		Status:  false,
+		Message: message,
+		Error:   err.Error(),
+	})
+}
@@ -0,0 +1,62 @@
+package handlers
+
+import (
+	"errors"
+	"log"
+	"net/http"
+
+	"postal/config"
+	"postal/post"
+	"postal/rest/middlewares"
+	"postal/rest/utils"
+)
+
+// ImportMarkdownPosts creates posts from the multipart "file", a zip, tar or
+// tar.gz of Markdown files with YAML front matter
+func (h *Handlers) ImportMarkdownPosts(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	userID := middlewares.GetUserID(r)
+	if userID == 0 {
+		utils.SendError(w, http.StatusUnauthorized, "Unauthorized", nil)
+		return
+	}
+
+	maxImportUploadSize := config.GetConfig().MaxImportUploadSizeMB << 20
+	r.Body = http.MaxBytesReader(w, r.Body, maxImportUploadSize)
+
+	err := r.ParseMultipartForm(32 << 20)
+	if err != nil {
+		var tooLarge *http.MaxBytesError
+		if errors.As(err, &tooLarge) {
+			utils.SendError(w, http.StatusRequestEntityTooLarge, "Archive is larger than the upload limit", nil)
+			return
+		}
+		utils.SendError(w, http.StatusBadRequest, "Invalid multipart form", nil)
+		return
+	}
+
+	file, fileheader, err := r.FormFile("file")
+	if err != nil {
+		utils.SendError(w, http.StatusBadRequest, "Failed to read file", nil)
+		return
+	}
+	defer file.Close()
+
+	posts, err := h.PostService.ImportMarkdownPosts(ctx, userID, file, fileheader.Size)
+	if err != nil {
+		if errors.Is(err, post.ErrInvalidImport) {
+			utils.SendError(w, http.StatusBadRequest, err.Error(), nil)
+			return
+		}
+		log.Printf("Failed to import Markdown posts: %v", err)
+		utils.SendError(w, http.StatusInternalServerError, "Failed to import posts", nil)
+		return
+	}
+
+	utils.SendJson(w, http.StatusCreated, map[string]any{
+		"success": true,
+		"message": "Posts imported successfully",
+		"data":    posts,
+	})
+}
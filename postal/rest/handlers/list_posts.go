@@ -4,11 +4,15 @@ import (
 	"encoding/json"
 	"log"
 	"net/http"
+	"net/url"
+	"sort"
 	"strconv"
+	"strings"
 	"time"
 
 	"postal/domain"
 	"postal/post"
+	"postal/util"
 )
 
 func (h *Handlers) ListPosts(w http.ResponseWriter, r *http.Request) {
@@ -37,6 +41,50 @@ func (h *Handlers) ListPosts(w http.ResponseWriter, r *http.Request) {
 		}
 	}
 
+	applyPostFilterQuery(&filter, query)
+
+	if sortBy := query.Get("sort_by"); sortBy != "" {
+		filter.SortBy = sortBy
+	}
+
+	if sortOrder := query.Get("sort_order"); sortOrder != "" {
+		filter.SortOrder = sortOrder
+	}
+
+	serviceStart := time.Now()
+	posts, total, err := h.PostService.ListPosts(ctx, filter)
+	serviceTime := time.Since(serviceStart)
+
+	if err != nil {
+		w.WriteHeader(http.StatusInternalServerError)
+		json.NewEncoder(w).Encode(ErrorResponse{
+			Status:  false,
+			Message: "Failed to retrieve posts",
+			Error:   err.Error(),
+		})
+		return
+	}
+
+	encodeStart := time.Now()
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(PaginatedResponse{
+		Status:  true,
+		Message: "Posts retrieved successfully",
+		Data:    posts,
+		Meta: MetaData{
+			Total:  total,
+			Limit:  filter.Limit,
+			Offset: filter.Offset,
+		},
+	})
+	encodeTime := time.Since(encodeStart)
+
+	log.Printf("ListPosts timing: service=%v, encode=%v, total=%v", serviceTime, encodeTime, time.Since(handlerStart))
+}
+
+// applyPostFilterQuery sets the filters of a post listing from its query, paging
+// and sorting are left to the caller
+func applyPostFilterQuery(filter *post.PostFilter, query url.Values) {
 	if status := query.Get("status"); status != "" {
 		s := domain.PostStatus(status)
 		filter.Status = &s
@@ -78,41 +126,28 @@ func (h *Handlers) ListPosts(w http.ResponseWriter, r *http.Request) {
 		filter.Search = &search
 	}
 
-	if sortBy := query.Get("sort_by"); sortBy != "" {
-		filter.SortBy = sortBy
-	}
+	// ?tags=go,channels&tag_match=all, any tag matches by default
+	if tags := query.Get("tags"); tags != "" {
+		for _, name := range util.ParseTags(strings.Split(tags, ",")) {
+			filter.Tags = append(filter.Tags, util.TagSlug(name))
+		}
+		sort.Strings(filter.Tags)
 
-	if sortOrder := query.Get("sort_order"); sortOrder != "" {
-		filter.SortOrder = sortOrder
+		filter.TagMatch = post.TagMatchAny
+		if query.Get("tag_match") == post.TagMatchAll {
+			filter.TagMatch = post.TagMatchAll
+		}
 	}
 
-	serviceStart := time.Now()
-	posts, total, err := h.PostService.ListPosts(ctx, filter)
-	serviceTime := time.Since(serviceStart)
+	// ?author_id=7&author_role=editor, any credited role matches by default
+	if authorID := query.Get("author_id"); authorID != "" {
+		if aid, err := strconv.ParseUint(authorID, 10, 32); err == nil {
+			id := uint(aid)
+			filter.AuthorID = &id
 
-	if err != nil {
-		w.WriteHeader(http.StatusInternalServerError)
-		json.NewEncoder(w).Encode(ErrorResponse{
-			Status:  false,
-			Message: "Failed to retrieve posts",
-			Error:   err.Error(),
-		})
-		return
+			if role := domain.AuthorRole(query.Get("author_role")); domain.IsValidAuthorRole(role) {
+				filter.AuthorRole = &role
+			}
+		}
 	}
-
-	encodeStart := time.Now()
-	w.WriteHeader(http.StatusOK)
-	json.NewEncoder(w).Encode(PaginatedResponse{
-		Status:  true,
-		Message: "Posts retrieved successfully",
-		Data:    posts,
-		Meta: MetaData{
-			Total:  total,
-			Limit:  filter.Limit,
-			Offset: filter.Offset,
-		},
-	})
-	encodeTime := time.Since(encodeStart)
-
-	log.Printf("ListPosts timing: service=%v, encode=%v, total=%v", serviceTime, encodeTime, time.Since(handlerStart))
 }
@@ -0,0 +1,70 @@
+package handlers
+
+import (
+	"encoding/json"
+	"net/http"
+	"strconv"
+	"time"
+
+	"postal/post"
+)
+
+// ServiceRole is the role of the tokens cortex signs for its own calls, no user
+// account can hold it
+const ServiceRole = "service"
+
+// ListViewStats returns the daily view counts of posts, used by cortex
+// to rebuild its per-category "top posts" leaderboards
+func (h *Handlers) ListViewStats(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	query := r.URL.Query()
+
+	filter := post.ViewStatFilter{
+		Since: time.Now().UTC().AddDate(0, 0, -30),
+	}
+
+	if categoryID := query.Get("category_id"); categoryID != "" {
+		cid, err := strconv.ParseUint(categoryID, 10, 32)
+		if err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			json.NewEncoder(w).Encode(ErrorResponse{
+				Status:  false,
+				Message: "Invalid category_id",
+			})
+			return
+		}
+		id := uint(cid)
+		filter.CategoryID = &id
+	}
+
+	if since := query.Get("since"); since != "" {
+		t, err := time.Parse(time.RFC3339, since)
+		if err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			json.NewEncoder(w).Encode(ErrorResponse{
+				Status:  false,
+				Message: "Invalid since, expected RFC3339 timestamp",
+			})
+			return
+		}
+		filter.Since = t
+	}
+
+	stats, err := h.PostService.ListViewStats(ctx, filter)
+	if err != nil {
+		w.WriteHeader(http.StatusInternalServerError)
+		json.NewEncoder(w).Encode(ErrorResponse{
+			Status:  false,
+			Message: "Failed to retrieve view stats",
+			Error:   err.Error(),
+		})
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(SuccessResponse{
+		Status:  true,
+		Message: "View stats retrieved successfully",
+		Data:    stats,
+	})
+}
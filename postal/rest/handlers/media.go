@@ -0,0 +1,246 @@
+package handlers
+
+import (
+	"encoding/json"
+	"errors"
+	"log"
+	"net/http"
+	"path"
+	"strconv"
+	"time"
+
+	"postal/config"
+	"postal/media"
+	"postal/rest/middlewares"
+)
+
+// UploadAsset stores the multipart "file" in the media library of the tenant
+func (h *Handlers) UploadAsset(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	// The service enforces the exact limit, the slack covers the multipart framing
+	maxUploadSize := config.GetConfig().MaxMediaUploadSizeMB << 20
+	r.Body = http.MaxBytesReader(w, r.Body, maxUploadSize+1<<20)
+
+	file, fileheader, err := r.FormFile("file")
+	if err != nil {
+		var tooLarge *http.MaxBytesError
+		if errors.As(err, &tooLarge) {
+			sendMediaError(w, "Failed to upload file", media.ErrFileTooLarge)
+			return
+		}
+		w.WriteHeader(http.StatusBadRequest)
+		json.NewEncoder(w).Encode(ErrorResponse{
+			Status:  false,
+			Message: "Failed to read file",
+			Error:   err.Error(),
+		})
+		return
+	}
+	defer file.Close()
+
+	asset, err := h.MediaService.Upload(ctx, media.UploadRequest{
+		Tenant:   h.requestTenant(r),
+		Filename: fileheader.Filename,
+		File:     file,
+		UserID:   middlewares.GetUserID(r),
+	})
+	if err != nil {
+		sendMediaError(w, "Failed to upload file", err)
+		return
+	}
+
+	w.WriteHeader(http.StatusCreated)
+	json.NewEncoder(w).Encode(SuccessResponse{
+		Status:  true,
+		Message: "File uploaded successfully",
+		Data:    asset,
+	})
+}
+
+// ListAssets pages the media library of the tenant, newest first. ?mine=true
+// narrows it to the caller's uploads.
+func (h *Handlers) ListAssets(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	query := r.URL.Query()
+
+	filter := media.AssetFilter{
+		Tenant: h.requestTenant(r),
+		Limit:  20,
+	}
+	if l, err := strconv.Atoi(query.Get("limit")); err == nil && l > 0 && l <= 100 {
+		filter.Limit = l
+	}
+	if o, err := strconv.Atoi(query.Get("offset")); err == nil && o > 0 {
+		filter.Offset = o
+	}
+	if mine, err := strconv.ParseBool(query.Get("mine")); err == nil && mine {
+		userID := middlewares.GetUserID(r)
+		filter.UploadedBy = &userID
+	}
+
+	assets, total, err := h.MediaService.ListAssets(ctx, filter)
+	if err != nil {
+		sendMediaError(w, "Failed to retrieve assets", err)
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(PaginatedResponse{
+		Status:  true,
+		Message: "Assets retrieved successfully",
+		Data:    assets,
+		Meta: MetaData{
+			Total:  total,
+			Limit:  filter.Limit,
+			Offset: filter.Offset,
+		},
+	})
+}
+
+// GetAsset returns an asset of the tenant's media library
+func (h *Handlers) GetAsset(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	id, ok := parseAssetID(w, r)
+	if !ok {
+		return
+	}
+
+	asset, err := h.MediaService.GetAsset(ctx, id, h.requestTenant(r))
+	if err != nil {
+		sendMediaError(w, "Failed to retrieve asset", err)
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(SuccessResponse{
+		Status:  true,
+		Message: "Asset retrieved successfully",
+		Data:    asset,
+	})
+}
+
+// DeleteAsset removes an asset no post uses, staff may delete any upload
+func (h *Handlers) DeleteAsset(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	id, ok := parseAssetID(w, r)
+	if !ok {
+		return
+	}
+
+	if err := h.MediaService.DeleteAsset(ctx, id, h.requestTenant(r), postActor(r)); err != nil {
+		sendMediaError(w, "Failed to delete asset", err)
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(SuccessResponse{
+		Status:  true,
+		Message: "Asset deleted successfully",
+	})
+}
+
+// GetMediaUsage returns how much of its quota the tenant uses
+func (h *Handlers) GetMediaUsage(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	usage, err := h.MediaService.GetUsage(ctx, h.requestTenant(r))
+	if err != nil {
+		sendMediaError(w, "Failed to retrieve media usage", err)
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(SuccessResponse{
+		Status:  true,
+		Message: "Media usage retrieved successfully",
+		Data:    usage,
+	})
+}
+
+// GetPostAssets returns the assets a post uses
+func (h *Handlers) GetPostAssets(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	id, ok := parsePostID(w, r)
+	if !ok {
+		return
+	}
+
+	assets, err := h.MediaService.ListPostAssets(ctx, id)
+	if err != nil {
+		sendMediaError(w, "Failed to retrieve post assets", err)
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(SuccessResponse{
+		Status:  true,
+		Message: "Post assets retrieved successfully",
+		Data:    assets,
+	})
+}
+
+// ServeMedia serves a stored file. Keys never change content, so they are cached for good.
+func (h *Handlers) ServeMedia(w http.ResponseWriter, r *http.Request) {
+	key := r.PathValue("key")
+
+	file, err := h.MediaService.Open(r.Context(), key)
+	if err != nil {
+		if errors.Is(err, media.ErrObjectNotFound) {
+			http.NotFound(w, r)
+			return
+		}
+		log.Printf("Failed to open media object (key=%s): %v", key, err)
+		http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+		return
+	}
+	defer file.Close()
+
+	w.Header().Set("Cache-Control", "public, max-age=31536000, immutable")
+	w.Header().Set("X-Content-Type-Options", "nosniff")
+	http.ServeContent(w, r, path.Base(key), time.Time{}, file)
+}
+
+func parseAssetID(w http.ResponseWriter, r *http.Request) (uint, bool) {
+	id, err := strconv.ParseUint(r.PathValue("id"), 10, 32)
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		json.NewEncoder(w).Encode(ErrorResponse{
+			Status:  false,
+			Message: "Invalid asset ID",
+		})
+		return 0, false
+	}
+	return uint(id), true
+}
+
+// sendMediaError maps media errors to their HTTP status
+func sendMediaError(w http.ResponseWriter, message string, err error) {
+	status := http.StatusInternalServerError
+	switch {
+	case errors.Is(err, media.ErrAssetNotFound):
+		status = http.StatusNotFound
+	case errors.Is(err, media.ErrFileTooLarge):
+		status = http.StatusRequestEntityTooLarge
+	case errors.Is(err, media.ErrUnsupportedType):
+		status = http.StatusUnsupportedMediaType
+	case errors.Is(err, media.ErrImageTooLarge):
+		status = http.StatusUnprocessableEntity
+	case errors.Is(err, media.ErrQuotaExceeded):
+		status = http.StatusInsufficientStorage
+	case errors.Is(err, media.ErrAssetInUse):
+		status = http.StatusConflict
+	case errors.Is(err, media.ErrNotUploader):
+		status = http.StatusForbidden
+	}
+
+	w.WriteHeader(status)
+	json.NewEncoder(w).Encode(ErrorResponse{
+		Status:  false,
+		Message: message,
+		Error:   err.Error(),
+	})
+}
@@ -23,6 +23,10 @@ func (h *Handlers) PublishPost(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	if !h.authorizePostEdit(w, r, uint(id)) {
+		return
+	}
+
 	userID := middlewares.GetUserID(r)
 	if err := h.PostService.PublishPost(ctx, uint(id), userID); err != nil {
 		w.WriteHeader(http.StatusInternalServerError)
@@ -55,6 +59,10 @@ func (h *Handlers) UnpublishPost(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	if !h.authorizePostEdit(w, r, uint(id)) {
+		return
+	}
+
 	userID := middlewares.GetUserID(r)
 	if err := h.PostService.UnpublishPost(ctx, uint(id), userID); err != nil {
 		w.WriteHeader(http.StatusInternalServerError)
@@ -87,6 +95,10 @@ func (h *Handlers) ArchivePost(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	if !h.authorizePostEdit(w, r, uint(id)) {
+		return
+	}
+
 	userID := middlewares.GetUserID(r)
 	if err := h.PostService.ArchivePost(ctx, uint(id), userID); err != nil {
 		w.WriteHeader(http.StatusInternalServerError)
@@ -119,6 +131,10 @@ func (h *Handlers) DeletePost(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	if !h.authorizePostEdit(w, r, uint(id)) {
+		return
+	}
+
 	if err := h.PostService.DeletePost(ctx, uint(id)); err != nil {
 		w.WriteHeader(http.StatusInternalServerError)
 		json.NewEncoder(w).Encode(ErrorResponse{
@@ -0,0 +1,163 @@
+package handlers
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strconv"
+
+	"postal/post"
+	"postal/rest/middlewares"
+	"postal/rest/utils"
+)
+
+// PostStaffRoles may edit every post, other users only the posts they are credited on
+var PostStaffRoles = []string{"admin", "editor"}
+
+// GetPostAuthors returns the authors of a post and everyone who saved a version of it
+func (h *Handlers) GetPostAuthors(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	id, ok := parsePostID(w, r)
+	if !ok {
+		return
+	}
+
+	credits, err := h.PostService.GetPostCredits(ctx, id)
+	if err != nil {
+		sendAuthorError(w, "Failed to retrieve post authors", err)
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(SuccessResponse{
+		Status:  true,
+		Message: "Post authors retrieved successfully",
+		Data:    credits,
+	})
+}
+
+// SetPostAuthor credits a user on a post as author, editor or reviewer
+func (h *Handlers) SetPostAuthor(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	id, ok := parsePostID(w, r)
+	if !ok {
+		return
+	}
+	userID, ok := parseAuthorUserID(w, r)
+	if !ok {
+		return
+	}
+
+	var req post.SetPostAuthorRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		json.NewEncoder(w).Encode(ErrorResponse{
+			Status:  false,
+			Message: "Invalid request body",
+			Error:   err.Error(),
+		})
+		return
+	}
+	if validationErrs := h.Validator.ValidateStruct(&req); validationErrs != nil {
+		utils.SendJson(w, http.StatusBadRequest, map[string]any{
+			"status":  false,
+			"message": "Validation failed",
+			"errors":  validationErrs.Errors,
+		})
+		return
+	}
+
+	credits, err := h.PostService.SetPostAuthor(ctx, id, userID, req, postActor(r))
+	if err != nil {
+		sendAuthorError(w, "Failed to set post author", err)
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(SuccessResponse{
+		Status:  true,
+		Message: "Post author set successfully",
+		Data:    credits,
+	})
+}
+
+func (h *Handlers) RemovePostAuthor(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	id, ok := parsePostID(w, r)
+	if !ok {
+		return
+	}
+	userID, ok := parseAuthorUserID(w, r)
+	if !ok {
+		return
+	}
+
+	credits, err := h.PostService.RemovePostAuthor(ctx, id, userID, postActor(r))
+	if err != nil {
+		sendAuthorError(w, "Failed to remove post author", err)
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(SuccessResponse{
+		Status:  true,
+		Message: "Post author removed successfully",
+		Data:    credits,
+	})
+}
+
+// authorizePostEdit writes the error response and returns false when the
+// authenticated user may not change the post
+func (h *Handlers) authorizePostEdit(w http.ResponseWriter, r *http.Request, postID uint) bool {
+	if err := h.PostService.AuthorizePostEdit(r.Context(), postID, postActor(r)); err != nil {
+		sendAuthorError(w, "Not allowed to edit post", err)
+		return false
+	}
+	return true
+}
+
+// postActor is the authenticated user, admins and editors are staff
+func postActor(r *http.Request) post.Actor {
+	return post.Actor{
+		UserID:  middlewares.GetUserID(r),
+		IsStaff: middlewares.HasRole(r, PostStaffRoles...),
+	}
+}
+
+func parseAuthorUserID(w http.ResponseWriter, r *http.Request) (uint, bool) {
+	id, err := strconv.ParseUint(r.PathValue("user_id"), 10, 32)
+	if err != nil || id == 0 {
+		w.WriteHeader(http.StatusBadRequest)
+		json.NewEncoder(w).Encode(ErrorResponse{
+			Status:  false,
+			Message: "Invalid user ID",
+		})
+		return 0, false
+	}
+	return uint(id), true
+}
+
+// sendAuthorError maps post author errors to their HTTP status
+func sendAuthorError(w http.ResponseWriter, message string, err error) {
+	status := http.StatusInternalServerError
+	switch {
+	case errors.Is(err, post.ErrNotPostAuthor), errors.Is(err, post.ErrNotPostOwner):
+		status = http.StatusForbidden
+	case errors.Is(err, post.ErrLastAuthor):
+		status = http.StatusConflict
+	case errors.Is(err, post.ErrPostNotFound), errors.Is(err, post.ErrPostAuthorNotFound):
+		status = http.StatusNotFound
+	case errors.Is(err, post.ErrInvalidAuthorRole):
+		status = http.StatusBadRequest
+	}
+
+	w.WriteHeader(status)
+	json.NewEncoder(w).Encode(ErrorResponse{
+		Status:  false,
+		Message: message,
+		Error:   err.Error(),
+	})
+}
@@ -0,0 +1,204 @@
+package handlers
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strconv"
+
+	"postal/post"
+	"postal/rest/middlewares"
+	"postal/rest/utils"
+	"postal/util"
+)
+
+// ListPostVersions lists the versions of a post, only to users who may edit it
+func (h *Handlers) ListPostVersions(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	id, ok := parsePostID(w, r)
+	if !ok {
+		return
+	}
+
+	if !h.authorizePostEdit(w, r, id) {
+		return
+	}
+
+	versions, err := h.PostService.ListVersions(ctx, id)
+	if err != nil {
+		w.WriteHeader(http.StatusNotFound)
+		json.NewEncoder(w).Encode(ErrorResponse{
+			Status:  false,
+			Message: "Post not found",
+			Error:   err.Error(),
+		})
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(SuccessResponse{
+		Status:  true,
+		Message: "Versions retrieved successfully",
+		Data:    versions,
+	})
+}
+
+func (h *Handlers) GetPostVersion(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	id, ok := parsePostID(w, r)
+	if !ok {
+		return
+	}
+	versionNo, ok := parseVersionNo(w, r.PathValue("version"), "version")
+	if !ok {
+		return
+	}
+
+	if !h.authorizePostEdit(w, r, id) {
+		return
+	}
+
+	version, err := h.PostService.GetVersion(ctx, id, versionNo)
+	if err != nil {
+		w.WriteHeader(http.StatusNotFound)
+		json.NewEncoder(w).Encode(ErrorResponse{
+			Status:  false,
+			Message: "Version not found",
+			Error:   err.Error(),
+		})
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(SuccessResponse{
+		Status:  true,
+		Message: "Version retrieved successfully",
+		Data:    version,
+	})
+}
+
+// DiffPostVersions compares two versions, ?from=1&to=2&mode=line|word
+func (h *Handlers) DiffPostVersions(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	query := r.URL.Query()
+
+	id, ok := parsePostID(w, r)
+	if !ok {
+		return
+	}
+	from, ok := parseVersionNo(w, query.Get("from"), "from")
+	if !ok {
+		return
+	}
+	to, ok := parseVersionNo(w, query.Get("to"), "to")
+	if !ok {
+		return
+	}
+
+	mode := query.Get("mode")
+	if mode != "" && mode != util.DiffModeLine && mode != util.DiffModeWord {
+		w.WriteHeader(http.StatusBadRequest)
+		json.NewEncoder(w).Encode(ErrorResponse{
+			Status:  false,
+			Message: "Invalid mode, expected line or word",
+		})
+		return
+	}
+
+	if !h.authorizePostEdit(w, r, id) {
+		return
+	}
+
+	diff, err := h.PostService.DiffVersions(ctx, id, from, to, mode)
+	if err != nil {
+		w.WriteHeader(http.StatusNotFound)
+		json.NewEncoder(w).Encode(ErrorResponse{
+			Status:  false,
+			Message: "Version not found",
+			Error:   err.Error(),
+		})
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(SuccessResponse{
+		Status:  true,
+		Message: "Versions compared successfully",
+		Data:    diff,
+	})
+}
+
+func (h *Handlers) RestorePostVersion(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	id, ok := parsePostID(w, r)
+	if !ok {
+		return
+	}
+	versionNo, ok := parseVersionNo(w, r.PathValue("version"), "version")
+	if !ok {
+		return
+	}
+
+	if !h.authorizePostEdit(w, r, id) {
+		return
+	}
+
+	userID := middlewares.GetUserID(r)
+	postResp, err := h.PostService.RestoreVersion(ctx, id, versionNo, userID)
+	if err != nil {
+		var conflict *post.VersionConflictError
+		if errors.As(err, &conflict) {
+			sendVersionConflict(w, conflict, false)
+			return
+		}
+
+		status := http.StatusInternalServerError
+		if errors.Is(err, post.ErrPostNotFound) || errors.Is(err, post.ErrVersionNotFound) {
+			status = http.StatusNotFound
+		}
+		w.WriteHeader(status)
+		json.NewEncoder(w).Encode(ErrorResponse{
+			Status:  false,
+			Message: "Failed to restore version",
+			Error:   err.Error(),
+		})
+		return
+	}
+
+	w.Header().Set("ETag", postETag(postResp.Version))
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(SuccessResponse{
+		Status:  true,
+		Message: "Version restored successfully",
+		Data:    postResp,
+	})
+}
+
+func parsePostID(w http.ResponseWriter, r *http.Request) (uint, bool) {
+	id, err := strconv.ParseUint(utils.ExtractIDFromPath(r.URL.Path), 10, 32)
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		json.NewEncoder(w).Encode(ErrorResponse{
+			Status:  false,
+			Message: "Invalid post ID",
+		})
+		return 0, false
+	}
+	return uint(id), true
+}
+
+func parseVersionNo(w http.ResponseWriter, value, name string) (int, bool) {
+	versionNo, err := strconv.Atoi(value)
+	if err != nil || versionNo < 1 {
+		w.WriteHeader(http.StatusBadRequest)
+		json.NewEncoder(w).Encode(ErrorResponse{
+			Status:  false,
+			Message: "Invalid " + name + ", expected a version number",
+		})
+		return 0, false
+	}
+	return versionNo, true
+}
@@ -0,0 +1,70 @@
+package handlers
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"postal/post"
+	"postal/rest/utils"
+)
+
+// postETag identifies the edited state of a post, it follows the post Version
+func postETag(version int) string {
+	return fmt.Sprintf(`"%d"`, version)
+}
+
+// ifMatchVersions reads the versions a client based its edit on from If-Match,
+// a comma-separated list of which any may match (RFC 9110). present reports
+// whether the header was sent at all, "*" matches any version and leaves
+// versions nil. If-Match compares strong ETags only, a weak one is rejected
+// rather than matched.
+func ifMatchVersions(r *http.Request) (versions []int, present bool, err error) {
+	match := strings.TrimSpace(strings.Join(r.Header.Values("If-Match"), ","))
+	if match == "" {
+		return nil, false, nil
+	}
+	if match == "*" {
+		return nil, true, nil
+	}
+
+	for _, tag := range strings.Split(match, ",") {
+		tag = strings.TrimSpace(tag)
+		if tag == "" {
+			continue
+		}
+		if strings.HasPrefix(tag, "W/") {
+			return nil, true, fmt.Errorf("If-Match must list strong post ETags")
+		}
+		if len(tag) < 2 || tag[0] != '"' || tag[len(tag)-1] != '"' {
+			return nil, true, fmt.Errorf("If-Match must list post ETags")
+		}
+		v, err := strconv.Atoi(tag[1 : len(tag)-1])
+		if err != nil || v < 1 {
+			return nil, true, fmt.Errorf("If-Match must list post ETags")
+		}
+		versions = append(versions, v)
+	}
+	if len(versions) == 0 {
+		return nil, true, fmt.Errorf("If-Match must list post ETags")
+	}
+	return versions, true, nil
+}
+
+// sendVersionConflict answers an edit based on a stale version with the current one,
+// 412 when the stale version came from If-Match and 409 when it came from the body
+func sendVersionConflict(w http.ResponseWriter, conflict *post.VersionConflictError, fromIfMatch bool) {
+	status := http.StatusConflict
+	if fromIfMatch {
+		status = http.StatusPreconditionFailed
+	}
+
+	w.Header().Set("ETag", postETag(conflict.Current))
+	utils.SendJson(w, status, map[string]any{
+		"status":          false,
+		"message":         "Post has been modified by someone else",
+		"error":           conflict.Error(),
+		"current_version": conflict.Current,
+	})
+}
@@ -0,0 +1,111 @@
+package handlers
+
+import (
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestPostETag(t *testing.T) {
+	require.Equal(t, `"7"`, postETag(7))
+}
+
+func TestIfMatchVersions(t *testing.T) {
+	tests := []struct {
+		name             string
+		ifMatch          string
+		expectedVersions []int
+		expectedPresent  bool
+		expectError      bool
+	}{
+		{
+			name:            "No Header",
+			expectedPresent: false,
+		},
+		{
+			name:            "Any Version",
+			ifMatch:         "*",
+			expectedPresent: true,
+		},
+		{
+			name:             "Strong ETag",
+			ifMatch:          `"3"`,
+			expectedVersions: []int{3},
+			expectedPresent:  true,
+		},
+		{
+			name:             "Surrounding Whitespace",
+			ifMatch:          ` "12" `,
+			expectedVersions: []int{12},
+			expectedPresent:  true,
+		},
+		{
+			name:             "List Of ETags",
+			ifMatch:          `"3", "5","8"`,
+			expectedVersions: []int{3, 5, 8},
+			expectedPresent:  true,
+		},
+		{
+			name:             "Empty List Members Skipped",
+			ifMatch:          `"3",, "4",`,
+			expectedVersions: []int{3, 4},
+			expectedPresent:  true,
+		},
+		{
+			name:            "Weak ETag In List Rejected",
+			ifMatch:         `"3", W/"4"`,
+			expectedPresent: true,
+			expectError:     true,
+		},
+		{
+			name:            "Unquoted ETag",
+			ifMatch:         `3`,
+			expectedPresent: true,
+			expectError:     true,
+		},
+		{
+			name:            "Only Commas",
+			ifMatch:         `, ,`,
+			expectedPresent: true,
+			expectError:     true,
+		},
+		{
+			name:            "Weak ETag Rejected",
+			ifMatch:         `W/"3"`,
+			expectedPresent: true,
+			expectError:     true,
+		},
+		{
+			name:            "Not A Post ETag",
+			ifMatch:         `"abc"`,
+			expectedPresent: true,
+			expectError:     true,
+		},
+		{
+			name:            "Version Zero",
+			ifMatch:         `"0"`,
+			expectedPresent: true,
+			expectError:     true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := httptest.NewRequest("PUT", "/api/v1/posts/1", nil)
+			if tt.ifMatch != "" {
+				r.Header.Set("If-Match", tt.ifMatch)
+			}
+
+			versions, present, err := ifMatchVersions(r)
+
+			require.Equal(t, tt.expectedPresent, present)
+			if tt.expectError {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tt.expectedVersions, versions)
+		})
+	}
+}
@@ -0,0 +1,193 @@
+package handlers
+
+import (
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"strconv"
+
+	"postal/preview"
+	"postal/rest/middlewares"
+	"postal/rest/utils"
+)
+
+// CreatePreviewLink hands out a signed, expiring link that shows an unpublished
+// post to readers without an account
+func (h *Handlers) CreatePreviewLink(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	id, ok := parsePostID(w, r)
+	if !ok {
+		return
+	}
+	if !h.authorizePostEdit(w, r, id) {
+		return
+	}
+
+	// The body is optional, an empty one asks for a link to the current draft
+	var req preview.CreateLinkRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil && !errors.Is(err, io.EOF) {
+		w.WriteHeader(http.StatusBadRequest)
+		json.NewEncoder(w).Encode(ErrorResponse{
+			Status:  false,
+			Message: "Invalid request body",
+			Error:   err.Error(),
+		})
+		return
+	}
+	if validationErrs := h.Validator.ValidateStruct(&req); validationErrs != nil {
+		utils.SendJson(w, http.StatusBadRequest, map[string]any{
+			"status":  false,
+			"message": "Validation failed",
+			"errors":  validationErrs.Errors,
+		})
+		return
+	}
+
+	link, err := h.PreviewService.CreateLink(ctx, id, req, middlewares.GetUserID(r))
+	if err != nil {
+		sendPreviewError(w, "Failed to create preview link", err)
+		return
+	}
+	link.URL = previewURL(r, link.Token)
+
+	w.WriteHeader(http.StatusCreated)
+	json.NewEncoder(w).Encode(SuccessResponse{
+		Status:  true,
+		Message: "Preview link created successfully",
+		Data:    link,
+	})
+}
+
+// ListPreviewLinks returns the preview links of a post that still work
+func (h *Handlers) ListPreviewLinks(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	id, ok := parsePostID(w, r)
+	if !ok {
+		return
+	}
+	if !h.authorizePostEdit(w, r, id) {
+		return
+	}
+
+	links, err := h.PreviewService.ListLinks(ctx, id)
+	if err != nil {
+		sendPreviewError(w, "Failed to retrieve preview links", err)
+		return
+	}
+	for _, link := range links {
+		link.URL = previewURL(r, link.Token)
+	}
+
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(SuccessResponse{
+		Status:  true,
+		Message: "Preview links retrieved successfully",
+		Data:    links,
+	})
+}
+
+func (h *Handlers) RevokePreviewLink(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	id, ok := parsePostID(w, r)
+	if !ok {
+		return
+	}
+	linkID, err := strconv.ParseUint(r.PathValue("preview_id"), 10, 32)
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		json.NewEncoder(w).Encode(ErrorResponse{
+			Status:  false,
+			Message: "Invalid preview link ID",
+		})
+		return
+	}
+	if !h.authorizePostEdit(w, r, id) {
+		return
+	}
+
+	if err := h.PreviewService.RevokeLink(ctx, id, uint(linkID), middlewares.GetUserID(r)); err != nil {
+		sendPreviewError(w, "Failed to revoke preview link", err)
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(SuccessResponse{
+		Status:  true,
+		Message: "Preview link revoked successfully",
+	})
+}
+
+// RevokePreviewLinks revokes every outstanding preview link of a post
+func (h *Handlers) RevokePreviewLinks(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	id, ok := parsePostID(w, r)
+	if !ok {
+		return
+	}
+	if !h.authorizePostEdit(w, r, id) {
+		return
+	}
+
+	count, err := h.PreviewService.RevokeAll(ctx, id, middlewares.GetUserID(r))
+	if err != nil {
+		sendPreviewError(w, "Failed to revoke preview links", err)
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(SuccessResponse{
+		Status:  true,
+		Message: "Preview links revoked successfully",
+		Data:    map[string]int64{"revoked": count},
+	})
+}
+
+// GetPreview serves the post a preview token gives access to. Previews are never
+// cached by shared caches nor indexed by search engines.
+func (h *Handlers) GetPreview(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	w.Header().Set("Cache-Control", "private, no-store")
+	w.Header().Set("X-Robots-Tag", "noindex, nofollow")
+
+	p, err := h.PreviewService.Resolve(ctx, r.PathValue("token"))
+	if err != nil {
+		sendPreviewError(w, "Preview not found", err)
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(SuccessResponse{
+		Status:  true,
+		Message: "Preview retrieved successfully",
+		Data:    p,
+	})
+}
+
+func previewURL(r *http.Request, token string) string {
+	return requestBaseURL(r) + "/api/v1/preview/" + token
+}
+
+// sendPreviewError maps preview errors to their HTTP status, an invalid, expired
+// or revoked token reads as not found
+func sendPreviewError(w http.ResponseWriter, message string, err error) {
+	status := http.StatusInternalServerError
+	switch {
+	case errors.Is(err, preview.ErrInvalidToken), errors.Is(err, preview.ErrLinkNotFound):
+		status = http.StatusNotFound
+	case err.Error() == "post not found", err.Error() == "version not found":
+		status = http.StatusNotFound
+	}
+
+	w.WriteHeader(status)
+	json.NewEncoder(w).Encode(ErrorResponse{
+		Status:  false,
+		Message: message,
+		Error:   err.Error(),
+	})
+}
@@ -0,0 +1,184 @@
+package handlers
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strconv"
+
+	"postal/domain"
+	"postal/post"
+	"postal/rest/middlewares"
+)
+
+// GetPostEngagement returns the reaction and bookmark counts of a post
+func (h *Handlers) GetPostEngagement(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	postID, ok := parsePostID(w, r)
+	if !ok {
+		return
+	}
+
+	engagement, err := h.PostService.GetEngagement(ctx, postID, 0)
+	if err != nil {
+		sendEngagementError(w, "Failed to retrieve engagement", err)
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(SuccessResponse{
+		Status:  true,
+		Message: "Engagement retrieved successfully",
+		Data:    engagement,
+	})
+}
+
+// AddPostReaction reacts to a post, repeating the request keeps a single reaction
+func (h *Handlers) AddPostReaction(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	postID, ok := parsePostID(w, r)
+	if !ok {
+		return
+	}
+
+	reaction := domain.ReactionType(r.PathValue("type"))
+	engagement, err := h.PostService.ReactToPost(ctx, postID, middlewares.GetUserID(r), reaction)
+	if err != nil {
+		sendEngagementError(w, "Failed to add reaction", err)
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(SuccessResponse{
+		Status:  true,
+		Message: "Reaction added successfully",
+		Data:    engagement,
+	})
+}
+
+// RemovePostReaction removes a reaction, removing one that is not there succeeds
+func (h *Handlers) RemovePostReaction(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	postID, ok := parsePostID(w, r)
+	if !ok {
+		return
+	}
+
+	reaction := domain.ReactionType(r.PathValue("type"))
+	engagement, err := h.PostService.UnreactToPost(ctx, postID, middlewares.GetUserID(r), reaction)
+	if err != nil {
+		sendEngagementError(w, "Failed to remove reaction", err)
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(SuccessResponse{
+		Status:  true,
+		Message: "Reaction removed successfully",
+		Data:    engagement,
+	})
+}
+
+// AddPostBookmark bookmarks a post, repeating the request keeps a single bookmark
+func (h *Handlers) AddPostBookmark(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	postID, ok := parsePostID(w, r)
+	if !ok {
+		return
+	}
+
+	engagement, err := h.PostService.BookmarkPost(ctx, postID, middlewares.GetUserID(r))
+	if err != nil {
+		sendEngagementError(w, "Failed to add bookmark", err)
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(SuccessResponse{
+		Status:  true,
+		Message: "Bookmark added successfully",
+		Data:    engagement,
+	})
+}
+
+// RemovePostBookmark removes a bookmark, removing one that is not there succeeds
+func (h *Handlers) RemovePostBookmark(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	postID, ok := parsePostID(w, r)
+	if !ok {
+		return
+	}
+
+	engagement, err := h.PostService.UnbookmarkPost(ctx, postID, middlewares.GetUserID(r))
+	if err != nil {
+		sendEngagementError(w, "Failed to remove bookmark", err)
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(SuccessResponse{
+		Status:  true,
+		Message: "Bookmark removed successfully",
+		Data:    engagement,
+	})
+}
+
+// ListMyBookmarks returns the posts the authenticated user bookmarked, ?limit=&offset= page them
+func (h *Handlers) ListMyBookmarks(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	query := r.URL.Query()
+
+	limit, offset := 20, 0
+	if l, err := strconv.Atoi(query.Get("limit")); err == nil && l > 0 && l <= 100 {
+		limit = l
+	}
+	if o, err := strconv.Atoi(query.Get("offset")); err == nil && o > 0 {
+		offset = o
+	}
+
+	posts, total, err := h.PostService.ListBookmarks(ctx, middlewares.GetUserID(r), limit, offset)
+	if err != nil {
+		w.WriteHeader(http.StatusInternalServerError)
+		json.NewEncoder(w).Encode(ErrorResponse{
+			Status:  false,
+			Message: "Failed to retrieve bookmarks",
+			Error:   err.Error(),
+		})
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(PaginatedResponse{
+		Status:  true,
+		Message: "Bookmarks retrieved successfully",
+		Data:    posts,
+		Meta: MetaData{
+			Total:  total,
+			Limit:  limit,
+			Offset: offset,
+		},
+	})
+}
+
+// sendEngagementError maps reaction and bookmark errors to their HTTP status
+func sendEngagementError(w http.ResponseWriter, message string, err error) {
+	status := http.StatusInternalServerError
+	switch {
+	case errors.Is(err, post.ErrInvalidReaction):
+		status = http.StatusBadRequest
+	case errors.Is(err, post.ErrPostNotFound):
+		status = http.StatusNotFound
+	}
+
+	w.WriteHeader(status)
+	json.NewEncoder(w).Encode(ErrorResponse{
+		Status:  false,
+		Message: message,
+		Error:   err.Error(),
+	})
+}
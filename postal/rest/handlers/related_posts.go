@@ -0,0 +1,40 @@
+package handlers
+
+import (
+	"encoding/json"
+	"net/http"
+	"strconv"
+)
+
+// GetRelatedPosts returns the posts recommended after reading a post, ?limit= caps them
+func (h *Handlers) GetRelatedPosts(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	postID, ok := parsePostID(w, r)
+	if !ok {
+		return
+	}
+
+	limit := 5
+	if l, err := strconv.Atoi(r.URL.Query().Get("limit")); err == nil && l > 0 {
+		limit = l
+	}
+
+	posts, err := h.PostService.GetRelatedPosts(ctx, postID, limit)
+	if err != nil {
+		w.WriteHeader(http.StatusNotFound)
+		json.NewEncoder(w).Encode(ErrorResponse{
+			Status:  false,
+			Message: "Failed to retrieve related posts",
+			Error:   err.Error(),
+		})
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(SuccessResponse{
+		Status:  true,
+		Message: "Related posts retrieved successfully",
+		Data:    posts,
+	})
+}
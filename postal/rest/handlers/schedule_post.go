@@ -0,0 +1,121 @@
+package handlers
+
+import (
+	"encoding/json"
+	"net/http"
+	"strconv"
+
+	"postal/post"
+	"postal/rest/middlewares"
+)
+
+// SchedulePost sets when a post is published and/or unpublished by the scheduler
+func (h *Handlers) SchedulePost(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	id, ok := parsePostID(w, r)
+	if !ok {
+		return
+	}
+
+	if !h.authorizePostEdit(w, r, id) {
+		return
+	}
+
+	var req post.SchedulePostRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		json.NewEncoder(w).Encode(ErrorResponse{
+			Status:  false,
+			Message: "Invalid request body",
+			Error:   err.Error(),
+		})
+		return
+	}
+
+	userID := middlewares.GetUserID(r)
+	postResp, err := h.PostService.SchedulePost(ctx, id, req, userID)
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		json.NewEncoder(w).Encode(ErrorResponse{
+			Status:  false,
+			Message: "Failed to schedule post",
+			Error:   err.Error(),
+		})
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(SuccessResponse{
+		Status:  true,
+		Message: "Post scheduled successfully",
+		Data:    postResp,
+	})
+}
+
+func (h *Handlers) CancelPostSchedule(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	id, ok := parsePostID(w, r)
+	if !ok {
+		return
+	}
+
+	if !h.authorizePostEdit(w, r, id) {
+		return
+	}
+
+	userID := middlewares.GetUserID(r)
+	if err := h.PostService.CancelSchedule(ctx, id, userID); err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		json.NewEncoder(w).Encode(ErrorResponse{
+			Status:  false,
+			Message: "Failed to cancel schedule",
+			Error:   err.Error(),
+		})
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(SuccessResponse{
+		Status:  true,
+		Message: "Schedule cancelled successfully",
+	})
+}
+
+// ListScheduledPosts returns the upcoming scheduled publishes and unpublishes, soonest first
+func (h *Handlers) ListScheduledPosts(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	query := r.URL.Query()
+
+	limit, offset := 20, 0
+	if l, err := strconv.Atoi(query.Get("limit")); err == nil && l > 0 {
+		limit = l
+	}
+	if o, err := strconv.Atoi(query.Get("offset")); err == nil && o > 0 {
+		offset = o
+	}
+
+	posts, total, err := h.PostService.ListScheduledPosts(ctx, limit, offset)
+	if err != nil {
+		w.WriteHeader(http.StatusInternalServerError)
+		json.NewEncoder(w).Encode(ErrorResponse{
+			Status:  false,
+			Message: "Failed to retrieve scheduled posts",
+			Error:   err.Error(),
+		})
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(PaginatedResponse{
+		Status:  true,
+		Message: "Scheduled posts retrieved successfully",
+		Data:    posts,
+		Meta: MetaData{
+			Total:  total,
+			Limit:  limit,
+			Offset: offset,
+		},
+	})
+}
@@ -0,0 +1,91 @@
+package handlers
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"postal/post"
+)
+
+// SearchPosts runs a ranked full-text search over published posts,
+// ?q=<query>&category_id=&sub_category_id=&limit=&offset=
+func (h *Handlers) SearchPosts(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	query := r.URL.Query()
+
+	filter := post.SearchFilter{
+		Query:  strings.TrimSpace(query.Get("q")),
+		Limit:  20,
+		Offset: 0,
+	}
+
+	if filter.Query == "" {
+		w.WriteHeader(http.StatusBadRequest)
+		json.NewEncoder(w).Encode(ErrorResponse{
+			Status:  false,
+			Message: "Query parameter q is required",
+		})
+		return
+	}
+
+	if limit := query.Get("limit"); limit != "" {
+		if l, err := strconv.Atoi(limit); err == nil && l > 0 && l <= 100 {
+			filter.Limit = l
+		}
+	}
+
+	if offset := query.Get("offset"); offset != "" {
+		if o, err := strconv.Atoi(offset); err == nil && o >= 0 {
+			filter.Offset = o
+		}
+	}
+
+	if categoryID := query.Get("category_id"); categoryID != "" {
+		if cid, err := strconv.ParseUint(categoryID, 10, 32); err == nil {
+			id := uint(cid)
+			filter.CategoryID = &id
+		}
+	}
+
+	if subCategoryID := query.Get("sub_category_id"); subCategoryID != "" {
+		if scid, err := strconv.ParseUint(subCategoryID, 10, 32); err == nil {
+			id := uint(scid)
+			filter.SubCategoryID = &id
+		}
+	}
+
+	results, total, err := h.PostService.SearchPosts(ctx, filter)
+	if errors.Is(err, post.ErrEmptySearchQuery) {
+		w.WriteHeader(http.StatusBadRequest)
+		json.NewEncoder(w).Encode(ErrorResponse{
+			Status:  false,
+			Message: "Invalid search query",
+			Error:   err.Error(),
+		})
+		return
+	}
+	if err != nil {
+		w.WriteHeader(http.StatusInternalServerError)
+		json.NewEncoder(w).Encode(ErrorResponse{
+			Status:  false,
+			Message: "Failed to search posts",
+			Error:   err.Error(),
+		})
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(PaginatedResponse{
+		Status:  true,
+		Message: "Posts retrieved successfully",
+		Data:    results,
+		Meta: MetaData{
+			Total:  total,
+			Limit:  filter.Limit,
+			Offset: filter.Offset,
+		},
+	})
+}
@@ -0,0 +1,222 @@
+package handlers
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"postal/post"
+	"postal/rest/utils"
+)
+
+// ListSeries returns the series with published parts, ?limit=&offset= page them
+func (h *Handlers) ListSeries(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	query := r.URL.Query()
+
+	limit, offset := 20, 0
+	if l, err := strconv.Atoi(query.Get("limit")); err == nil && l > 0 && l <= 100 {
+		limit = l
+	}
+	if o, err := strconv.Atoi(query.Get("offset")); err == nil && o > 0 {
+		offset = o
+	}
+
+	series, total, err := h.PostService.ListSeries(ctx, limit, offset)
+	if err != nil {
+		w.WriteHeader(http.StatusInternalServerError)
+		json.NewEncoder(w).Encode(ErrorResponse{
+			Status:  false,
+			Message: "Failed to retrieve series",
+			Error:   err.Error(),
+		})
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(PaginatedResponse{
+		Status:  true,
+		Message: "Series retrieved successfully",
+		Data:    series,
+		Meta: MetaData{
+			Total:  total,
+			Limit:  limit,
+			Offset: offset,
+		},
+	})
+}
+
+// GetSeriesBySlug returns a series with its published parts in reading order
+func (h *Handlers) GetSeriesBySlug(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	series, err := h.PostService.GetSeriesBySlug(ctx, r.PathValue("slug"))
+	if err != nil {
+		sendSeriesError(w, "Series not found", err)
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(SuccessResponse{
+		Status:  true,
+		Message: "Series retrieved successfully",
+		Data:    series,
+	})
+}
+
+func (h *Handlers) CreateSeries(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var req post.CreateSeriesRequest
+	if !decodeSeriesRequest(h, w, r, &req) {
+		return
+	}
+
+	series, err := h.PostService.CreateSeries(ctx, req, postActor(r))
+	if err != nil {
+		sendSeriesError(w, "Failed to create series", err)
+		return
+	}
+
+	w.WriteHeader(http.StatusCreated)
+	json.NewEncoder(w).Encode(SuccessResponse{
+		Status:  true,
+		Message: "Series created successfully",
+		Data:    series,
+	})
+}
+
+func (h *Handlers) UpdateSeries(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	id, ok := parseSeriesID(w, r)
+	if !ok {
+		return
+	}
+
+	var req post.UpdateSeriesRequest
+	if !decodeSeriesRequest(h, w, r, &req) {
+		return
+	}
+
+	series, err := h.PostService.UpdateSeries(ctx, id, req, postActor(r))
+	if err != nil {
+		sendSeriesError(w, "Failed to update series", err)
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(SuccessResponse{
+		Status:  true,
+		Message: "Series updated successfully",
+		Data:    series,
+	})
+}
+
+// SetSeriesPosts replaces the ordered parts of a series
+func (h *Handlers) SetSeriesPosts(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	id, ok := parseSeriesID(w, r)
+	if !ok {
+		return
+	}
+
+	var req post.SeriesPostsRequest
+	if !decodeSeriesRequest(h, w, r, &req) {
+		return
+	}
+
+	series, err := h.PostService.SetSeriesPosts(ctx, id, req, postActor(r))
+	if err != nil {
+		sendSeriesError(w, "Failed to update series posts", err)
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(SuccessResponse{
+		Status:  true,
+		Message: "Series posts updated successfully",
+		Data:    series,
+	})
+}
+
+func (h *Handlers) DeleteSeries(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	id, ok := parseSeriesID(w, r)
+	if !ok {
+		return
+	}
+
+	if err := h.PostService.DeleteSeries(ctx, id, postActor(r)); err != nil {
+		sendSeriesError(w, "Failed to delete series", err)
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(SuccessResponse{
+		Status:  true,
+		Message: "Series deleted successfully",
+	})
+}
+
+func decodeSeriesRequest(h *Handlers, w http.ResponseWriter, r *http.Request, req any) bool {
+	if err := json.NewDecoder(r.Body).Decode(req); err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		json.NewEncoder(w).Encode(ErrorResponse{
+			Status:  false,
+			Message: "Invalid request body",
+			Error:   err.Error(),
+		})
+		return false
+	}
+	if validationErrs := h.Validator.ValidateStruct(req); validationErrs != nil {
+		utils.SendJson(w, http.StatusBadRequest, map[string]any{
+			"status":  false,
+			"message": "Validation failed",
+			"errors":  validationErrs.Errors,
+		})
+		return false
+	}
+	return true
+}
+
+func parseSeriesID(w http.ResponseWriter, r *http.Request) (uint, bool) {
+	id, err := strconv.ParseUint(r.PathValue("id"), 10, 32)
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		json.NewEncoder(w).Encode(ErrorResponse{
+			Status:  false,
+			Message: "Invalid series ID",
+		})
+		return 0, false
+	}
+	return uint(id), true
+}
+
+// sendSeriesError maps series errors to their HTTP status
+func sendSeriesError(w http.ResponseWriter, message string, err error) {
+	status := http.StatusInternalServerError
+	switch msg := err.Error(); {
+	case errors.Is(err, post.ErrNotSeriesOwner), errors.Is(err, post.ErrNotPostAuthor):
+		status = http.StatusForbidden
+	case errors.Is(err, post.ErrInvalidSlug):
+		status = http.StatusBadRequest
+	case strings.Contains(msg, "not found"):
+		status = http.StatusNotFound
+	case strings.Contains(msg, "already"):
+		status = http.StatusConflict
+	case strings.Contains(msg, "more than once"):
+		status = http.StatusBadRequest
+	}
+
+	w.WriteHeader(status)
+	json.NewEncoder(w).Encode(ErrorResponse{
+		Status:  false,
+		Message: message,
+		Error:   err.Error(),
+	})
+}
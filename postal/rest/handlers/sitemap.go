@@ -0,0 +1,97 @@
+package handlers
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"postal/sitemap"
+)
+
+// GetSitemap serves /sitemap.xml for the domain of the request, a sitemap
+// index once the site has more than sitemap.MaxURLs URLs
+func (h *Handlers) GetSitemap(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	root, err := h.SitemapService.Root(ctx)
+	if err != nil {
+		sendSitemapError(w, err)
+		return
+	}
+
+	var body []byte
+	if root.IsIndex() {
+		body, err = sitemap.EncodeIndex(h.publicBaseURL(r), root.Sitemaps)
+	} else {
+		body, err = sitemap.EncodeURLSet(h.publicBaseURL(r), root.Entries)
+	}
+	if err != nil {
+		sendSitemapError(w, err)
+		return
+	}
+
+	writeSitemap(w, body)
+}
+
+// GetSitemapFile serves the split sitemaps listed by the sitemap index,
+// /sitemaps/categories.xml and /sitemaps/posts-{page}.xml
+func (h *Handlers) GetSitemapFile(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var entries []sitemap.Entry
+	var err error
+
+	file := r.PathValue("file")
+	switch {
+	case "/sitemaps/"+file == sitemap.CategoriesPath:
+		entries, err = h.SitemapService.Categories(ctx)
+	case strings.HasPrefix(file, "posts-") && strings.HasSuffix(file, ".xml"):
+		page, parseErr := strconv.Atoi(strings.TrimSuffix(strings.TrimPrefix(file, "posts-"), ".xml"))
+		if parseErr != nil || page < 0 {
+			http.NotFound(w, r)
+			return
+		}
+		entries, err = h.SitemapService.PostPage(ctx, page)
+	default:
+		http.NotFound(w, r)
+		return
+	}
+	if err != nil {
+		sendSitemapError(w, err)
+		return
+	}
+
+	body, err := sitemap.EncodeURLSet(h.publicBaseURL(r), entries)
+	if err != nil {
+		sendSitemapError(w, err)
+		return
+	}
+
+	writeSitemap(w, body)
+}
+
+// GetRobots serves /robots.txt pointing crawlers to the sitemap of the domain
+func (h *Handlers) GetRobots(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "text/plain; charset=utf-8")
+	w.Header().Set("Cache-Control", "public, max-age=3600")
+	w.WriteHeader(http.StatusOK)
+	fmt.Fprintf(w, "User-agent: *\nAllow: /\nDisallow: /api/\n\nSitemap: %s/sitemap.xml\n", h.publicBaseURL(r))
+}
+
+func writeSitemap(w http.ResponseWriter, body []byte) {
+	w.Header().Set("Content-Type", "application/xml; charset=utf-8")
+	w.Header().Set("Cache-Control", "public, max-age=300")
+	w.WriteHeader(http.StatusOK)
+	w.Write(body)
+}
+
+func sendSitemapError(w http.ResponseWriter, err error) {
+	w.WriteHeader(http.StatusInternalServerError)
+	json.NewEncoder(w).Encode(ErrorResponse{
+		Status:  false,
+		Message: "Failed to build sitemap",
+		Error:   err.Error(),
+	})
+}
@@ -0,0 +1,84 @@
+package handlers
+
+import (
+	"encoding/json"
+	"net/http"
+	"strconv"
+
+	"postal/post"
+)
+
+// ListTags returns the tags of published posts with their post counts,
+// ?search=<prefix>&sort_by=count|name&limit=&offset=
+func (h *Handlers) ListTags(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	query := r.URL.Query()
+
+	filter := post.TagFilter{
+		Search: query.Get("search"),
+		SortBy: "count",
+		Limit:  50,
+		Offset: 0,
+	}
+
+	if query.Get("sort_by") == "name" {
+		filter.SortBy = "name"
+	}
+
+	if limit := query.Get("limit"); limit != "" {
+		if l, err := strconv.Atoi(limit); err == nil && l > 0 && l <= 500 {
+			filter.Limit = l
+		}
+	}
+
+	if offset := query.Get("offset"); offset != "" {
+		if o, err := strconv.Atoi(offset); err == nil && o >= 0 {
+			filter.Offset = o
+		}
+	}
+
+	tags, total, err := h.PostService.ListTags(ctx, filter)
+	if err != nil {
+		w.WriteHeader(http.StatusInternalServerError)
+		json.NewEncoder(w).Encode(ErrorResponse{
+			Status:  false,
+			Message: "Failed to retrieve tags",
+			Error:   err.Error(),
+		})
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(PaginatedResponse{
+		Status:  true,
+		Message: "Tags retrieved successfully",
+		Data:    tags,
+		Meta: MetaData{
+			Total:  total,
+			Limit:  filter.Limit,
+			Offset: filter.Offset,
+		},
+	})
+}
+
+func (h *Handlers) GetTagBySlug(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	tag, err := h.PostService.GetTagBySlug(ctx, r.PathValue("slug"))
+	if err != nil {
+		w.WriteHeader(http.StatusNotFound)
+		json.NewEncoder(w).Encode(ErrorResponse{
+			Status:  false,
+			Message: "Tag not found",
+			Error:   err.Error(),
+		})
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(SuccessResponse{
+		Status:  true,
+		Message: "Tag retrieved successfully",
+		Data:    tag,
+	})
+}
@@ -0,0 +1,66 @@
+package handlers
+
+import (
+	"net"
+	"net/http"
+	"net/url"
+	"strings"
+)
+
+// requestTenant identifies the tenant of a request by the X-Tenant header,
+// falling back to the host of the configured site the request was made to. The
+// TrustProxies middleware drops X-Tenant unless a trusted proxy set it and a
+// made up Host header only ever resolves to a configured site.
+func (h *Handlers) requestTenant(r *http.Request) string {
+	if tenant := strings.TrimSpace(r.Header.Get("X-Tenant")); tenant != "" {
+		return strings.ToLower(tenant)
+	}
+
+	baseURL := h.publicBaseURL(r)
+	if u, err := url.Parse(baseURL); err == nil && u.Hostname() != "" {
+		return strings.ToLower(u.Hostname())
+	}
+	return strings.ToLower(baseURL)
+}
+
+// requestBaseURL is the scheme and host the request was made to, honouring the
+// X-Forwarded-Proto and X-Forwarded-Host headers set by the proxy
+func requestBaseURL(r *http.Request) string {
+	scheme := "http"
+	if r.TLS != nil {
+		scheme = "https"
+	}
+	if proto := r.Header.Get("X-Forwarded-Proto"); proto != "" {
+		scheme, _, _ = strings.Cut(proto, ",")
+	}
+
+	host := r.Host
+	if forwarded := r.Header.Get("X-Forwarded-Host"); forwarded != "" {
+		host, _, _ = strings.Cut(forwarded, ",")
+	}
+	return strings.TrimSpace(scheme) + "://" + strings.TrimSpace(host)
+}
+
+// publicBaseURL is the configured site the request was made to, links in cached
+// feeds and sitemaps never point to a host a client made up. Unknown hosts get
+// the first site.
+func (h *Handlers) publicBaseURL(r *http.Request) string {
+	host := r.Host
+	if forwarded := r.Header.Get("X-Forwarded-Host"); forwarded != "" {
+		host, _, _ = strings.Cut(forwarded, ",")
+	}
+	host = strings.TrimSpace(host)
+	if hostname, _, err := net.SplitHostPort(host); err == nil {
+		host = hostname
+	}
+
+	for _, baseURL := range h.PublicBaseURLs {
+		if u, err := url.Parse(baseURL); err == nil && strings.EqualFold(u.Hostname(), host) {
+			return baseURL
+		}
+	}
+	if len(h.PublicBaseURLs) > 0 {
+		return h.PublicBaseURLs[0]
+	}
+	return requestBaseURL(r)
+}
@@ -2,6 +2,7 @@ package handlers
 
 import (
 	"encoding/json"
+	"errors"
 	"net/http"
 	"strconv"
 
@@ -29,6 +30,9 @@ func (h *Handlers) UpdatePost(w http.ResponseWriter, r *http.Request) {
 		http.Error(w, "Unauthorized", http.StatusUnauthorized)
 		return
 	}
+	if !h.authorizePostEdit(w, r, uint(id)) {
+		return
+	}
 
 	var req post.UpdatePostRequest
 	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
@@ -41,8 +45,38 @@ func (h *Handlers) UpdatePost(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	// Edits must say which version they are based on, through If-Match or the body
+	versions, fromIfMatch, err := ifMatchVersions(r)
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		json.NewEncoder(w).Encode(ErrorResponse{
+			Status:  false,
+			Message: "Invalid If-Match header",
+			Error:   err.Error(),
+		})
+		return
+	}
+	if versions != nil {
+		req.Version = nil
+		req.IfMatch = versions
+	}
+	if !fromIfMatch && req.Version == nil {
+		w.WriteHeader(http.StatusPreconditionRequired)
+		json.NewEncoder(w).Encode(ErrorResponse{
+			Status:  false,
+			Message: "If-Match header or version is required",
+		})
+		return
+	}
+
 	postResp, err := h.PostService.UpdatePost(ctx, uint(id), req, userID)
 	if err != nil {
+		var conflict *post.VersionConflictError
+		if errors.As(err, &conflict) {
+			sendVersionConflict(w, conflict, versions != nil)
+			return
+		}
+
 		w.WriteHeader(http.StatusInternalServerError)
 		json.NewEncoder(w).Encode(ErrorResponse{
 			Status:  false,
@@ -52,6 +86,7 @@ func (h *Handlers) UpdatePost(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	w.Header().Set("ETag", postETag(postResp.Version))
 	w.WriteHeader(http.StatusOK)
 	json.NewEncoder(w).Encode(SuccessResponse{
 		Status:  true,
@@ -53,6 +53,31 @@ func (m *Middlewares) AuthenticateJWT(next http.Handler) http.Handler {
 	})
 }
 
+// RequireRole only lets users with one of the roles through, it must wrap
+// handlers already behind AuthenticateJWT
+func (m *Middlewares) RequireRole(roles ...string) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if !HasRole(r, roles...) {
+				respondWithError(w, "Insufficient permissions", http.StatusForbidden)
+				return
+			}
+			next.ServeHTTP(w, r)
+		})
+	}
+}
+
+// HasRole reports whether the authenticated user has one of the roles
+func HasRole(r *http.Request, roles ...string) bool {
+	role := GetRole(r)
+	for _, allowed := range roles {
+		if role == allowed {
+			return true
+		}
+	}
+	return false
+}
+
 func GetUserID(r *http.Request) uint {
 	if userID, ok := r.Context().Value(UserIDKey).(uint); ok {
 		return userID
@@ -1,15 +1,21 @@
 package middlewares
 
-import "github.com/ulule/limiter/v3"
+import (
+	"net/netip"
+
+	"github.com/ulule/limiter/v3"
+)
 
 type Middlewares struct {
-	jwtSecret string
-	IPStore   limiter.Store
+	jwtSecret      string
+	trustedProxies []netip.Prefix
+	IPStore        limiter.Store
 }
 
-func NewMiddlewares(jwtSecret string, ipStore limiter.Store) *Middlewares {
+func NewMiddlewares(jwtSecret string, trustedProxies []string, ipStore limiter.Store) *Middlewares {
 	return &Middlewares{
-		jwtSecret: jwtSecret,
-		IPStore:   ipStore,
+		jwtSecret:      jwtSecret,
+		trustedProxies: parseTrustedProxies(trustedProxies),
+		IPStore:        ipStore,
 	}
 }
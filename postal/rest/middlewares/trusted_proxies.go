@@ -0,0 +1,65 @@
+package middlewares
+
+import (
+	"log"
+	"net"
+	"net/http"
+	"net/netip"
+	"strings"
+)
+
+// forwardedHeaders are set by the reverse proxy in front of postal, a client
+// talking to postal directly could set them to pick another tenant, address or visitor
+var forwardedHeaders = []string{"X-Tenant", "X-Visitor-ID", "X-Forwarded-For", "X-Forwarded-Host", "X-Forwarded-Proto"}
+
+// parseTrustedProxies reads the proxy addresses and CIDR ranges, invalid entries are skipped
+func parseTrustedProxies(entries []string) []netip.Prefix {
+	var prefixes []netip.Prefix
+	for _, entry := range entries {
+		entry = strings.TrimSpace(entry)
+		if entry == "" {
+			continue
+		}
+		if prefix, err := netip.ParsePrefix(entry); err == nil {
+			prefixes = append(prefixes, prefix.Masked())
+			continue
+		}
+		if addr, err := netip.ParseAddr(entry); err == nil {
+			prefixes = append(prefixes, netip.PrefixFrom(addr, addr.BitLen()))
+			continue
+		}
+		log.Printf("⚠️ Ignoring invalid trusted proxy %q", entry)
+	}
+	return prefixes
+}
+
+// TrustProxies drops the forwarded headers of requests that did not come
+// through one of the trusted proxies, handlers may then rely on them
+func (m *Middlewares) TrustProxies(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if !m.fromTrustedProxy(r) {
+			for _, header := range forwardedHeaders {
+				r.Header.Del(header)
+			}
+		}
+		next.ServeHTTP(w, r)
+	})
+}
+
+func (m *Middlewares) fromTrustedProxy(r *http.Request) bool {
+	host, _, err := net.SplitHostPort(r.RemoteAddr)
+	if err != nil {
+		host = r.RemoteAddr
+	}
+	addr, err := netip.ParseAddr(host)
+	if err != nil {
+		return false
+	}
+	addr = addr.Unmap()
+	for _, prefix := range m.trustedProxies {
+		if prefix.Contains(addr) {
+			return true
+		}
+	}
+	return false
+}
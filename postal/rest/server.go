@@ -30,6 +30,20 @@ func NewServeMux(mw *middlewares.Middlewares, h *handlers.Handlers) (http.Handle
 	mux.HandleFunc("GET /api/v1/posts", h.ListPosts)
 	mux.HandleFunc("GET /api/v1/posts/{id}", h.GetPostByID)
 	mux.HandleFunc("GET /api/v1/posts/slug/{slug}", h.GetPostBySlug)
+	mux.HandleFunc("GET /api/v1/posts/search", h.SearchPosts)
+
+	// View stats are internal, only cortex reads them with its service token
+	mux.HandleFunc("GET /api/v1/posts/view-stats", func(w http.ResponseWriter, r *http.Request) {
+		mw.AuthenticateJWT(mw.RequireRole(handlers.ServiceRole)(http.HandlerFunc(h.ListViewStats))).ServeHTTP(w, r)
+	})
+
+	// Comments (public)
+	mux.HandleFunc("GET /api/v1/comments/counts", h.CountPostComments)
+	mux.HandleFunc("GET /api/v1/comments/settings", h.GetCommentSettings)
+
+	// Tags (public)
+	mux.HandleFunc("GET /api/v1/tags", h.ListTags)
+	mux.HandleFunc("GET /api/v1/tags/{slug}", h.GetTagBySlug)
 
 	// Protected routes
 	mux.HandleFunc("POST /api/v1/posts", func(w http.ResponseWriter, r *http.Request) {
@@ -38,6 +52,18 @@ func NewServeMux(mw *middlewares.Middlewares, h *handlers.Handlers) (http.Handle
 	mux.HandleFunc("POST /api/v1/posts/batch", func(w http.ResponseWriter, r *http.Request) {
 		mw.AuthenticateJWT(http.HandlerFunc(h.BatchUploadPosts)).ServeHTTP(w, r)
 	})
+	mux.HandleFunc("POST /api/v1/posts/import", func(w http.ResponseWriter, r *http.Request) {
+		mw.AuthenticateJWT(http.HandlerFunc(h.ImportMarkdownPosts)).ServeHTTP(w, r)
+	})
+	mux.HandleFunc("GET /api/v1/import-jobs/{id}", func(w http.ResponseWriter, r *http.Request) {
+		mw.AuthenticateJWT(http.HandlerFunc(h.GetImportJob)).ServeHTTP(w, r)
+	})
+	mux.HandleFunc("GET /api/v1/import-jobs/{id}/rows", func(w http.ResponseWriter, r *http.Request) {
+		mw.AuthenticateJWT(http.HandlerFunc(h.ListImportJobRows)).ServeHTTP(w, r)
+	})
+	mux.HandleFunc("GET /api/v1/posts/export", func(w http.ResponseWriter, r *http.Request) {
+		mw.AuthenticateJWT(mw.RequireRole(handlers.PostStaffRoles...)(http.HandlerFunc(h.ExportPosts))).ServeHTTP(w, r)
+	})
 	mux.HandleFunc("PUT /api/v1/posts/{id}", func(w http.ResponseWriter, r *http.Request) {
 		mw.AuthenticateJWT(http.HandlerFunc(h.UpdatePost)).ServeHTTP(w, r)
 	})
@@ -46,7 +72,7 @@ func NewServeMux(mw *middlewares.Middlewares, h *handlers.Handlers) (http.Handle
 	})
 
 	mux.HandleFunc("DELETE /api/v1/posts/batch", func(w http.ResponseWriter, r *http.Request){
-		mw.AuthenticateJWT(http.HandlerFunc(h.BatchDeletePosts)).ServeHTTP(w, r)
+		mw.AuthenticateJWT(mw.RequireRole(handlers.PostStaffRoles...)(http.HandlerFunc(h.BatchDeletePosts))).ServeHTTP(w, r)
 	})
 
 	// Post action routes (protected)
@@ -60,15 +86,155 @@ func NewServeMux(mw *middlewares.Middlewares, h *handlers.Handlers) (http.Handle
 		mw.AuthenticateJWT(http.HandlerFunc(h.ArchivePost)).ServeHTTP(w, r)
 	})
 
+	// Comments (protected)
+	mux.HandleFunc("POST /api/v1/posts/{id}/comments", func(w http.ResponseWriter, r *http.Request) {
+		mw.AuthenticateJWT(http.HandlerFunc(h.CreateComment)).ServeHTTP(w, r)
+	})
+	mux.HandleFunc("PUT /api/v1/comments/{id}", func(w http.ResponseWriter, r *http.Request) {
+		mw.AuthenticateJWT(http.HandlerFunc(h.UpdateComment)).ServeHTTP(w, r)
+	})
+	mux.HandleFunc("DELETE /api/v1/comments/{id}", func(w http.ResponseWriter, r *http.Request) {
+		mw.AuthenticateJWT(http.HandlerFunc(h.DeleteComment)).ServeHTTP(w, r)
+	})
+
+	// Comment moderation (editors)
+	requireModerator := mw.RequireRole(handlers.CommentModeratorRoles...)
+	mux.HandleFunc("GET /api/v1/comments/moderation", func(w http.ResponseWriter, r *http.Request) {
+		mw.AuthenticateJWT(requireModerator(http.HandlerFunc(h.ListModerationQueue))).ServeHTTP(w, r)
+	})
+	mux.HandleFunc("POST /api/v1/comments/{id}/moderate", func(w http.ResponseWriter, r *http.Request) {
+		mw.AuthenticateJWT(requireModerator(http.HandlerFunc(h.ModerateComment))).ServeHTTP(w, r)
+	})
+	mux.HandleFunc("PUT /api/v1/comments/settings", func(w http.ResponseWriter, r *http.Request) {
+		mw.AuthenticateJWT(requireModerator(http.HandlerFunc(h.UpdateCommentSettings))).ServeHTTP(w, r)
+	})
+
+	// Scheduling (protected)
+	mux.HandleFunc("GET /api/v1/posts/scheduled", func(w http.ResponseWriter, r *http.Request) {
+		mw.AuthenticateJWT(mw.RequireRole(handlers.PostStaffRoles...)(http.HandlerFunc(h.ListScheduledPosts))).ServeHTTP(w, r)
+	})
+	mux.HandleFunc("POST /api/v1/posts/{id}/schedule", func(w http.ResponseWriter, r *http.Request) {
+		mw.AuthenticateJWT(http.HandlerFunc(h.SchedulePost)).ServeHTTP(w, r)
+	})
+	mux.HandleFunc("DELETE /api/v1/posts/{id}/schedule", func(w http.ResponseWriter, r *http.Request) {
+		mw.AuthenticateJWT(http.HandlerFunc(h.CancelPostSchedule)).ServeHTTP(w, r)
+	})
+
+	// Series
+	mux.HandleFunc("GET /api/v1/series", h.ListSeries)
+	mux.HandleFunc("GET /api/v1/series/{slug}", h.GetSeriesBySlug)
+	mux.HandleFunc("POST /api/v1/series", func(w http.ResponseWriter, r *http.Request) {
+		mw.AuthenticateJWT(http.HandlerFunc(h.CreateSeries)).ServeHTTP(w, r)
+	})
+	mux.HandleFunc("PUT /api/v1/series/{id}", func(w http.ResponseWriter, r *http.Request) {
+		mw.AuthenticateJWT(http.HandlerFunc(h.UpdateSeries)).ServeHTTP(w, r)
+	})
+	mux.HandleFunc("PUT /api/v1/series/{id}/posts", func(w http.ResponseWriter, r *http.Request) {
+		mw.AuthenticateJWT(http.HandlerFunc(h.SetSeriesPosts)).ServeHTTP(w, r)
+	})
+	mux.HandleFunc("DELETE /api/v1/series/{id}", func(w http.ResponseWriter, r *http.Request) {
+		mw.AuthenticateJWT(http.HandlerFunc(h.DeleteSeries)).ServeHTTP(w, r)
+	})
+
+	// Media library, files themselves are served below /media/
+	mux.HandleFunc("POST /api/v1/media", func(w http.ResponseWriter, r *http.Request) {
+		mw.AuthenticateJWT(http.HandlerFunc(h.UploadAsset)).ServeHTTP(w, r)
+	})
+	mux.HandleFunc("GET /api/v1/media", func(w http.ResponseWriter, r *http.Request) {
+		mw.AuthenticateJWT(http.HandlerFunc(h.ListAssets)).ServeHTTP(w, r)
+	})
+	mux.HandleFunc("GET /api/v1/media/usage", func(w http.ResponseWriter, r *http.Request) {
+		mw.AuthenticateJWT(http.HandlerFunc(h.GetMediaUsage)).ServeHTTP(w, r)
+	})
+	mux.HandleFunc("GET /api/v1/media/{id}", func(w http.ResponseWriter, r *http.Request) {
+		mw.AuthenticateJWT(http.HandlerFunc(h.GetAsset)).ServeHTTP(w, r)
+	})
+	mux.HandleFunc("DELETE /api/v1/media/{id}", func(w http.ResponseWriter, r *http.Request) {
+		mw.AuthenticateJWT(http.HandlerFunc(h.DeleteAsset)).ServeHTTP(w, r)
+	})
+	mux.HandleFunc("GET /media/{key...}", h.ServeMedia)
+
+	// Reactions and bookmarks
+	mux.HandleFunc("PUT /api/v1/posts/{id}/reactions/{type}", func(w http.ResponseWriter, r *http.Request) {
+		mw.AuthenticateJWT(http.HandlerFunc(h.AddPostReaction)).ServeHTTP(w, r)
+	})
+	mux.HandleFunc("DELETE /api/v1/posts/{id}/reactions/{type}", func(w http.ResponseWriter, r *http.Request) {
+		mw.AuthenticateJWT(http.HandlerFunc(h.RemovePostReaction)).ServeHTTP(w, r)
+	})
+	mux.HandleFunc("PUT /api/v1/posts/{id}/bookmark", func(w http.ResponseWriter, r *http.Request) {
+		mw.AuthenticateJWT(http.HandlerFunc(h.AddPostBookmark)).ServeHTTP(w, r)
+	})
+	mux.HandleFunc("DELETE /api/v1/posts/{id}/bookmark", func(w http.ResponseWriter, r *http.Request) {
+		mw.AuthenticateJWT(http.HandlerFunc(h.RemovePostBookmark)).ServeHTTP(w, r)
+	})
+	mux.HandleFunc("GET /api/v1/users/me/bookmarks", func(w http.ResponseWriter, r *http.Request) {
+		mw.AuthenticateJWT(http.HandlerFunc(h.ListMyBookmarks)).ServeHTTP(w, r)
+	})
+
+	// Post sub-resources
+	// "/posts/{id}/versions" would conflict with "/posts/slug/{slug}", so these routes
+	// live on their own mux below /api/v1/posts, where no slug route exists
+	postResources := http.NewServeMux()
+	postResources.HandleFunc("GET /{id}/comments", h.ListPostComments)
+	postResources.HandleFunc("GET /{id}/engagement", h.GetPostEngagement)
+	postResources.HandleFunc("GET /{id}/related", h.GetRelatedPosts)
+	postResources.HandleFunc("GET /{id}/authors", h.GetPostAuthors)
+	postResources.HandleFunc("GET /{id}/assets", h.GetPostAssets)
+	postResources.HandleFunc("GET /{id}/previews", func(w http.ResponseWriter, r *http.Request) {
+		mw.AuthenticateJWT(http.HandlerFunc(h.ListPreviewLinks)).ServeHTTP(w, r)
+	})
+	postResources.HandleFunc("GET /{id}/versions", func(w http.ResponseWriter, r *http.Request) {
+		mw.AuthenticateJWT(http.HandlerFunc(h.ListPostVersions)).ServeHTTP(w, r)
+	})
+	mux.Handle("GET /api/v1/posts/{id}/{resource}", http.StripPrefix("/api/v1/posts", postResources))
+
+	mux.HandleFunc("PUT /api/v1/posts/{id}/authors/{user_id}", func(w http.ResponseWriter, r *http.Request) {
+		mw.AuthenticateJWT(http.HandlerFunc(h.SetPostAuthor)).ServeHTTP(w, r)
+	})
+	mux.HandleFunc("DELETE /api/v1/posts/{id}/authors/{user_id}", func(w http.ResponseWriter, r *http.Request) {
+		mw.AuthenticateJWT(http.HandlerFunc(h.RemovePostAuthor)).ServeHTTP(w, r)
+	})
+	// Draft previews, the token itself grants access to GET /api/v1/preview/{token}
+	mux.HandleFunc("POST /api/v1/posts/{id}/previews", func(w http.ResponseWriter, r *http.Request) {
+		mw.AuthenticateJWT(http.HandlerFunc(h.CreatePreviewLink)).ServeHTTP(w, r)
+	})
+	mux.HandleFunc("DELETE /api/v1/posts/{id}/previews", func(w http.ResponseWriter, r *http.Request) {
+		mw.AuthenticateJWT(http.HandlerFunc(h.RevokePreviewLinks)).ServeHTTP(w, r)
+	})
+	mux.HandleFunc("DELETE /api/v1/posts/{id}/previews/{preview_id}", func(w http.ResponseWriter, r *http.Request) {
+		mw.AuthenticateJWT(http.HandlerFunc(h.RevokePreviewLink)).ServeHTTP(w, r)
+	})
+	mux.HandleFunc("GET /api/v1/preview/{token}", h.GetPreview)
+
+	mux.HandleFunc("GET /api/v1/posts/{id}/versions/diff", func(w http.ResponseWriter, r *http.Request) {
+		mw.AuthenticateJWT(http.HandlerFunc(h.DiffPostVersions)).ServeHTTP(w, r)
+	})
+	mux.HandleFunc("GET /api/v1/posts/{id}/versions/{version}", func(w http.ResponseWriter, r *http.Request) {
+		mw.AuthenticateJWT(http.HandlerFunc(h.GetPostVersion)).ServeHTTP(w, r)
+	})
+	mux.HandleFunc("POST /api/v1/posts/{id}/versions/{version}/restore", func(w http.ResponseWriter, r *http.Request) {
+		mw.AuthenticateJWT(http.HandlerFunc(h.RestorePostVersion)).ServeHTTP(w, r)
+	})
+
+	// Feeds, /feeds/.../posts.rss, posts.atom and posts.json
+	mux.HandleFunc("GET /feeds/{file}", h.GetPostsFeed)
+	mux.HandleFunc("GET /feeds/categories/{id}/{file}", h.GetCategoryFeed)
+	mux.HandleFunc("GET /feeds/tags/{slug}/{file}", h.GetTagFeed)
+
+	// Sitemaps and robots.txt, served for the domain of the request
+	mux.HandleFunc("GET /sitemap.xml", h.GetSitemap)
+	mux.HandleFunc("GET /sitemaps/{file}", h.GetSitemapFile)
+	mux.HandleFunc("GET /robots.txt", h.GetRobots)
+
 	// Setup swagger with its own middleware manager
 	swaggerManager := middlewares.NewManager()
 	swaggerManager.Use(middlewares.Recover, middlewares.Logger, middlewares.CORS)
 	swagger.SetupSwagger(mux, swaggerManager)
 
 	// Apply global middlewares to all routes (including swagger)
-	// Order: RateLimiter (outermost) -> CORS -> Logger -> Recover -> Routes (innermost)
+	// Order: TrustProxies (outermost) -> RateLimiter -> CORS -> Logger -> Recover -> Routes (innermost)
 	manager := middlewares.NewManager()
-	handler := manager.With(mux, mw.RateLimiter, middlewares.CORS, middlewares.Logger, middlewares.Recover)
+	handler := manager.With(mux, mw.TrustProxies, mw.RateLimiter, middlewares.CORS, middlewares.Logger, middlewares.Recover)
 
 	return handler, nil
 }
@@ -0,0 +1,87 @@
+package sitemap
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// cortexPageSize is the number of categories requested from cortex at a time
+const cortexPageSize = 100
+
+var cortexHTTPClient = &http.Client{Timeout: 10 * time.Second}
+
+type cortexCategories struct {
+	baseURL string
+}
+
+// NewCortexCategories loads the approved categories from the cortex API,
+// an empty baseURL disables categories in the sitemap
+func NewCortexCategories(baseURL string) CategorySource {
+	return &cortexCategories{baseURL: strings.TrimRight(baseURL, "/")}
+}
+
+type cortexCategory struct {
+	Slug      string    `json:"slug"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+func (c *cortexCategories) ListApprovedCategories(ctx context.Context) ([]Entry, error) {
+	entries := []Entry{}
+	if c.baseURL == "" {
+		return entries, nil
+	}
+
+	for offset := 0; ; offset += cortexPageSize {
+		categories, err := c.fetch(ctx, offset)
+		if err != nil {
+			return nil, err
+		}
+		for _, category := range categories {
+			entries = append(entries, Entry{
+				Path:    "/categories/" + url.PathEscape(category.Slug),
+				LastMod: category.UpdatedAt,
+			})
+		}
+		if len(categories) < cortexPageSize {
+			return entries, nil
+		}
+	}
+}
+
+func (c *cortexCategories) fetch(ctx context.Context, offset int) ([]cortexCategory, error) {
+	query := url.Values{}
+	query.Set("status", "approved")
+	query.Set("limit", strconv.Itoa(cortexPageSize))
+	query.Set("offset", strconv.Itoa(offset))
+	endpoint := c.baseURL + "/api/v1/categories?" + query.Encode()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, endpoint, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := cortexHTTPClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("cortex: categories request failed: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("cortex: categories request failed with status %d", resp.StatusCode)
+	}
+
+	var body struct {
+		Data []cortexCategory `json:"data"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&body); err != nil {
+		return nil, fmt.Errorf("cortex: failed to decode categories: %w", err)
+	}
+
+	return body.Data, nil
+}
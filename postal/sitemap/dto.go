@@ -0,0 +1,29 @@
+package sitemap
+
+import "time"
+
+// Entry is one URL of a sitemap
+type Entry struct {
+	Path    string    `json:"path"`
+	LastMod time.Time `json:"last_mod"`
+}
+
+// Page is a block of MaxURLs post ids, posts never move between pages so
+// publishing or unpublishing a post only changes the page of its id
+type Page struct {
+	Number  int       `json:"number"`
+	Count   int64     `json:"count"`
+	LastMod time.Time `json:"last_mod"`
+}
+
+// Root is what /sitemap.xml serves: every URL while they fit in one sitemap,
+// an index of the split sitemaps past MaxURLs
+type Root struct {
+	Entries  []Entry
+	Sitemaps []Entry
+}
+
+// IsIndex reports whether the root sitemap is a sitemap index
+func (r *Root) IsIndex() bool {
+	return r.Sitemaps != nil
+}
@@ -0,0 +1,24 @@
+package sitemap
+
+import (
+	"context"
+)
+
+// Service builds the sitemaps of the public site. Sitemaps hold paths only,
+// the handler prefixes them with the domain of the tenant being served.
+type Service interface {
+	Root(ctx context.Context) (*Root, error)
+	PostPage(ctx context.Context, page int) ([]Entry, error)
+	Categories(ctx context.Context) ([]Entry, error)
+}
+
+// Repository loads the published posts of the sitemap
+type Repository interface {
+	ListPostPages(ctx context.Context, pageSize int) ([]Page, error)
+	ListPostEntries(ctx context.Context, page, pageSize int) ([]Entry, error)
+}
+
+// CategorySource loads the approved categories, they are owned by cortex
+type CategorySource interface {
+	ListApprovedCategories(ctx context.Context) ([]Entry, error)
+}
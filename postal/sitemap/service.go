@@ -0,0 +1,172 @@
+package sitemap
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"time"
+
+	"postal/cache"
+)
+
+// MaxURLs is the most URLs a single sitemap may hold
+const MaxURLs = 50000
+
+const (
+	pagesCacheKey      = "sitemap:pages"
+	categoriesCacheKey = "sitemap:categories"
+
+	// Pages are invalidated by post writes, the TTLs only bound drift
+	pagesCacheTTL      = time.Hour
+	postPageCacheTTL   = 24 * time.Hour
+	categoriesCacheTTL = time.Hour
+)
+
+func postPageCacheKey(page int) string {
+	return fmt.Sprintf("sitemap:posts:%d", page)
+}
+
+// PostCacheKeys are the cached sitemap entries a change of the post affects,
+// deleting them regenerates only the page of the post
+func PostCacheKeys(postID uint) []string {
+	return []string{pagesCacheKey, postPageCacheKey(int(postID / MaxURLs))}
+}
+
+// PostPagePath is the path of the sitemap of a post page
+func PostPagePath(page int) string {
+	return fmt.Sprintf("/sitemaps/posts-%d.xml", page)
+}
+
+// CategoriesPath is the path of the sitemap of the categories
+const CategoriesPath = "/sitemaps/categories.xml"
+
+type service struct {
+	repo       Repository
+	categories CategorySource
+	cache      cache.Cache
+}
+
+func (s *service) Root(ctx context.Context) (*Root, error) {
+	pages, err := s.postPages(ctx)
+	if err != nil {
+		return nil, err
+	}
+	categories, err := s.Categories(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// The home page, the categories and every post
+	total := int64(1 + len(categories))
+	for _, page := range pages {
+		total += page.Count
+	}
+
+	if total > MaxURLs {
+		sitemaps := make([]Entry, 0, len(pages)+1)
+		if len(categories) > 0 {
+			sitemaps = append(sitemaps, Entry{Path: CategoriesPath, LastMod: latest(categories)})
+		}
+		for _, page := range pages {
+			sitemaps = append(sitemaps, Entry{Path: PostPagePath(page.Number), LastMod: page.LastMod})
+		}
+		return &Root{Sitemaps: sitemaps}, nil
+	}
+
+	entries := make([]Entry, 0, total)
+	entries = append(entries, Entry{Path: "/"})
+	entries = append(entries, categories...)
+	for _, page := range pages {
+		posts, err := s.PostPage(ctx, page.Number)
+		if err != nil {
+			return nil, err
+		}
+		entries = append(entries, posts...)
+	}
+	entries[0].LastMod = latest(entries[1:])
+
+	return &Root{Entries: entries}, nil
+}
+
+func (s *service) PostPage(ctx context.Context, page int) ([]Entry, error) {
+	var entries []Entry
+	if s.getCached(ctx, postPageCacheKey(page), &entries) {
+		return entries, nil
+	}
+
+	entries, err := s.repo.ListPostEntries(ctx, page, MaxURLs)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list sitemap posts: %w", err)
+	}
+
+	s.setCached(ctx, postPageCacheKey(page), entries, postPageCacheTTL)
+	return entries, nil
+}
+
+func (s *service) Categories(ctx context.Context) ([]Entry, error) {
+	var entries []Entry
+	if s.getCached(ctx, categoriesCacheKey, &entries) {
+		return entries, nil
+	}
+
+	entries, err := s.categories.ListApprovedCategories(ctx)
+	if err != nil {
+		// The posts are the bulk of the sitemap, serve them without categories
+		log.Printf("⚠️ Failed to load sitemap categories: %v", err)
+		return []Entry{}, nil
+	}
+
+	s.setCached(ctx, categoriesCacheKey, entries, categoriesCacheTTL)
+	return entries, nil
+}
+
+func (s *service) postPages(ctx context.Context) ([]Page, error) {
+	var pages []Page
+	if s.getCached(ctx, pagesCacheKey, &pages) {
+		return pages, nil
+	}
+
+	pages, err := s.repo.ListPostPages(ctx, MaxURLs)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list sitemap pages: %w", err)
+	}
+
+	s.setCached(ctx, pagesCacheKey, pages, pagesCacheTTL)
+	return pages, nil
+}
+
+func (s *service) getCached(ctx context.Context, key string, v any) bool {
+	if s.cache == nil {
+		return false
+	}
+	cached, err := s.cache.Get(ctx, key)
+	if err != nil || cached == "" {
+		return false
+	}
+	return json.Unmarshal([]byte(cached), v) == nil
+}
+
+func (s *service) setCached(ctx context.Context, key string, v any, ttl time.Duration) {
+	if s.cache == nil {
+		return
+	}
+	data, err := json.Marshal(v)
+	if err != nil {
+		log.Printf("Failed to marshal sitemap for cache: %v", err)
+		return
+	}
+	if err := s.cache.Set(ctx, key, data, ttl); err != nil {
+		log.Printf("Failed to cache sitemap (key=%s): %v", key, err)
+	}
+}
+
+func latest(entries []Entry) time.Time {
+	var t time.Time
+	for _, entry := range entries {
+		if entry.LastMod.After(t) {
+			t = entry.LastMod
+		}
+	}
+	return t
+}
@@ -0,0 +1,14 @@
+package sitemap
+
+import (
+	"postal/cache"
+)
+
+// NewService creates a new sitemap service with injected dependencies
+func NewService(repo Repository, categories CategorySource, cache cache.Cache) Service {
+	return &service{
+		repo:       repo,
+		categories: categories,
+		cache:      cache,
+	}
+}
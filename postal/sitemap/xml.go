@@ -0,0 +1,56 @@
+package sitemap
+
+import (
+	"encoding/xml"
+	"strings"
+	"time"
+)
+
+const xmlns = "http://www.sitemaps.org/schemas/sitemap/0.9"
+
+type urlSet struct {
+	XMLName xml.Name `xml:"urlset"`
+	Xmlns   string   `xml:"xmlns,attr"`
+	URLs    []urlXML `xml:"url"`
+}
+
+type urlXML struct {
+	Loc     string `xml:"loc"`
+	LastMod string `xml:"lastmod,omitempty"`
+}
+
+type sitemapIndex struct {
+	XMLName  xml.Name `xml:"sitemapindex"`
+	Xmlns    string   `xml:"xmlns,attr"`
+	Sitemaps []urlXML `xml:"sitemap"`
+}
+
+// EncodeURLSet writes entries as a sitemap of the site at baseURL
+func EncodeURLSet(baseURL string, entries []Entry) ([]byte, error) {
+	return marshal(urlSet{Xmlns: xmlns, URLs: locations(baseURL, entries)})
+}
+
+// EncodeIndex writes a sitemap index pointing to the sitemaps at baseURL
+func EncodeIndex(baseURL string, sitemaps []Entry) ([]byte, error) {
+	return marshal(sitemapIndex{Xmlns: xmlns, Sitemaps: locations(baseURL, sitemaps)})
+}
+
+func locations(baseURL string, entries []Entry) []urlXML {
+	baseURL = strings.TrimSuffix(baseURL, "/")
+	urls := make([]urlXML, len(entries))
+	for i, entry := range entries {
+		urls[i].Loc = baseURL + entry.Path
+		if !entry.LastMod.IsZero() {
+			urls[i].LastMod = entry.LastMod.UTC().Format(time.RFC3339)
+		}
+	}
+	return urls
+}
+
+func marshal(v any) ([]byte, error) {
+	body, err := xml.Marshal(v)
+	if err != nil {
+		return nil, err
+	}
+	return append([]byte(xml.Header), body...), nil
+}
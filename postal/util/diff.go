@@ -0,0 +1,201 @@
+package util
+
+import (
+	"regexp"
+	"strings"
+)
+
+type DiffOpType string
+
+const (
+	DiffEqual  DiffOpType = "equal"
+	DiffInsert DiffOpType = "insert"
+	DiffDelete DiffOpType = "delete"
+)
+
+const (
+	DiffModeLine = "line"
+	DiffModeWord = "word"
+)
+
+// DiffOp is a run of text that is kept, inserted or deleted
+type DiffOp struct {
+	Type DiffOpType `json:"type"`
+	Text string     `json:"text"`
+}
+
+var wordTokenPattern = regexp.MustCompile(`\s+|[^\s]+`)
+
+// Myers' algorithm keeps a copy of its diagonals per edit, so its time and memory
+// grow with the square of the edit distance. These caps keep a diff request cheap.
+const (
+	// maxDiffTokens caps the tokens left to compare once the common prefix and
+	// suffix are cut, word diffs above it are redone line by line
+	maxDiffTokens = 10000
+	// maxDiffEdits caps the edit distance searched, beyond it the differing
+	// middle is reported as one deletion and one insertion
+	maxDiffEdits = 1000
+)
+
+// DiffText compares two texts line by line or word by word (mode "line" or "word")
+func DiffText(a, b, mode string) []DiffOp {
+	ta, tb := tokenize(a, mode), tokenize(b, mode)
+	if mode == DiffModeWord && len(ta)+len(tb) > maxDiffTokens {
+		ta, tb = tokenize(a, DiffModeLine), tokenize(b, DiffModeLine)
+	}
+	return Diff(ta, tb)
+}
+
+func tokenize(s, mode string) []string {
+	if s == "" {
+		return nil
+	}
+	if mode == DiffModeWord {
+		return wordTokenPattern.FindAllString(s, -1)
+	}
+	return strings.SplitAfter(s, "\n")
+}
+
+// Diff returns the shortest edit script turning a into b using Myers' algorithm,
+// with consecutive tokens of the same kind merged into one op. Inputs past
+// maxDiffTokens or maxDiffEdits get a coarser script that replaces everything
+// between their common prefix and suffix.
+func Diff(a, b []string) []DiffOp {
+	prefix := 0
+	for prefix < len(a) && prefix < len(b) && a[prefix] == b[prefix] {
+		prefix++
+	}
+	suffix := 0
+	for suffix < len(a)-prefix && suffix < len(b)-prefix && a[len(a)-1-suffix] == b[len(b)-1-suffix] {
+		suffix++
+	}
+	middleA, middleB := a[prefix:len(a)-suffix], b[prefix:len(b)-suffix]
+
+	ops := make([]DiffOp, 0, len(a)+len(b))
+	for _, token := range a[:prefix] {
+		ops = append(ops, DiffOp{Type: DiffEqual, Text: token})
+	}
+
+	edits, ok := []DiffOp(nil), false
+	if len(middleA)+len(middleB) <= maxDiffTokens {
+		edits, ok = myers(middleA, middleB)
+	}
+	if !ok {
+		edits = replaceOps(middleA, middleB)
+	}
+	ops = append(ops, edits...)
+
+	for _, token := range a[len(a)-suffix:] {
+		ops = append(ops, DiffOp{Type: DiffEqual, Text: token})
+	}
+	return mergeOps(ops)
+}
+
+// myers returns the per-token shortest edit script, false once the edit
+// distance passes maxDiffEdits
+func myers(a, b []string) ([]DiffOp, bool) {
+	n, m := len(a), len(b)
+	total := n + m
+	if total == 0 {
+		return nil, true
+	}
+
+	// v[k+offset] is the furthest x reached on diagonal k. Before each round d the
+	// diagonals -d-1..d+1 are saved to trace, which is all the backtracking needs.
+	offset := total + 1
+	v := make([]int, 2*total+3)
+	var trace [][]int
+
+search:
+	for d := 0; d <= total; d++ {
+		if d > maxDiffEdits {
+			return nil, false
+		}
+		snapshot := make([]int, 2*d+3)
+		copy(snapshot, v[offset-d-1:offset+d+2])
+		trace = append(trace, snapshot)
+
+		for k := -d; k <= d; k += 2 {
+			var x int
+			if k == -d || (k != d && v[k-1+offset] < v[k+1+offset]) {
+				x = v[k+1+offset]
+			} else {
+				x = v[k-1+offset] + 1
+			}
+			y := x - k
+			for x < n && y < m && a[x] == b[y] {
+				x++
+				y++
+			}
+			v[k+offset] = x
+			if x >= n && y >= m {
+				break search
+			}
+		}
+	}
+
+	// Walk the trace backwards to recover the edits
+	var ops []DiffOp
+	x, y := n, m
+	for d := len(trace) - 1; d >= 0; d-- {
+		v, offset := trace[d], d+1
+		k := x - y
+
+		var prevK int
+		if k == -d || (k != d && v[k-1+offset] < v[k+1+offset]) {
+			prevK = k + 1
+		} else {
+			prevK = k - 1
+		}
+		prevX := v[prevK+offset]
+		prevY := prevX - prevK
+
+		for x > prevX && y > prevY {
+			ops = append(ops, DiffOp{Type: DiffEqual, Text: a[x-1]})
+			x--
+			y--
+		}
+		if d > 0 {
+			if x == prevX {
+				ops = append(ops, DiffOp{Type: DiffInsert, Text: b[y-1]})
+			} else {
+				ops = append(ops, DiffOp{Type: DiffDelete, Text: a[x-1]})
+			}
+		}
+		x, y = prevX, prevY
+	}
+
+	// ops were collected from the end
+	for i, j := 0, len(ops)-1; i < j; i, j = i+1, j-1 {
+		ops[i], ops[j] = ops[j], ops[i]
+	}
+	return ops, true
+}
+
+// replaceOps deletes every token of a and inserts every token of b
+func replaceOps(a, b []string) []DiffOp {
+	ops := make([]DiffOp, 0, len(a)+len(b))
+	for _, token := range a {
+		ops = append(ops, DiffOp{Type: DiffDelete, Text: token})
+	}
+	for _, token := range b {
+		ops = append(ops, DiffOp{Type: DiffInsert, Text: token})
+	}
+	return ops
+}
+
+// mergeOps joins consecutive ops of the same kind
+func mergeOps(ops []DiffOp) []DiffOp {
+	if len(ops) == 0 {
+		return nil
+	}
+	merged := make([]DiffOp, 0, len(ops))
+	for _, op := range ops {
+		if last := len(merged) - 1; last >= 0 && merged[last].Type == op.Type {
+			merged[last].Text += op.Text
+			continue
+		}
+		merged = append(merged, op)
+	}
+	return merged
+}
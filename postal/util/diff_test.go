@@ -0,0 +1,210 @@
+package util
+
+import (
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestDiff(t *testing.T) {
+	tests := []struct {
+		name     string
+		a        []string
+		b        []string
+		expected []DiffOp
+	}{
+		{
+			name:     "Both Empty",
+			expected: nil,
+		},
+		{
+			name:     "Identical",
+			a:        []string{"a", "b", "c"},
+			b:        []string{"a", "b", "c"},
+			expected: []DiffOp{{Type: DiffEqual, Text: "abc"}},
+		},
+		{
+			name:     "Everything Inserted",
+			b:        []string{"a", "b"},
+			expected: []DiffOp{{Type: DiffInsert, Text: "ab"}},
+		},
+		{
+			name:     "Everything Deleted",
+			a:        []string{"a", "b"},
+			expected: []DiffOp{{Type: DiffDelete, Text: "ab"}},
+		},
+		{
+			name: "Insert In The Middle",
+			a:    []string{"a", "c"},
+			b:    []string{"a", "b", "c"},
+			expected: []DiffOp{
+				{Type: DiffEqual, Text: "a"},
+				{Type: DiffInsert, Text: "b"},
+				{Type: DiffEqual, Text: "c"},
+			},
+		},
+		{
+			name: "Replace",
+			a:    []string{"a", "b", "c"},
+			b:    []string{"a", "x", "c"},
+			expected: []DiffOp{
+				{Type: DiffEqual, Text: "a"},
+				{Type: DiffDelete, Text: "b"},
+				{Type: DiffInsert, Text: "x"},
+				{Type: DiffEqual, Text: "c"},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require.Equal(t, tt.expected, Diff(tt.a, tt.b))
+		})
+	}
+}
+
+// TestDiffIsShortestEditScript checks the classic Myers example, ABCABBA to CBABAC
+// takes 5 edits, and that the ops rebuild both sides
+func TestDiffIsShortestEditScript(t *testing.T) {
+	a := strings.Split("ABCABBA", "")
+	b := strings.Split("CBABAC", "")
+
+	ops := Diff(a, b)
+
+	var from, to strings.Builder
+	edits := 0
+	for _, op := range ops {
+		switch op.Type {
+		case DiffEqual:
+			from.WriteString(op.Text)
+			to.WriteString(op.Text)
+		case DiffDelete:
+			from.WriteString(op.Text)
+			edits += len(op.Text)
+		case DiffInsert:
+			to.WriteString(op.Text)
+			edits += len(op.Text)
+		}
+	}
+
+	require.Equal(t, "ABCABBA", from.String())
+	require.Equal(t, "CBABAC", to.String())
+	require.Equal(t, 5, edits)
+}
+
+// TestDiffLimits checks inputs past maxDiffEdits or maxDiffTokens fall back to
+// replacing everything between the common prefix and suffix
+func TestDiffLimits(t *testing.T) {
+	tokens := func(prefix string, count int) []string {
+		out := make([]string, count)
+		for i := range out {
+			out[i] = prefix + strconv.Itoa(i) + "\n"
+		}
+		return out
+	}
+	wrap := func(middle []string) []string {
+		return append(append([]string{"head\n"}, middle...), "tail\n")
+	}
+
+	tests := []struct {
+		name string
+		a    []string
+		b    []string
+	}{
+		{
+			name: "Edit Distance Over Cap",
+			a:    wrap(tokens("a", maxDiffEdits/2+1)),
+			b:    wrap(tokens("b", maxDiffEdits/2+1)),
+		},
+		{
+			name: "Tokens Over Cap",
+			a:    wrap(tokens("a", maxDiffTokens/2+1)),
+			b:    wrap(tokens("b", maxDiffTokens/2+1)),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			expected := []DiffOp{
+				{Type: DiffEqual, Text: "head\n"},
+				{Type: DiffDelete, Text: strings.Join(tt.a[1:len(tt.a)-1], "")},
+				{Type: DiffInsert, Text: strings.Join(tt.b[1:len(tt.b)-1], "")},
+				{Type: DiffEqual, Text: "tail\n"},
+			}
+			require.Equal(t, expected, Diff(tt.a, tt.b))
+		})
+	}
+}
+
+func TestDiffText(t *testing.T) {
+	tests := []struct {
+		name     string
+		a        string
+		b        string
+		mode     string
+		expected []DiffOp
+	}{
+		{
+			name: "Line Mode",
+			a:    "one\ntwo\nthree\n",
+			b:    "one\n2\nthree\n",
+			mode: DiffModeLine,
+			expected: []DiffOp{
+				{Type: DiffEqual, Text: "one\n"},
+				{Type: DiffDelete, Text: "two\n"},
+				{Type: DiffInsert, Text: "2\n"},
+				{Type: DiffEqual, Text: "three\n"},
+			},
+		},
+		{
+			name: "Word Mode Keeps Whitespace",
+			a:    "the quick fox",
+			b:    "the slow fox",
+			mode: DiffModeWord,
+			expected: []DiffOp{
+				{Type: DiffEqual, Text: "the "},
+				{Type: DiffDelete, Text: "quick"},
+				{Type: DiffInsert, Text: "slow"},
+				{Type: DiffEqual, Text: " fox"},
+			},
+		},
+		{
+			name: "Bangla Words",
+			a:    "আমার সোনার বাংলা",
+			b:    "আমার প্রিয় বাংলা",
+			mode: DiffModeWord,
+			expected: []DiffOp{
+				{Type: DiffEqual, Text: "আমার "},
+				{Type: DiffDelete, Text: "সোনার"},
+				{Type: DiffInsert, Text: "প্রিয়"},
+				{Type: DiffEqual, Text: " বাংলা"},
+			},
+		},
+		{
+			name: "Long Word Diff Falls Back To Lines",
+			a:    strings.Repeat("word ", maxDiffTokens/2) + "\nold\nend\n",
+			b:    strings.Repeat("word ", maxDiffTokens/2) + "\nnew\nend\n",
+			mode: DiffModeWord,
+			expected: []DiffOp{
+				{Type: DiffEqual, Text: strings.Repeat("word ", maxDiffTokens/2) + "\n"},
+				{Type: DiffDelete, Text: "old\n"},
+				{Type: DiffInsert, Text: "new\n"},
+				{Type: DiffEqual, Text: "end\n"},
+			},
+		},
+		{
+			name:     "Empty To Text",
+			b:        "new\n",
+			mode:     DiffModeLine,
+			expected: []DiffOp{{Type: DiffInsert, Text: "new\n"}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require.Equal(t, tt.expected, DiffText(tt.a, tt.b, tt.mode))
+		})
+	}
+}
@@ -0,0 +1,219 @@
+package util
+
+import (
+	"bytes"
+	"strconv"
+	"strings"
+	"time"
+
+	"gopkg.in/yaml.v3"
+
+	"postal/domain"
+)
+
+// PostCSVHeader is the layout ParseCSVRows reads. The columns after
+// is_pinned are ignored by the import, they keep what it cannot set.
+var PostCSVHeader = []string{
+	"title", "slug", "content", "summary", "thumbnail",
+	"category_id", "sub_category_id", "meta_title", "meta_description",
+	"keywords", "og_image", "is_public", "is_featured", "is_pinned",
+	"id", "uuid", "status", "version", "created_at", "published_at",
+}
+
+// PostVersionCSVHeader is the layout of exported version history
+var PostVersionCSVHeader = []string{
+	"post_id", "slug", "version_no", "title", "content", "summary", "thumbnail",
+	"category_id", "sub_category_id", "meta_title", "meta_description",
+	"keywords", "og_image", "edited_by", "change_note", "created_at",
+}
+
+// PostCSVRecord is a post in the PostCSVHeader layout
+func PostCSVRecord(post *domain.Post) []string {
+	return []string{
+		post.Title,
+		post.Slug,
+		post.Content,
+		post.Summary,
+		post.Thumbnail,
+		strconv.FormatUint(uint64(post.CategoryID), 10),
+		formatOptionalID(post.SubCategoryID),
+		post.MetaTitle,
+		post.MetaDescription,
+		PostKeywords(post),
+		post.OGImage,
+		strconv.FormatBool(post.IsPublic),
+		strconv.FormatBool(post.IsFeatured),
+		strconv.FormatBool(post.IsPinned),
+		strconv.FormatUint(uint64(post.ID), 10),
+		post.UUID,
+		string(post.Status),
+		strconv.Itoa(post.Version),
+		post.CreatedAt.UTC().Format(time.RFC3339),
+		formatOptionalTime(post.PublishedAt),
+	}
+}
+
+// PostVersionCSVRecord is a version of the post with slug in the PostVersionCSVHeader layout
+func PostVersionCSVRecord(slug string, version *domain.PostVersion) []string {
+	return []string{
+		strconv.FormatUint(uint64(version.PostID), 10),
+		slug,
+		strconv.Itoa(version.VersionNo),
+		version.Title,
+		version.Content,
+		version.Summary,
+		version.Thumbnail,
+		strconv.FormatUint(uint64(version.CategoryID), 10),
+		formatOptionalID(version.SubCategoryID),
+		version.MetaTitle,
+		version.MetaDescription,
+		version.Keywords,
+		version.OGImage,
+		strconv.FormatUint(uint64(version.EditedBy), 10),
+		version.ChangeNote,
+		version.CreatedAt.UTC().Format(time.RFC3339),
+	}
+}
+
+// exportFrontMatter is the front matter of an exported post. Categories are
+// cortex IDs, postal does not know their slugs.
+type exportFrontMatter struct {
+	Title           string     `yaml:"title"`
+	Slug            string     `yaml:"slug"`
+	UUID            string     `yaml:"uuid"`
+	CategoryID      uint       `yaml:"category_id"`
+	SubCategoryID   *uint      `yaml:"sub_category_id,omitempty"`
+	Tags            []string   `yaml:"tags,omitempty"`
+	Status          string     `yaml:"status"`
+	Summary         string     `yaml:"summary,omitempty"`
+	Thumbnail       string     `yaml:"thumbnail,omitempty"`
+	MetaTitle       string     `yaml:"meta_title,omitempty"`
+	MetaDescription string     `yaml:"meta_description,omitempty"`
+	OGImage         string     `yaml:"og_image,omitempty"`
+	IsPublic        bool       `yaml:"is_public"`
+	IsFeatured      bool       `yaml:"is_featured,omitempty"`
+	IsPinned        bool       `yaml:"is_pinned,omitempty"`
+	Version         int        `yaml:"version"`
+	CreatedAt       time.Time  `yaml:"created_at"`
+	PublishedAt     *time.Time `yaml:"published_at,omitempty"`
+	PublishAt       *time.Time `yaml:"publish_at,omitempty"`
+	UnpublishAt     *time.Time `yaml:"unpublish_at,omitempty"`
+}
+
+// versionFrontMatter is the front matter of an exported version
+type versionFrontMatter struct {
+	Title           string    `yaml:"title"`
+	Version         int       `yaml:"version"`
+	CategoryID      uint      `yaml:"category_id"`
+	SubCategoryID   *uint     `yaml:"sub_category_id,omitempty"`
+	Summary         string    `yaml:"summary,omitempty"`
+	Thumbnail       string    `yaml:"thumbnail,omitempty"`
+	MetaTitle       string    `yaml:"meta_title,omitempty"`
+	MetaDescription string    `yaml:"meta_description,omitempty"`
+	Keywords        string    `yaml:"keywords,omitempty"`
+	OGImage         string    `yaml:"og_image,omitempty"`
+	EditedBy        uint      `yaml:"edited_by"`
+	ChangeNote      string    `yaml:"change_note,omitempty"`
+	CreatedAt       time.Time `yaml:"created_at"`
+}
+
+// MarkdownFromPost writes a post as Markdown with YAML front matter. The fields
+// follow ParseMarkdownArchive's, but categories are written as category_id and
+// sub_category_id since slugs live in cortex, an export is imported again after
+// replacing them with category and sub_category slugs.
+func MarkdownFromPost(post *domain.Post) ([]byte, error) {
+	return markdownWithFrontMatter(exportFrontMatter{
+		Title:           post.Title,
+		Slug:            post.Slug,
+		UUID:            post.UUID,
+		CategoryID:      post.CategoryID,
+		SubCategoryID:   post.SubCategoryID,
+		Tags:            ParseKeywords(PostKeywords(post)),
+		Status:          string(post.Status),
+		Summary:         post.Summary,
+		Thumbnail:       post.Thumbnail,
+		MetaTitle:       post.MetaTitle,
+		MetaDescription: post.MetaDescription,
+		OGImage:         post.OGImage,
+		IsPublic:        post.IsPublic,
+		IsFeatured:      post.IsFeatured,
+		IsPinned:        post.IsPinned,
+		Version:         post.Version,
+		CreatedAt:       post.CreatedAt.UTC(),
+		PublishedAt:     utcTime(post.PublishedAt),
+		PublishAt:       utcTime(post.PublishAt),
+		UnpublishAt:     utcTime(post.UnpublishAt),
+	}, post.Content)
+}
+
+// MarkdownFromPostVersion writes a version of a post as Markdown with YAML front matter
+func MarkdownFromPostVersion(version *domain.PostVersion) ([]byte, error) {
+	return markdownWithFrontMatter(versionFrontMatter{
+		Title:           version.Title,
+		Version:         version.VersionNo,
+		CategoryID:      version.CategoryID,
+		SubCategoryID:   version.SubCategoryID,
+		Summary:         version.Summary,
+		Thumbnail:       version.Thumbnail,
+		MetaTitle:       version.MetaTitle,
+		MetaDescription: version.MetaDescription,
+		Keywords:        version.Keywords,
+		OGImage:         version.OGImage,
+		EditedBy:        version.EditedBy,
+		ChangeNote:      version.ChangeNote,
+		CreatedAt:       version.CreatedAt.UTC(),
+	}, version.Content)
+}
+
+// PostKeywords are the keywords of a post, or its tags when it has none
+func PostKeywords(post *domain.Post) string {
+	if post.Keywords != "" || len(post.Tags) == 0 {
+		return post.Keywords
+	}
+	names := make([]string, len(post.Tags))
+	for i, tag := range post.Tags {
+		names[i] = tag.Name
+	}
+	return strings.Join(names, ", ")
+}
+
+func markdownWithFrontMatter(header any, content string) ([]byte, error) {
+	var buf bytes.Buffer
+	buf.WriteString("---\n")
+	encoder := yaml.NewEncoder(&buf)
+	encoder.SetIndent(2)
+	if err := encoder.Encode(header); err != nil {
+		return nil, err
+	}
+	if err := encoder.Close(); err != nil {
+		return nil, err
+	}
+	buf.WriteString("---\n\n")
+	buf.WriteString(content)
+	if !strings.HasSuffix(content, "\n") {
+		buf.WriteByte('\n')
+	}
+	return buf.Bytes(), nil
+}
+
+func formatOptionalID(id *uint) string {
+	if id == nil {
+		return ""
+	}
+	return strconv.FormatUint(uint64(*id), 10)
+}
+
+func formatOptionalTime(t *time.Time) string {
+	if t == nil {
+		return ""
+	}
+	return t.UTC().Format(time.RFC3339)
+}
+
+func utcTime(t *time.Time) *time.Time {
+	if t == nil {
+		return nil
+	}
+	utc := t.UTC()
+	return &utc
+}
@@ -0,0 +1,263 @@
+package util
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"os"
+	"path"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// DocsTree is a folder of Markdown notes. Its first level folders are sections,
+// every Markdown file in a section, or folder of one with a README.md, is a note.
+type DocsTree struct {
+	Name     string
+	Sections []string
+	Notes    []DocNote
+}
+
+// DocNote is a note of a docs tree
+type DocNote struct {
+	// Source is the path of the file relative to the parent of the tree
+	Source string
+	// Section is the first level folder, empty for notes at the root of the tree
+	Section string
+	// Name is the file or folder name the note is named after, Number the
+	// number in it ("golang014-what-is-scope" is 14) or -1 when it has none
+	Name   string
+	Number int
+
+	Title   string
+	Content string
+	Tags    []string
+	Date    *time.Time
+	Hash    string
+}
+
+var (
+	noteNumberPattern = regexp.MustCompile(`^\D*?(\d+)`)
+	noteFieldPattern  = regexp.MustCompile(`^\[?\*\*([A-Za-z]+):\*\*\s*(.*?)\s*$`)
+)
+
+// ParseDocsTree reads the notes of a docs tree, each section ordered by the
+// numbers in the note names and then by name
+func ParseDocsTree(root string) (*DocsTree, error) {
+	root, err := filepath.Abs(root)
+	if err != nil {
+		return nil, err
+	}
+	tree := &DocsTree{Name: filepath.Base(root)}
+
+	entries, err := os.ReadDir(root)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read docs tree: %w", err)
+	}
+
+	var notes []DocNote
+	for _, entry := range entries {
+		if isHiddenName(entry.Name()) {
+			continue
+		}
+		if entry.IsDir() {
+			sectionNotes, err := readDocsSection(root, tree.Name, entry.Name())
+			if err != nil {
+				return nil, err
+			}
+			if len(sectionNotes) > 0 {
+				tree.Sections = append(tree.Sections, entry.Name())
+				notes = append(notes, sectionNotes...)
+			}
+			continue
+		}
+		if isNoteFile(entry.Name()) {
+			note, err := readDocNote(filepath.Join(root, entry.Name()), path.Join(tree.Name, entry.Name()), "", noteName(entry.Name()))
+			if err != nil {
+				return nil, err
+			}
+			notes = append(notes, *note)
+		}
+	}
+
+	sort.SliceStable(notes, func(i, j int) bool {
+		a, b := notes[i], notes[j]
+		if a.Section != b.Section {
+			return a.Section < b.Section
+		}
+		if (a.Number < 0) != (b.Number < 0) {
+			return a.Number >= 0
+		}
+		if a.Number != b.Number {
+			return a.Number < b.Number
+		}
+		return a.Name < b.Name
+	})
+	tree.Notes = notes
+	return tree, nil
+}
+
+func readDocsSection(root, treeName, section string) ([]DocNote, error) {
+	entries, err := os.ReadDir(filepath.Join(root, section))
+	if err != nil {
+		return nil, fmt.Errorf("failed to read %s: %w", section, err)
+	}
+
+	var notes []DocNote
+	for _, entry := range entries {
+		name := entry.Name()
+		if isHiddenName(name) {
+			continue
+		}
+
+		if !entry.IsDir() {
+			if !isNoteFile(name) {
+				continue
+			}
+			note, err := readDocNote(filepath.Join(root, section, name), path.Join(treeName, section, name), section, noteName(name))
+			if err != nil {
+				return nil, err
+			}
+			notes = append(notes, *note)
+			continue
+		}
+
+		// A folder is a note when it has a README.md, its other files are examples
+		for _, readme := range []string{"README.md", "readme.md", "index.md"} {
+			file := filepath.Join(root, section, name, readme)
+			if _, err := os.Stat(file); err != nil {
+				continue
+			}
+			note, err := readDocNote(file, path.Join(treeName, section, name, readme), section, name)
+			if err != nil {
+				return nil, err
+			}
+			notes = append(notes, *note)
+			break
+		}
+	}
+	return notes, nil
+}
+
+func readDocNote(file, source, section, name string) (*DocNote, error) {
+	info, err := os.Stat(file)
+	if err != nil {
+		return nil, err
+	}
+	if info.Size() > maxMarkdownFileSize {
+		return nil, fmt.Errorf("%s: file is larger than %d MB", source, maxMarkdownFileSize>>20)
+	}
+	data, err := os.ReadFile(file)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", source, err)
+	}
+
+	sum := sha256.Sum256(data)
+	note := &DocNote{
+		Source:  source,
+		Section: section,
+		Name:    name,
+		Number:  -1,
+		Hash:    hex.EncodeToString(sum[:]),
+	}
+	if match := noteNumberPattern.FindStringSubmatch(name); match != nil {
+		note.Number, _ = strconv.Atoi(match[1])
+	}
+
+	text := strings.TrimPrefix(strings.ReplaceAll(string(data), "\r\n", "\n"), "\ufeff")
+	note.Content, note.Tags, note.Date = parseNoteHeader(text)
+	note.Content = strings.TrimSpace(note.Content)
+	if note.Content == "" {
+		return nil, fmt.Errorf("%s: note is empty", source)
+	}
+	note.Title = noteTitle(note.Content, name)
+
+	return note, nil
+}
+
+// parseNoteHeader strips the "**Author:** / **Date:** / **Tags:**" block some
+// notes open with, keeping its date and tags
+func parseNoteHeader(text string) (string, []string, *time.Time) {
+	lines := strings.Split(text, "\n")
+	start := 0
+	for start < len(lines) && strings.TrimSpace(lines[start]) == "" {
+		start++
+	}
+	if start == len(lines) || noteFieldPattern.FindStringSubmatch(strings.TrimSpace(lines[start])) == nil {
+		return text, nil, nil
+	}
+
+	var (
+		tags []string
+		date *time.Time
+		end  = start
+	)
+	for ; end < len(lines); end++ {
+		line := strings.TrimSpace(lines[end])
+		if line == "]" {
+			continue
+		}
+		match := noteFieldPattern.FindStringSubmatch(line)
+		if match == nil {
+			break
+		}
+		switch strings.ToLower(match[1]) {
+		case "date":
+			if t, err := time.Parse("2006-01-02", match[2]); err == nil {
+				date = &t
+			}
+		case "tags":
+			value := strings.Trim(match[2], "[]")
+			tags = ParseTags(strings.Split(value, ","))
+		}
+	}
+	return strings.Join(lines[end:], "\n"), tags, date
+}
+
+// noteTitle is the first level one heading, or the name without its number
+func noteTitle(content, name string) string {
+	for _, line := range strings.Split(content, "\n") {
+		if strings.HasPrefix(line, "# ") {
+			if title := strings.TrimSpace(strings.TrimLeft(line, "#")); title != "" {
+				return title
+			}
+		}
+	}
+
+	if match := noteNumberPattern.FindString(name); match != "" {
+		name = name[len(match):]
+	}
+	return HumanizeName(name)
+}
+
+// HumanizeName turns a file or folder name like "class-wise" into "Class Wise"
+func HumanizeName(name string) string {
+	words := strings.FieldsFunc(name, func(r rune) bool { return r == '-' || r == '_' || r == ' ' })
+	for i, word := range words {
+		runes := []rune(word)
+		words[i] = strings.ToUpper(string(runes[:1])) + string(runes[1:])
+	}
+	return strings.Join(words, " ")
+}
+
+func noteName(file string) string {
+	return strings.TrimSuffix(file, path.Ext(file))
+}
+
+func isNoteFile(name string) bool {
+	ext := strings.ToLower(path.Ext(name))
+	if ext != ".md" && ext != ".markdown" {
+		return false
+	}
+	// READMEs of the tree and its sections describe the folder, not a note
+	stem := strings.ToLower(noteName(name))
+	return stem != "readme" && stem != "index"
+}
+
+func isHiddenName(name string) bool {
+	return strings.HasPrefix(name, ".") || strings.HasPrefix(name, "_")
+}
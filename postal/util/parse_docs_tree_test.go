@@ -0,0 +1,195 @@
+package util
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+// writeDocsTree creates the files of a docs tree below a temporary "golang" folder
+func writeDocsTree(t *testing.T, files map[string]string) string {
+	t.Helper()
+	root := filepath.Join(t.TempDir(), "golang")
+	for name, content := range files {
+		file := filepath.Join(root, filepath.FromSlash(name))
+		require.NoError(t, os.MkdirAll(filepath.Dir(file), 0o755))
+		require.NoError(t, os.WriteFile(file, []byte(content), 0o644))
+	}
+	return root
+}
+
+func TestParseDocsTreeOrder(t *testing.T) {
+	tests := []struct {
+		name     string
+		files    map[string]string
+		sections []string
+		sources  []string
+	}{
+		{
+			name: "Numbers Before Names",
+			files: map[string]string{
+				"basics/golang010-loops.md":   "Loops",
+				"basics/golang002-types.md":   "Types",
+				"basics/golang001-hello.md":   "Hello",
+				"basics/appendix.md":          "Appendix",
+				"basics/cheatsheet.md":        "Cheatsheet",
+				"basics/golang002-types-b.md": "More types",
+			},
+			sections: []string{"basics"},
+			sources: []string{
+				"golang/basics/golang001-hello.md",
+				"golang/basics/golang002-types.md",
+				"golang/basics/golang002-types-b.md",
+				"golang/basics/golang010-loops.md",
+				"golang/basics/appendix.md",
+				"golang/basics/cheatsheet.md",
+			},
+		},
+		{
+			name: "Numbers Compare As Numbers",
+			files: map[string]string{
+				"basics/9-nine.md":    "Nine",
+				"basics/10-ten.md":    "Ten",
+				"basics/100-large.md": "Large",
+			},
+			sections: []string{"basics"},
+			sources: []string{
+				"golang/basics/9-nine.md",
+				"golang/basics/10-ten.md",
+				"golang/basics/100-large.md",
+			},
+		},
+		{
+			name: "Root Notes First Then Sections",
+			files: map[string]string{
+				"web/1-http.md":     "HTTP",
+				"basics/1-hello.md": "Hello",
+				"intro.md":          "Intro",
+				"README.md":         "About the tree",
+			},
+			sections: []string{"basics", "web"},
+			sources: []string{
+				"golang/intro.md",
+				"golang/basics/1-hello.md",
+				"golang/web/1-http.md",
+			},
+		},
+		{
+			name: "Folder Notes And Skipped Files",
+			files: map[string]string{
+				"basics/2-scope/README.md":   "# Scope",
+				"basics/2-scope/example.md":  "An example, not a note",
+				"basics/1-hello.md":          "Hello",
+				"basics/3-empty/main.go":     "package main",
+				"basics/.draft.md":           "Hidden",
+				"basics/_partial.md":         "Hidden",
+				"basics/notes.txt":           "Not Markdown",
+				"_templates/1-template.md":   "Hidden section",
+				"empty/.gitkeep":             "",
+				"basics/4-channels.markdown": "Channels",
+			},
+			sections: []string{"basics"},
+			sources: []string{
+				"golang/basics/1-hello.md",
+				"golang/basics/2-scope/README.md",
+				"golang/basics/4-channels.markdown",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tree, err := ParseDocsTree(writeDocsTree(t, tt.files))
+			require.NoError(t, err)
+			require.Equal(t, "golang", tree.Name)
+			require.Equal(t, tt.sections, tree.Sections)
+
+			sources := make([]string, len(tree.Notes))
+			for i, note := range tree.Notes {
+				sources[i] = note.Source
+			}
+			require.Equal(t, tt.sources, sources)
+		})
+	}
+}
+
+func TestParseDocsTreeNote(t *testing.T) {
+	date := time.Date(2024, 3, 9, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name     string
+		file     string
+		content  string
+		expected DocNote
+	}{
+		{
+			name:    "Heading Title",
+			file:    "basics/golang014-what-is-scope.md",
+			content: "# What Is Scope?\n\nBody\n",
+			expected: DocNote{
+				Source:  "golang/basics/golang014-what-is-scope.md",
+				Section: "basics",
+				Name:    "golang014-what-is-scope",
+				Number:  14,
+				Title:   "What Is Scope?",
+				Content: "# What Is Scope?\n\nBody",
+			},
+		},
+		{
+			name:    "Title From Name",
+			file:    "basics/03-class-wise.md",
+			content: "Body only\n",
+			expected: DocNote{
+				Source:  "golang/basics/03-class-wise.md",
+				Section: "basics",
+				Name:    "03-class-wise",
+				Number:  3,
+				Title:   "Class Wise",
+				Content: "Body only",
+			},
+		},
+		{
+			name:    "Header Block",
+			file:    "basics/1-hello.md",
+			content: "\r\n**Author:** Someone\r\n**Date:** 2024-03-09\r\n**Tags:** [Go, basics, go]\r\n\r\n# Hello\r\nBody\r\n",
+			expected: DocNote{
+				Source:  "golang/basics/1-hello.md",
+				Section: "basics",
+				Name:    "1-hello",
+				Number:  1,
+				Title:   "Hello",
+				Content: "# Hello\nBody",
+				Tags:    []string{"Go", "basics"},
+				Date:    &date,
+			},
+		},
+		{
+			name:    "Root Note Without Number",
+			file:    "overview.md",
+			content: "Overview\n",
+			expected: DocNote{
+				Source:  "golang/overview.md",
+				Name:    "overview",
+				Number:  -1,
+				Title:   "Overview",
+				Content: "Overview",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tree, err := ParseDocsTree(writeDocsTree(t, map[string]string{tt.file: tt.content}))
+			require.NoError(t, err)
+			require.Len(t, tree.Notes, 1)
+
+			note := tree.Notes[0]
+			require.NotEmpty(t, note.Hash)
+			note.Hash = ""
+			require.Equal(t, tt.expected, note)
+		})
+	}
+}
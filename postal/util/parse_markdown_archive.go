@@ -0,0 +1,350 @@
+package util
+
+import (
+	"archive/tar"
+	"archive/zip"
+	"bytes"
+	"compress/gzip"
+	"errors"
+	"fmt"
+	"io"
+	"path"
+	"sort"
+	"strings"
+	"time"
+
+	"gopkg.in/yaml.v3"
+
+	"postal/domain"
+)
+
+const (
+	// maxMarkdownFileSize bounds a single post, a small archive can inflate to a lot
+	maxMarkdownFileSize = 5 << 20
+	// maxArchiveBytes bounds what is inflated from the whole archive
+	maxArchiveBytes   = 100 << 20
+	maxArchiveEntries = 5000
+)
+
+// MarkdownPost is a post read from a Markdown file, its categories are still
+// slugs that have to be resolved against cortex
+type MarkdownPost struct {
+	File            string
+	CategorySlug    string
+	SubCategorySlug string
+	Post            domain.Post
+}
+
+// frontMatter is the YAML header of an imported Markdown file. date is the
+// Jekyll and Hugo spelling of published_at.
+type frontMatter struct {
+	Title           string     `yaml:"title"`
+	Slug            string     `yaml:"slug"`
+	Category        string     `yaml:"category"`
+	SubCategory     string     `yaml:"sub_category"`
+	Tags            []string   `yaml:"tags"`
+	Status          string     `yaml:"status"`
+	Summary         string     `yaml:"summary"`
+	Thumbnail       string     `yaml:"thumbnail"`
+	MetaTitle       string     `yaml:"meta_title"`
+	MetaDescription string     `yaml:"meta_description"`
+	OGImage         string     `yaml:"og_image"`
+	IsPublic        *bool      `yaml:"is_public"`
+	IsFeatured      bool       `yaml:"is_featured"`
+	IsPinned        bool       `yaml:"is_pinned"`
+	Date            *time.Time `yaml:"date"`
+	CreatedAt       *time.Time `yaml:"created_at"`
+	PublishedAt     *time.Time `yaml:"published_at"`
+	PublishAt       *time.Time `yaml:"publish_at"`
+	UnpublishAt     *time.Time `yaml:"unpublish_at"`
+}
+
+// ParseMarkdownArchive reads the .md files of a zip, tar or gzipped tar archive.
+// Files are returned in path order, which becomes their order in the listing.
+func ParseMarkdownArchive(file io.ReaderAt, size int64, userID uint) (*[]MarkdownPost, *[]SlugRow, error) {
+	files, err := readArchive(file, size)
+	if err != nil {
+		return nil, nil, err
+	}
+	if len(files) == 0 {
+		return nil, nil, fmt.Errorf("archive contains no Markdown files")
+	}
+
+	names := make([]string, 0, len(files))
+	for name := range files {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	var (
+		posts    []MarkdownPost
+		slugRows []SlugRow
+		slugSet  = make(map[string]string)
+		now      = time.Now()
+	)
+
+	for _, name := range names {
+		post, err := parseMarkdownPost(name, files[name], userID, now)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		if other, ok := slugSet[post.Post.Slug]; ok {
+			return nil, nil, fmt.Errorf("%s: duplicate slug '%s', also used by %s", name, post.Post.Slug, other)
+		}
+		slugSet[post.Post.Slug] = name
+
+		slugRows = append(slugRows, SlugRow{
+			Slug: post.Post.Slug,
+			File: name,
+		})
+		posts = append(posts, *post)
+	}
+
+	return &posts, &slugRows, nil
+}
+
+func parseMarkdownPost(name string, data []byte, userID uint, now time.Time) (*MarkdownPost, error) {
+	header, body, err := splitFrontMatter(data)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", name, err)
+	}
+
+	var fm frontMatter
+	if err := yaml.Unmarshal(header, &fm); err != nil {
+		return nil, fmt.Errorf("%s: invalid front matter: %w", name, err)
+	}
+
+	title := strings.TrimSpace(fm.Title)
+	if title == "" {
+		return nil, fmt.Errorf("%s: title is required", name)
+	}
+	category := strings.TrimSpace(fm.Category)
+	if category == "" {
+		return nil, fmt.Errorf("%s: category is required", name)
+	}
+	content := strings.TrimSpace(body)
+	if content == "" {
+		return nil, fmt.Errorf("%s: content is required", name)
+	}
+
+	slug := SanitizeSlug(fm.Slug)
+	if strings.TrimSpace(fm.Slug) == "" {
+		slug = GenerateSlug(title)
+	}
+	if slug == "" {
+		return nil, fmt.Errorf("%s: no slug can be made from the title, set one", name)
+	}
+
+	post := domain.Post{
+		Title:           title,
+		Slug:            slug,
+		Summary:         strings.TrimSpace(fm.Summary),
+		Content:         content,
+		Thumbnail:       strings.TrimSpace(fm.Thumbnail),
+		MetaTitle:       strings.TrimSpace(fm.MetaTitle),
+		MetaDescription: strings.TrimSpace(fm.MetaDescription),
+		Keywords:        strings.Join(ParseTags(fm.Tags), ", "),
+		OGImage:         strings.TrimSpace(fm.OGImage),
+		IsPublic:        fm.IsPublic == nil || *fm.IsPublic,
+		IsFeatured:      fm.IsFeatured,
+		IsPinned:        fm.IsPinned,
+		CreatedBy:       userID,
+		Version:         1,
+	}
+	if fm.CreatedAt != nil {
+		post.CreatedAt = fm.CreatedAt.UTC()
+	}
+
+	publishedAt := fm.PublishedAt
+	if publishedAt == nil {
+		publishedAt = fm.Date
+	}
+
+	status := domain.PostStatus(strings.ToLower(strings.TrimSpace(fm.Status)))
+	switch status {
+	case "", domain.StatusDraft:
+		post.Status = domain.StatusDraft
+	case domain.StatusPublished:
+		post.Status = domain.StatusPublished
+		published := now.UTC()
+		if publishedAt != nil {
+			published = publishedAt.UTC()
+		}
+		post.PublishedAt = &published
+	case domain.StatusScheduled:
+		if fm.PublishAt == nil || !fm.PublishAt.After(now) {
+			return nil, fmt.Errorf("%s: a scheduled post needs a publish_at in the future", name)
+		}
+		post.Status = domain.StatusScheduled
+		publishAt := fm.PublishAt.UTC()
+		post.PublishAt = &publishAt
+	case domain.StatusArchived:
+		post.Status = domain.StatusArchived
+		archived := now.UTC()
+		post.ArchivedAt = &archived
+		if publishedAt != nil {
+			published := publishedAt.UTC()
+			post.PublishedAt = &published
+		}
+	default:
+		return nil, fmt.Errorf("%s: status must be draft, published, scheduled or archived, got '%s'", name, fm.Status)
+	}
+
+	if fm.UnpublishAt != nil {
+		if post.Status != domain.StatusPublished && post.Status != domain.StatusScheduled {
+			return nil, fmt.Errorf("%s: unpublish_at needs a published or scheduled post", name)
+		}
+		if !fm.UnpublishAt.After(now) || (post.PublishAt != nil && !fm.UnpublishAt.After(*post.PublishAt)) {
+			return nil, fmt.Errorf("%s: unpublish_at must be in the future and after publish_at", name)
+		}
+		unpublishAt := fm.UnpublishAt.UTC()
+		post.UnpublishAt = &unpublishAt
+	}
+
+	return &MarkdownPost{
+		File:            name,
+		CategorySlug:    category,
+		SubCategorySlug: strings.TrimSpace(fm.SubCategory),
+		Post:            post,
+	}, nil
+}
+
+// splitFrontMatter separates the YAML between the leading "---" lines from the body
+func splitFrontMatter(data []byte) ([]byte, string, error) {
+	text := strings.TrimPrefix(string(data), "\ufeff")
+	text = strings.ReplaceAll(text, "\r\n", "\n")
+
+	if !strings.HasPrefix(text, "---\n") {
+		return nil, "", fmt.Errorf("front matter is missing, start the file with a --- line")
+	}
+	rest := text[len("---\n"):]
+	if strings.HasPrefix(rest, "---\n") {
+		return nil, rest[len("---\n"):], nil
+	}
+
+	end := strings.Index(rest, "\n---\n")
+	if end < 0 {
+		if strings.HasSuffix(rest, "\n---") {
+			return []byte(strings.TrimSuffix(rest, "\n---")), "", nil
+		}
+		return nil, "", fmt.Errorf("front matter is not closed by a --- line")
+	}
+	return []byte(rest[:end]), rest[end+len("\n---\n"):], nil
+}
+
+// readArchive returns the Markdown files of an archive by path, the format is
+// detected from its content
+func readArchive(file io.ReaderAt, size int64) (map[string][]byte, error) {
+	magic := make([]byte, 4)
+	if _, err := file.ReadAt(magic, 0); err != nil && !errors.Is(err, io.EOF) {
+		return nil, fmt.Errorf("failed to read archive: %w", err)
+	}
+
+	switch {
+	case bytes.HasPrefix(magic, []byte("PK\x03\x04")), bytes.HasPrefix(magic, []byte("PK\x05\x06")):
+		return readZip(file, size)
+	case bytes.HasPrefix(magic, []byte{0x1f, 0x8b}):
+		gz, err := gzip.NewReader(io.NewSectionReader(file, 0, size))
+		if err != nil {
+			return nil, fmt.Errorf("failed to read gzip archive: %w", err)
+		}
+		defer gz.Close()
+		return readTar(gz)
+	default:
+		ustar := make([]byte, 5)
+		if _, err := file.ReadAt(ustar, 257); err != nil || string(ustar) != "ustar" {
+			return nil, fmt.Errorf("unsupported archive, upload a zip, tar or tar.gz file")
+		}
+		return readTar(io.NewSectionReader(file, 0, size))
+	}
+}
+
+func readZip(file io.ReaderAt, size int64) (map[string][]byte, error) {
+	archive, err := zip.NewReader(file, size)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read zip archive: %w", err)
+	}
+	if len(archive.File) > maxArchiveEntries {
+		return nil, fmt.Errorf("archive has more than %d entries", maxArchiveEntries)
+	}
+
+	// Skipped entries are never inflated, the declared sizes of the rest can lie
+	// so what is actually read counts
+	files := make(map[string][]byte)
+	var total int64
+	for _, entry := range archive.File {
+		if entry.FileInfo().IsDir() || !isMarkdownFile(entry.Name) {
+			continue
+		}
+		r, err := entry.Open()
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", entry.Name, err)
+		}
+		data, err := readLimited(r, entry.Name)
+		r.Close()
+		if err != nil {
+			return nil, err
+		}
+		if total += int64(len(data)); total > maxArchiveBytes {
+			return nil, errArchiveTooLarge
+		}
+		files[path.Clean(entry.Name)] = data
+	}
+	return files, nil
+}
+
+// readTar counts the size of every entry against maxArchiveBytes, a compressed
+// tar is inflated to skip past the entries that are not read as well
+func readTar(r io.Reader) (map[string][]byte, error) {
+	archive := tar.NewReader(r)
+	files := make(map[string][]byte)
+	var total int64
+	for entries := 0; ; entries++ {
+		if entries > maxArchiveEntries {
+			return nil, fmt.Errorf("archive has more than %d entries", maxArchiveEntries)
+		}
+		header, err := archive.Next()
+		if errors.Is(err, io.EOF) {
+			return files, nil
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to read tar archive: %w", err)
+		}
+		if total += header.Size; total > maxArchiveBytes {
+			return nil, errArchiveTooLarge
+		}
+		if header.Typeflag != tar.TypeReg || !isMarkdownFile(header.Name) {
+			continue
+		}
+		data, err := readLimited(archive, header.Name)
+		if err != nil {
+			return nil, err
+		}
+		files[path.Clean(header.Name)] = data
+	}
+}
+
+var errArchiveTooLarge = fmt.Errorf("archive inflates to more than %d MB", maxArchiveBytes>>20)
+
+func readLimited(r io.Reader, name string) ([]byte, error) {
+	data, err := io.ReadAll(io.LimitReader(r, maxMarkdownFileSize+1))
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", name, err)
+	}
+	if len(data) > maxMarkdownFileSize {
+		return nil, fmt.Errorf("%s: file is larger than %d MB", name, maxMarkdownFileSize>>20)
+	}
+	return data, nil
+}
+
+// isMarkdownFile skips hidden files and the resource forks macOS adds to zips
+func isMarkdownFile(name string) bool {
+	for _, part := range strings.Split(path.Clean(strings.ReplaceAll(name, "\\", "/")), "/") {
+		if strings.HasPrefix(part, ".") || part == "__MACOSX" {
+			return false
+		}
+	}
+	ext := strings.ToLower(path.Ext(name))
+	return ext == ".md" || ext == ".markdown"
+}
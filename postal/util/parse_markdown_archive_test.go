@@ -0,0 +1,294 @@
+package util
+
+import (
+	"archive/tar"
+	"archive/zip"
+	"bytes"
+	"compress/gzip"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"postal/domain"
+)
+
+type archiveFile struct {
+	name    string
+	content string
+}
+
+func zipArchive(t *testing.T, files []archiveFile) []byte {
+	t.Helper()
+	var buf bytes.Buffer
+	w := zip.NewWriter(&buf)
+	for _, f := range files {
+		entry, err := w.Create(f.name)
+		require.NoError(t, err)
+		_, err = entry.Write([]byte(f.content))
+		require.NoError(t, err)
+	}
+	require.NoError(t, w.Close())
+	return buf.Bytes()
+}
+
+func tarGzArchive(t *testing.T, files []archiveFile) []byte {
+	t.Helper()
+	var buf bytes.Buffer
+	gz := gzip.NewWriter(&buf)
+	w := tar.NewWriter(gz)
+	for _, f := range files {
+		require.NoError(t, w.WriteHeader(&tar.Header{
+			Name:     f.name,
+			Mode:     0o644,
+			Size:     int64(len(f.content)),
+			Typeflag: tar.TypeReg,
+		}))
+		_, err := w.Write([]byte(f.content))
+		require.NoError(t, err)
+	}
+	require.NoError(t, w.Close())
+	require.NoError(t, gz.Close())
+	return buf.Bytes()
+}
+
+func TestParseMarkdownArchive(t *testing.T) {
+	future := time.Now().Add(48 * time.Hour).UTC().Format(time.RFC3339)
+
+	tests := []struct {
+		name     string
+		archive  func(t *testing.T, files []archiveFile) []byte
+		files    []archiveFile
+		expected []MarkdownPost
+		err      string
+	}{
+		{
+			name:    "Zip In Path Order",
+			archive: zipArchive,
+			files: []archiveFile{
+				{name: "b.md", content: "---\ntitle: Second\ncategory: news\n---\nBody two\n"},
+				{name: "a.md", content: "---\ntitle: First Post\ncategory: news\nsub_category: local\ntags: [Go, go, Redis]\n---\n\nBody one\n"},
+				{name: "notes.txt", content: "not a post"},
+				{name: "__MACOSX/._a.md", content: "resource fork"},
+			},
+			expected: []MarkdownPost{
+				{
+					File:            "a.md",
+					CategorySlug:    "news",
+					SubCategorySlug: "local",
+					Post: domain.Post{
+						Title:     "First Post",
+						Slug:      "first-post",
+						Content:   "Body one",
+						Keywords:  "Go, Redis",
+						Status:    domain.StatusDraft,
+						IsPublic:  true,
+						CreatedBy: 7,
+						Version:   1,
+					},
+				},
+				{
+					File:         "b.md",
+					CategorySlug: "news",
+					Post: domain.Post{
+						Title:     "Second",
+						Slug:      "second",
+						Content:   "Body two",
+						Status:    domain.StatusDraft,
+						IsPublic:  true,
+						CreatedBy: 7,
+						Version:   1,
+					},
+				},
+			},
+		},
+		{
+			name:    "Tar Gz With Explicit Slug",
+			archive: tarGzArchive,
+			files: []archiveFile{
+				{name: "posts/hello.markdown", content: "---\ntitle: Hello\nslug: Custom Slug\ncategory: news\nis_public: false\n---\nHi\n"},
+			},
+			expected: []MarkdownPost{
+				{
+					File:         "posts/hello.markdown",
+					CategorySlug: "news",
+					Post: domain.Post{
+						Title:     "Hello",
+						Slug:      "custom-slug",
+						Content:   "Hi",
+						Status:    domain.StatusDraft,
+						CreatedBy: 7,
+						Version:   1,
+					},
+				},
+			},
+		},
+		{
+			name:    "No Markdown Files",
+			archive: zipArchive,
+			files:   []archiveFile{{name: "readme.txt", content: "hi"}},
+			err:     "archive contains no Markdown files",
+		},
+		{
+			name:    "Missing Front Matter",
+			archive: zipArchive,
+			files:   []archiveFile{{name: "a.md", content: "# Title\n"}},
+			err:     "a.md: front matter is missing, start the file with a --- line",
+		},
+		{
+			name:    "Missing Title",
+			archive: zipArchive,
+			files:   []archiveFile{{name: "a.md", content: "---\ncategory: news\n---\nBody\n"}},
+			err:     "a.md: title is required",
+		},
+		{
+			name:    "Missing Category",
+			archive: zipArchive,
+			files:   []archiveFile{{name: "a.md", content: "---\ntitle: A\n---\nBody\n"}},
+			err:     "a.md: category is required",
+		},
+		{
+			name:    "Duplicate Slug",
+			archive: zipArchive,
+			files: []archiveFile{
+				{name: "a.md", content: "---\ntitle: Same\ncategory: news\n---\nOne\n"},
+				{name: "b.md", content: "---\ntitle: Same\ncategory: news\n---\nTwo\n"},
+			},
+			err: "b.md: duplicate slug 'same', also used by a.md",
+		},
+		{
+			name:    "Scheduled Without Future Date",
+			archive: zipArchive,
+			files:   []archiveFile{{name: "a.md", content: "---\ntitle: A\ncategory: news\nstatus: scheduled\npublish_at: 2001-01-01T00:00:00Z\n---\nBody\n"}},
+			err:     "a.md: a scheduled post needs a publish_at in the future",
+		},
+		{
+			name:    "Unpublish Needs Published Post",
+			archive: zipArchive,
+			files:   []archiveFile{{name: "a.md", content: "---\ntitle: A\ncategory: news\nunpublish_at: " + future + "\n---\nBody\n"}},
+			err:     "a.md: unpublish_at needs a published or scheduled post",
+		},
+		{
+			name:    "Unknown Status",
+			archive: zipArchive,
+			files:   []archiveFile{{name: "a.md", content: "---\ntitle: A\ncategory: news\nstatus: live\n---\nBody\n"}},
+			err:     "a.md: status must be draft, published, scheduled or archived, got 'live'",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			data := tt.archive(t, tt.files)
+			posts, slugRows, err := ParseMarkdownArchive(bytes.NewReader(data), int64(len(data)), 7)
+			if tt.err != "" {
+				require.EqualError(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tt.expected, *posts)
+			require.Len(t, *slugRows, len(tt.expected))
+			for i, row := range *slugRows {
+				require.Equal(t, SlugRow{Slug: tt.expected[i].Post.Slug, File: tt.expected[i].File}, row)
+			}
+		})
+	}
+}
+
+func TestParseMarkdownArchivePublishedAt(t *testing.T) {
+	tests := []struct {
+		name        string
+		frontMatter string
+		expected    time.Time
+	}{
+		{
+			name:        "Published At",
+			frontMatter: "published_at: 2020-05-01T10:00:00+06:00",
+			expected:    time.Date(2020, 5, 1, 4, 0, 0, 0, time.UTC),
+		},
+		{
+			name:        "Jekyll Date",
+			frontMatter: "date: 2019-12-31T00:00:00Z",
+			expected:    time.Date(2019, 12, 31, 0, 0, 0, 0, time.UTC),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			data := zipArchive(t, []archiveFile{{
+				name:    "a.md",
+				content: "---\ntitle: A\ncategory: news\nstatus: published\n" + tt.frontMatter + "\n---\nBody\n",
+			}})
+			posts, _, err := ParseMarkdownArchive(bytes.NewReader(data), int64(len(data)), 1)
+			require.NoError(t, err)
+			require.Equal(t, domain.StatusPublished, (*posts)[0].Post.Status)
+			require.Equal(t, tt.expected, *(*posts)[0].Post.PublishedAt)
+		})
+	}
+}
+
+// TestReadTarTooLarge declares an entry past the cap, the archive is rejected
+// before it is inflated
+func TestReadTarTooLarge(t *testing.T) {
+	var buf bytes.Buffer
+	w := tar.NewWriter(&buf)
+	require.NoError(t, w.WriteHeader(&tar.Header{
+		Name:     "huge.bin",
+		Mode:     0o644,
+		Size:     maxArchiveBytes + 1,
+		Typeflag: tar.TypeReg,
+	}))
+
+	_, err := readTar(bytes.NewReader(buf.Bytes()))
+	require.ErrorIs(t, err, errArchiveTooLarge)
+}
+
+func TestSplitFrontMatter(t *testing.T) {
+	tests := []struct {
+		name   string
+		data   string
+		header string
+		body   string
+		err    string
+	}{
+		{
+			name:   "Header And Body",
+			data:   "---\ntitle: A\n---\nBody\n",
+			header: "title: A",
+			body:   "Body\n",
+		},
+		{
+			name:   "Byte Order Mark And CRLF",
+			data:   "\ufeff---\r\ntitle: A\r\n---\r\nBody\r\n",
+			header: "title: A",
+			body:   "Body\n",
+		},
+		{
+			name: "Empty Header",
+			data: "---\n---\nBody",
+			body: "Body",
+		},
+		{
+			name:   "No Body",
+			data:   "---\ntitle: A\n---",
+			header: "title: A",
+		},
+		{
+			name: "Not Closed",
+			data: "---\ntitle: A\nBody",
+			err:  "front matter is not closed by a --- line",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			header, body, err := splitFrontMatter([]byte(tt.data))
+			if tt.err != "" {
+				require.EqualError(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tt.header, string(header))
+			require.Equal(t, tt.body, body)
+		})
+	}
+}
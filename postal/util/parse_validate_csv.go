@@ -3,141 +3,166 @@ package util
 import (
 	"bufio"
 	"encoding/csv"
+	"errors"
 	"fmt"
 	"io"
-	"mime/multipart"
 	"strconv"
 	"strings"
 
 	"postal/domain"
 )
 
+// SlugRow is where an imported slug came from, a CSV row or an archived file
 type SlugRow struct {
 	Slug string
 	Row  int
+	File string
 }
 
-func ParseAndValidateCSV(
-	file *multipart.File,
-	userID uint,
-) (*[]domain.Post, *[]SlugRow, error) {
-	reader := csv.NewReader(bufio.NewReader(*file))
+// Source names the row or file in error messages
+func (sr SlugRow) Source() string {
+	if sr.File != "" {
+		return sr.File
+	}
+	return fmt.Sprintf("row %d", sr.Row)
+}
+
+// csvColumns are the columns every row of a CSV batch upload has, in order
+var csvColumns = []string{
+	"title", "slug", "content", "summary", "thumbnail",
+	"category_id", "sub_category_id", "meta_title", "meta_description",
+	"keywords", "og_image", "is_public", "is_featured", "is_pinned",
+}
+
+// csvOptionalColumns may be left empty, as PostCSVRecord does for unset fields
+var csvOptionalColumns = map[string]bool{
+	"summary": true, "thumbnail": true, "sub_category_id": true, "meta_title": true,
+	"meta_description": true, "keywords": true, "og_image": true,
+}
+
+// CSVRow is a data row of a CSV batch upload. Post is nil when the row is
+// invalid, Column is then the column at fault, empty when the row as a whole is.
+type CSVRow struct {
+	Row    int
+	Post   *domain.Post
+	Column string
+	Err    error
+}
+
+// ParseCSVRows validates every row of a CSV batch upload on its own, so one bad
+// row does not hide the others. Only an unreadable file is an error.
+func ParseCSVRows(r io.Reader, userID uint) ([]CSVRow, error) {
+	reader := csv.NewReader(bufio.NewReader(r))
 	reader.TrimLeadingSpace = true
+	// Short rows are reported as invalid rows, not as a broken file
+	reader.FieldsPerRecord = -1
 
 	if _, err := reader.Read(); err != nil {
-		return nil, nil, fmt.Errorf("invalid CSV header: %w", err)
+		return nil, fmt.Errorf("invalid CSV header: %w", err)
 	}
 
-	var (
-		posts    []domain.Post
-		slugRows []SlugRow
-		slugSet  = make(map[string]bool)
-		rowNo    = 1
-	)
-
-	for {
-		rowNo++
-
-		row, err := reader.Read()
+	var rows []CSVRow
+	for rowNo := 2; ; rowNo++ {
+		record, err := reader.Read()
 		if err == io.EOF {
-			break
+			return rows, nil
 		}
 		if err != nil {
-			return nil, nil, err
-		}
-
-		if len(row) < 14 {
-			return nil, nil, fmt.Errorf("invalid CSV format: expected at least 14 columns, got %d , in row %d", len(row), rowNo)
-		}
-
-		fields := []string{
-			"title", "slug", "content", "summary", "thumbnail",
-			"category_id", "sub_category_id", "meta_title", "meta_description",
-			"keywords", "og_image", "is_public", "is_featured", "is_pinned",
-		}
-		for i, name := range fields {
-			if strings.TrimSpace(row[i]) == "" {
-				return nil, nil, fmt.Errorf("row %d: %s is required", rowNo, name)
+			var parseErr *csv.ParseError
+			if errors.As(err, &parseErr) {
+				return nil, fmt.Errorf("invalid CSV in row %d: %w", rowNo, parseErr.Err)
 			}
+			return nil, err
 		}
 
-		title := strings.TrimSpace(row[0])
-		slug := strings.TrimSpace(row[1])
-		content := strings.TrimSpace(row[2])
-		summary := strings.TrimSpace(row[3])
-		thumbnail := strings.TrimSpace(row[4])
-		categoryIDStr := strings.TrimSpace(row[5])
-		subCategoryIDStr := strings.TrimSpace(row[6])
-		metaTitle := strings.TrimSpace(row[7])
-		metaDescription := strings.TrimSpace(row[8])
-		keywords := strings.TrimSpace(row[9])
-		ogImage := strings.TrimSpace(row[10])
-		isPublic, err := parseBoolStrict(row[11], "is_public", rowNo)
-		if err != nil {
-			return nil, nil, err
-		}
-
-		isFeatured, err := parseBoolStrict(row[12], "is_featured", rowNo)
-		if err != nil {
-			return nil, nil, err
-		}
+		post, column, err := parseCSVPost(record, userID)
+		rows = append(rows, CSVRow{
+			Row:    rowNo,
+			Post:   post,
+			Column: column,
+			Err:    err,
+		})
+	}
+}
 
-		isPinned, err := parseBoolStrict(row[13], "is_pinned", rowNo)
-		if err != nil {
-			return nil, nil, err
-		}
+// parseCSVPost turns a row into a post, or reports the column that is invalid
+func parseCSVPost(row []string, userID uint) (*domain.Post, string, error) {
+	if len(row) < len(csvColumns) {
+		return nil, "", fmt.Errorf("expected at least %d columns, got %d", len(csvColumns), len(row))
+	}
 
-		categoryID, err := strconv.ParseUint(categoryIDStr, 10, 64)
-		if err != nil {
-			return nil, nil, fmt.Errorf("row %d: invalid category_id '%s'", rowNo, categoryIDStr)
-		}
-		subCategoryID, err := strconv.ParseUint(subCategoryIDStr, 10, 64)
-		if err != nil {
-			return nil, nil, fmt.Errorf("row %d: invalid sub_category_id '%s'", rowNo, subCategoryIDStr)
+	for i, name := range csvColumns {
+		if !csvOptionalColumns[name] && strings.TrimSpace(row[i]) == "" {
+			return nil, name, fmt.Errorf("%s is required", name)
 		}
+	}
 
-		if slug == "" {
-			slug = GenerateSlug(title)
-		} else {
-			slug = SanitizeSlug(slug)
-		}
+	title := strings.TrimSpace(row[0])
+	slug := strings.TrimSpace(row[1])
+	content := strings.TrimSpace(row[2])
+	summary := strings.TrimSpace(row[3])
+	thumbnail := strings.TrimSpace(row[4])
+	categoryIDStr := strings.TrimSpace(row[5])
+	subCategoryIDStr := strings.TrimSpace(row[6])
+	metaTitle := strings.TrimSpace(row[7])
+	metaDescription := strings.TrimSpace(row[8])
+	keywords := strings.TrimSpace(row[9])
+	ogImage := strings.TrimSpace(row[10])
+
+	isPublic, err := parseBoolStrict(row[11], "is_public")
+	if err != nil {
+		return nil, "is_public", err
+	}
+	isFeatured, err := parseBoolStrict(row[12], "is_featured")
+	if err != nil {
+		return nil, "is_featured", err
+	}
+	isPinned, err := parseBoolStrict(row[13], "is_pinned")
+	if err != nil {
+		return nil, "is_pinned", err
+	}
 
-		if slugSet[slug] {
-			return nil, nil, fmt.Errorf("row %d: duplicate slug '%s'", rowNo, slug)
+	categoryID, err := strconv.ParseUint(categoryIDStr, 10, 32)
+	if err != nil {
+		return nil, "category_id", fmt.Errorf("invalid category_id '%s'", categoryIDStr)
+	}
+	var subCategoryID *uint
+	if subCategoryIDStr != "" {
+		id, err := strconv.ParseUint(subCategoryIDStr, 10, 32)
+		if err != nil {
+			return nil, "sub_category_id", fmt.Errorf("invalid sub_category_id '%s'", subCategoryIDStr)
 		}
-		slugSet[slug] = true
-
-		slugRows = append(slugRows, SlugRow{
-			Slug: slug,
-			Row:  rowNo,
-		})
+		subCategoryIDUint := uint(id)
+		subCategoryID = &subCategoryIDUint
+	}
 
-		subCategoryIDUint := uint(subCategoryID)
-		posts = append(posts, domain.Post{
-			Title:           title,
-			Slug:            slug,
-			Summary:         summary,
-			Content:         content,
-			Thumbnail:       thumbnail,
-			CategoryID:      uint(categoryID),
-			SubCategoryID:   &subCategoryIDUint,
-			MetaTitle:       metaTitle,
-			MetaDescription: metaDescription,
-			Keywords:        keywords,
-			OGImage:         ogImage,
-			Status:          domain.StatusPublished,
-			IsPublic:        isPublic,
-			IsFeatured:      isFeatured,
-			IsPinned:        isPinned,
-			CreatedBy:       userID,
-			Version:         1,
-		})
+	slug = SanitizeSlug(slug)
+	if slug == "" {
+		return nil, "slug", fmt.Errorf("slug '%s' has no letters or digits", strings.TrimSpace(row[1]))
 	}
 
-	return &posts, &slugRows, nil
+	return &domain.Post{
+		Title:           title,
+		Slug:            slug,
+		Summary:         summary,
+		Content:         content,
+		Thumbnail:       thumbnail,
+		CategoryID:      uint(categoryID),
+		SubCategoryID:   subCategoryID,
+		MetaTitle:       metaTitle,
+		MetaDescription: metaDescription,
+		Keywords:        keywords,
+		OGImage:         ogImage,
+		Status:          domain.StatusPublished,
+		IsPublic:        isPublic,
+		IsFeatured:      isFeatured,
+		IsPinned:        isPinned,
+		CreatedBy:       userID,
+		Version:         1,
+	}, "", nil
 }
 
-func parseBoolStrict(value string, field string, row int) (bool, error) {
+func parseBoolStrict(value string, field string) (bool, error) {
 	v := strings.ToLower(strings.TrimSpace(value))
 
 	switch v {
@@ -146,9 +171,6 @@ func parseBoolStrict(value string, field string, row int) (bool, error) {
 	case "false":
 		return false, nil
 	default:
-		return false, fmt.Errorf(
-			"row %d: %s must be 'true' or 'false', got '%s'",
-			row, field, value,
-		)
+		return false, fmt.Errorf("%s must be 'true' or 'false', got '%s'", field, value)
 	}
 }
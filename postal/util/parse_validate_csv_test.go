@@ -0,0 +1,222 @@
+package util
+
+import (
+	"bytes"
+	"encoding/csv"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"postal/domain"
+)
+
+const csvHeader = "title,slug,content,summary,thumbnail,category_id,sub_category_id,meta_title,meta_description,keywords,og_image,is_public,is_featured,is_pinned\n"
+
+func TestParseCSVRows(t *testing.T) {
+	subCategoryID := uint(4)
+
+	tests := []struct {
+		name     string
+		rows     string
+		expected []CSVRow
+	}{
+		{
+			name: "Header Only",
+		},
+		{
+			name: "Valid Row",
+			rows: `Hello World, Hello World!,Body,Sum,thumb.png,3,4,Meta,Desc,"go, redis",og.png,true,FALSE, false` + "\n",
+			expected: []CSVRow{{
+				Row: 2,
+				Post: &domain.Post{
+					Title:           "Hello World",
+					Slug:            "hello-world",
+					Content:         "Body",
+					Summary:         "Sum",
+					Thumbnail:       "thumb.png",
+					CategoryID:      3,
+					SubCategoryID:   &subCategoryID,
+					MetaTitle:       "Meta",
+					MetaDescription: "Desc",
+					Keywords:        "go, redis",
+					OGImage:         "og.png",
+					Status:          domain.StatusPublished,
+					IsPublic:        true,
+					CreatedBy:       9,
+					Version:         1,
+				},
+			}},
+		},
+		{
+			name: "Optional Columns Empty",
+			rows: "A,a,Body,,,3,,,,,,true,false,false\n",
+			expected: []CSVRow{{
+				Row: 2,
+				Post: &domain.Post{
+					Title: "A", Slug: "a", Content: "Body", CategoryID: 3,
+					Status: domain.StatusPublished, IsPublic: true, CreatedBy: 9, Version: 1,
+				},
+			}},
+		},
+		{
+			name: "Every Row Reported",
+			rows: "A,a,Body,Sum,t,3,4,M,D,k,o,true,false,false\n" +
+				"B,b,Body,Sum,t,x,4,M,D,k,o,true,false,false\n" +
+				"C,c,Body,Sum,t,3,4,M,D,k,o,yes,false,false\n" +
+				"D,d\n" +
+				",e,Body,Sum,t,3,4,M,D,k,o,true,false,false\n" +
+				"F,!!!,Body,Sum,t,3,4,M,D,k,o,true,false,false\n",
+			expected: []CSVRow{
+				{
+					Row: 2,
+					Post: &domain.Post{
+						Title: "A", Slug: "a", Content: "Body", Summary: "Sum", Thumbnail: "t",
+						CategoryID: 3, SubCategoryID: &subCategoryID, MetaTitle: "M", MetaDescription: "D",
+						Keywords: "k", OGImage: "o", Status: domain.StatusPublished, IsPublic: true,
+						CreatedBy: 9, Version: 1,
+					},
+				},
+				{Row: 3, Column: "category_id"},
+				{Row: 4, Column: "is_public"},
+				{Row: 5},
+				{Row: 6, Column: "title"},
+				{Row: 7, Column: "slug"},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rows, err := ParseCSVRows(strings.NewReader(csvHeader+tt.rows), 9)
+			require.NoError(t, err)
+			require.Len(t, rows, len(tt.expected))
+			for i, row := range rows {
+				expected := tt.expected[i]
+				require.Equal(t, expected.Row, row.Row)
+				require.Equal(t, expected.Column, row.Column)
+				require.Equal(t, expected.Post, row.Post)
+				if expected.Post == nil {
+					require.Error(t, row.Err)
+				} else {
+					require.NoError(t, row.Err)
+				}
+			}
+		})
+	}
+}
+
+func TestParseCSVRowsErrors(t *testing.T) {
+	tests := []struct {
+		name string
+		data string
+		err  string
+	}{
+		{
+			name: "Empty File",
+			err:  "invalid CSV header: EOF",
+		},
+		{
+			name: "Unterminated Quote",
+			data: csvHeader + "A,a,Body,Sum,t,3,4,M,D,\"k,o,true,false,false\n",
+			err:  `invalid CSV in row 2: extraneous or missing " in quoted-field`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := ParseCSVRows(strings.NewReader(tt.data), 9)
+			require.EqualError(t, err, tt.err)
+		})
+	}
+}
+
+func TestParseCSVRowMessages(t *testing.T) {
+	tests := []struct {
+		name string
+		row  string
+		err  string
+	}{
+		{
+			name: "Short Row",
+			row:  "A,a",
+			err:  "expected at least 14 columns, got 2",
+		},
+		{
+			name: "Missing Column",
+			row:  "A,a,,Sum,t,3,4,M,D,k,o,true,false,false",
+			err:  "content is required",
+		},
+		{
+			name: "Bad Boolean",
+			row:  "A,a,Body,Sum,t,3,4,M,D,k,o,true,1,false",
+			err:  "is_featured must be 'true' or 'false', got '1'",
+		},
+		{
+			name: "Bad Sub Category",
+			row:  "A,a,Body,Sum,t,3,-4,M,D,k,o,true,false,false",
+			err:  "invalid sub_category_id '-4'",
+		},
+		{
+			name: "Slug Without Letters",
+			row:  "A,---,Body,Sum,t,3,4,M,D,k,o,true,false,false",
+			err:  "slug '---' has no letters or digits",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rows, err := ParseCSVRows(strings.NewReader(csvHeader+tt.row+"\n"), 1)
+			require.NoError(t, err)
+			require.Len(t, rows, 1)
+			require.Nil(t, rows[0].Post)
+			require.EqualError(t, rows[0].Err, tt.err)
+		})
+	}
+}
+
+// TestPostCSVRecordRoundTrip parses exported posts back, unset optional fields
+// are written empty and must not fail the import
+func TestPostCSVRecordRoundTrip(t *testing.T) {
+	subCategoryID := uint(4)
+
+	tests := []struct {
+		name string
+		post domain.Post
+	}{
+		{
+			name: "Every Field Set",
+			post: domain.Post{
+				Title: "Hello", Slug: "hello", Content: "Body, with \"quotes\"\nand lines", Summary: "Sum",
+				Thumbnail: "t.png", CategoryID: 3, SubCategoryID: &subCategoryID, MetaTitle: "M",
+				MetaDescription: "D", Keywords: "go, redis", OGImage: "o.png", IsPublic: true, IsPinned: true,
+			},
+		},
+		{
+			name: "Optional Fields Empty",
+			post: domain.Post{Title: "Bare", Slug: "bare", Content: "Body", CategoryID: 3},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var buf bytes.Buffer
+			w := csv.NewWriter(&buf)
+			require.NoError(t, w.Write(PostCSVHeader))
+			require.NoError(t, w.Write(PostCSVRecord(&tt.post)))
+			w.Flush()
+			require.NoError(t, w.Error())
+
+			rows, err := ParseCSVRows(&buf, 9)
+			require.NoError(t, err)
+			require.Len(t, rows, 1)
+			require.NoError(t, rows[0].Err)
+
+			expected := tt.post
+			expected.Status = domain.StatusPublished
+			expected.CreatedBy = 9
+			expected.Version = 1
+			require.Equal(t, &expected, rows[0].Post)
+		})
+	}
+}
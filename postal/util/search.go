@@ -0,0 +1,78 @@
+package util
+
+import (
+	"strings"
+	"unicode"
+)
+
+// SearchTerm is one term of a full-text search query
+type SearchTerm struct {
+	Text    string
+	Phrase  bool // "quoted words" must appear next to each other
+	Prefix  bool // word* matches every word starting with word
+	Exclude bool // -word removes posts containing the term
+}
+
+// ParseSearchQuery splits a user query into terms. Quoted text becomes a phrase,
+// a trailing * makes a prefix term and a leading - excludes the term.
+// Prefix terms keep only letters, combining marks and digits so they are safe to
+// pass to to_tsquery.
+func ParseSearchQuery(query string) []SearchTerm {
+	var terms []SearchTerm
+	runes := []rune(strings.TrimSpace(query))
+
+	for i := 0; i < len(runes); {
+		if unicode.IsSpace(runes[i]) {
+			i++
+			continue
+		}
+
+		exclude := false
+		if runes[i] == '-' {
+			exclude = true
+			i++
+			if i >= len(runes) {
+				break
+			}
+		}
+
+		if runes[i] == '"' {
+			end := i + 1
+			for end < len(runes) && runes[end] != '"' {
+				end++
+			}
+			text := strings.TrimSpace(string(runes[i+1 : end]))
+			if text != "" {
+				terms = append(terms, SearchTerm{Text: text, Phrase: true, Exclude: exclude})
+			}
+			i = end + 1
+			continue
+		}
+
+		end := i
+		for end < len(runes) && !unicode.IsSpace(runes[end]) {
+			end++
+		}
+		word := string(runes[i:end])
+		i = end
+
+		if strings.HasSuffix(word, "*") {
+			lexeme := strings.Map(func(r rune) rune {
+				if unicode.IsLetter(r) || unicode.IsMark(r) || unicode.IsDigit(r) {
+					return unicode.ToLower(r)
+				}
+				return -1
+			}, word)
+			if lexeme != "" {
+				terms = append(terms, SearchTerm{Text: lexeme, Prefix: true, Exclude: exclude})
+			}
+			continue
+		}
+
+		if word != "" {
+			terms = append(terms, SearchTerm{Text: word, Exclude: exclude})
+		}
+	}
+
+	return terms
+}
@@ -0,0 +1,109 @@
+package util
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseSearchQuery(t *testing.T) {
+	tests := []struct {
+		name     string
+		query    string
+		expected []SearchTerm
+	}{
+		{
+			name:     "Empty Query",
+			query:    "",
+			expected: nil,
+		},
+		{
+			name:     "Only Whitespace",
+			query:    " \t\n ",
+			expected: nil,
+		},
+		{
+			name:  "Plain Words",
+			query: "go  redis",
+			expected: []SearchTerm{
+				{Text: "go"},
+				{Text: "redis"},
+			},
+		},
+		{
+			name:  "Phrase Prefix And Exclude",
+			query: `"clean code" cach* -java -"big ball" -rust*`,
+			expected: []SearchTerm{
+				{Text: "clean code", Phrase: true},
+				{Text: "cach", Prefix: true},
+				{Text: "java", Exclude: true},
+				{Text: "big ball", Phrase: true, Exclude: true},
+				{Text: "rust", Prefix: true, Exclude: true},
+			},
+		},
+		{
+			name:     "Unbalanced Quote Runs To The End",
+			query:    `go "clean code`,
+			expected: []SearchTerm{{Text: "go"}, {Text: "clean code", Phrase: true}},
+		},
+		{
+			name:     "Lone Quote",
+			query:    `"`,
+			expected: nil,
+		},
+		{
+			name:     "Empty Phrase",
+			query:    `"  " go`,
+			expected: []SearchTerm{{Text: "go"}},
+		},
+		{
+			name:     "Bare Minus",
+			query:    "-",
+			expected: nil,
+		},
+		{
+			name:     "Minus Before A Space Excludes Nothing",
+			query:    "- go",
+			expected: []SearchTerm{{Text: "go"}},
+		},
+		{
+			name:     "Bare Star",
+			query:    "*",
+			expected: nil,
+		},
+		{
+			name:     "Minus Star",
+			query:    "-*",
+			expected: nil,
+		},
+		{
+			name:  "Prefix Drops Tsquery Metacharacters",
+			query: "Go&Redis:* !(a|b)* <->*",
+			expected: []SearchTerm{
+				{Text: "goredis", Prefix: true},
+				{Text: "ab", Prefix: true},
+			},
+		},
+		{
+			name:  "Plain Words Keep Metacharacters",
+			query: "a&b !c | (d)",
+			expected: []SearchTerm{
+				{Text: "a&b"},
+				{Text: "!c"},
+				{Text: "|"},
+				{Text: "(d)"},
+			},
+		},
+		{
+			name:     "Bangla Prefix Keeps Vowel Signs",
+			query:    "বাংলা*",
+			expected: []SearchTerm{{Text: "বাংলা", Prefix: true}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require.Equal(t, tt.expected, ParseSearchQuery(tt.query))
+		})
+	}
+}
@@ -0,0 +1,48 @@
+package util
+
+import (
+	"strings"
+)
+
+// maxTagNameLength matches the size of the tags.name column
+const maxTagNameLength = 100
+
+var tagSymbolReplacer = strings.NewReplacer("+", "-plus", "#", "-sharp")
+
+// NormalizeTagName trims a tag, drops a leading # and collapses inner whitespace
+func NormalizeTagName(name string) string {
+	name = strings.TrimSpace(strings.TrimLeft(strings.TrimSpace(name), "#"))
+	name = strings.Join(strings.Fields(name), " ")
+	if runes := []rune(name); len(runes) > maxTagNameLength {
+		name = strings.TrimSpace(string(runes[:maxTagNameLength]))
+	}
+	return name
+}
+
+// TagSlug returns the slug identifying a tag. + and # are spelled out so
+// tags like "C++" and "C#" don't collapse into "c".
+func TagSlug(name string) string {
+	return GenerateSlug(tagSymbolReplacer.Replace(NormalizeTagName(name)))
+}
+
+// ParseTags normalizes tag names and drops empty ones and duplicates by slug,
+// keeping the first spelling
+func ParseTags(names []string) []string {
+	seen := make(map[string]bool, len(names))
+	tags := make([]string, 0, len(names))
+	for _, name := range names {
+		name = NormalizeTagName(name)
+		slug := TagSlug(name)
+		if slug == "" || seen[slug] {
+			continue
+		}
+		seen[slug] = true
+		tags = append(tags, name)
+	}
+	return tags
+}
+
+// ParseKeywords splits a comma separated keywords string into tag names
+func ParseKeywords(keywords string) []string {
+	return ParseTags(strings.Split(keywords, ","))
+}
@@ -0,0 +1,97 @@
+package util
+
+import (
+	"math"
+	"sort"
+	"strings"
+	"unicode"
+)
+
+// stopWords are common English words that carry no topic
+var stopWords = map[string]bool{}
+
+func init() {
+	for _, word := range strings.Fields(`a about above after again against all am an and any are as at be because been
+		before being below between both but by can could did do does doing down during each few for from further had
+		has have having he her here hers herself him himself his how i if in into is it its itself just let me more most
+		my myself no nor not now of off on once only or other our ours ourselves out over own same she should so some
+		such than that the their theirs them themselves then there these they this those through to too under until up
+		us very was we were what when where which while who whom why will with would you your yours yourself yourselves
+		also use used using like get one two new may must way well`) {
+		stopWords[word] = true
+	}
+}
+
+// Tokenize splits text into lowercase words of letters and digits, dropping
+// stop words and single characters. Inner + and # are kept so "c++" and "c#" survive.
+func Tokenize(text string) []string {
+	words := strings.FieldsFunc(strings.ToLower(text), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r) && r != '+' && r != '#'
+	})
+
+	tokens := make([]string, 0, len(words))
+	for _, word := range words {
+		word = strings.TrimLeft(word, "+#")
+		if len([]rune(word)) < 2 || stopWords[word] {
+			continue
+		}
+		tokens = append(tokens, word)
+	}
+	return tokens
+}
+
+// TermFrequencies returns the frequency of every token relative to the most frequent one
+func TermFrequencies(tokens []string) map[string]float64 {
+	counts := make(map[string]float64, len(tokens))
+	max := 0.0
+	for _, token := range tokens {
+		counts[token]++
+		if counts[token] > max {
+			max = counts[token]
+		}
+	}
+	for term := range counts {
+		counts[term] /= max
+	}
+	return counts
+}
+
+// TFIDF weighs term frequencies by the inverse document frequency of the terms in
+// a corpus of docCount documents, keeps the topN heaviest terms and normalizes
+// the vector to unit length so the dot product of two vectors is their cosine
+func TFIDF(tf map[string]float64, docFreq map[string]int, docCount int, topN int) map[string]float64 {
+	type weighted struct {
+		term   string
+		weight float64
+	}
+	weights := make([]weighted, 0, len(tf))
+	for term, freq := range tf {
+		idf := math.Log(float64(1+docCount) / float64(1+docFreq[term]))
+		if idf <= 0 {
+			continue
+		}
+		weights = append(weights, weighted{term, freq * idf})
+	}
+
+	sort.Slice(weights, func(i, j int) bool {
+		if weights[i].weight != weights[j].weight {
+			return weights[i].weight > weights[j].weight
+		}
+		return weights[i].term < weights[j].term
+	})
+	if len(weights) > topN {
+		weights = weights[:topN]
+	}
+
+	norm := 0.0
+	for _, w := range weights {
+		norm += w.weight * w.weight
+	}
+	norm = math.Sqrt(norm)
+
+	vector := make(map[string]float64, len(weights))
+	for _, w := range weights {
+		vector[w.term] = w.weight / norm
+	}
+	return vector
+}